@@ -20,6 +20,15 @@ var (
 		Threads:   4,
 		KeyLength: models.SecretLength,
 	}
+
+	// LegacyArgon2IDParams are the parameters used to hash credentials that
+	// were created before their parameters were recorded alongside them
+	LegacyArgon2IDParams = models.Argon2Params{
+		Time:      1,
+		Memory:    64 * 1024,
+		Threads:   4,
+		KeyLength: models.SecretLength,
+	}
 )
 
 // CredentialProducer represents an interface for generating credentials and
@@ -28,6 +37,11 @@ type CredentialProducer interface {
 	Generate(models.Password) (*models.Credentials, error)
 	Compare(models.Password, *models.Credentials) error
 	Alg() models.CredentialsAlgType
+
+	// NeedsRehash returns whether the credentials were produced with
+	// different parameters to the ones the producer currently uses, if so
+	// they should be generated again the next time the secret is known
+	NeedsRehash(*models.Credentials) bool
 }
 
 // Argon2IDProducer implements the CredentialProducer interface.
@@ -53,11 +67,13 @@ func (a *Argon2IDProducer) Generate(secret models.Password) (*models.Credentials
 		return nil, errors.Wrap(models.SystemErrorCause, err)
 	}
 
-	value := argon2.IDKey([]byte(secret), salt, a.Time, a.Memory, a.Threads, a.KeyLength)
+	params := a.params()
+	value := argon2.IDKey([]byte(secret), salt, params.Time, params.Memory, params.Threads, params.KeyLength)
 	creds := &models.Credentials{
 		Alg:    a.Alg(),
 		Secret: base64.New(value),
 		Salt:   base64.New(salt),
+		Params: &params,
 	}
 
 	return creds, nil
@@ -72,7 +88,14 @@ func (a *Argon2IDProducer) Compare(secret models.Password, creds *models.Credent
 		return errors.New(UnsupportedAlgorithm, "Algorithm %s is not supported, requires %s", creds.Alg, a.Alg())
 	}
 
-	value := argon2.IDKey([]byte(secret), *creds.Salt, a.Time, a.Memory, a.Threads, a.KeyLength)
+	// Credentials are compared using the parameters they were created with
+	// so changing the producer's parameters doesn't lock everyone out
+	params := LegacyArgon2IDParams
+	if creds.Params != nil {
+		params = *creds.Params
+	}
+
+	value := argon2.IDKey([]byte(secret), *creds.Salt, params.Time, params.Memory, params.Threads, params.KeyLength)
 	if subtle.ConstantTimeCompare(value, *creds.Secret) == 0 {
 		return ErrBadCredentials
 	}
@@ -84,6 +107,28 @@ func (a *Argon2IDProducer) Alg() models.CredentialsAlgType {
 	return models.Argon2ID
 }
 
+func (a *Argon2IDProducer) NeedsRehash(creds *models.Credentials) bool {
+	if creds.Alg != a.Alg() {
+		return false
+	}
+
+	params := LegacyArgon2IDParams
+	if creds.Params != nil {
+		params = *creds.Params
+	}
+
+	return params != a.params()
+}
+
+func (a *Argon2IDProducer) params() models.Argon2Params {
+	return models.Argon2Params{
+		Time:      a.Time,
+		Memory:    a.Memory,
+		Threads:   a.Threads,
+		KeyLength: a.KeyLength,
+	}
+}
+
 // SHA256Producer implements the CredentialProducer interface. The
 // SHA256Producer is designed for _fast_ hashing scenarios and thus should
 // only ever be used in development situations
@@ -131,4 +176,9 @@ func (s *SHA256Producer) Alg() models.CredentialsAlgType {
 	return models.SHA256
 }
 
+// NeedsRehash always returns false as the SHA256Producer has no parameters
+func (s *SHA256Producer) NeedsRehash(creds *models.Credentials) bool {
+	return false
+}
+
 var randRead = rand.Read
@@ -192,6 +192,45 @@ func TestCredentialProducer(t *testing.T) {
 	})
 }
 
+func TestArgon2IDParams(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	password := models.Password("abcdefghijk")
+	stronger := &Argon2IDProducer{
+		Time:      2,
+		Memory:    32 * 1024,
+		Threads:   2,
+		KeyLength: models.SecretLength,
+	}
+
+	t.Run("records parameters", func(t *testing.T) {
+		creds, err := stronger.Generate(password)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(creds.Params).ToNot(gm.BeNil())
+		gm.Expect(creds.Params.Time).To(gm.Equal(uint32(2)))
+		gm.Expect(stronger.NeedsRehash(creds)).To(gm.BeFalse())
+	})
+
+	t.Run("compares with the parameters the credentials were created with", func(t *testing.T) {
+		creds, err := DefaultArgon2IDProducer.Generate(password)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(stronger.Compare(password, creds)).To(gm.Succeed())
+		gm.Expect(stronger.NeedsRehash(creds)).To(gm.BeTrue())
+	})
+
+	t.Run("credentials without parameters use the legacy parameters", func(t *testing.T) {
+		creds, err := DefaultArgon2IDProducer.Generate(password)
+		gm.Expect(err).To(gm.BeNil())
+		creds.Params = nil
+
+		gm.Expect(stronger.Compare(password, creds)).To(gm.Succeed())
+		gm.Expect(DefaultArgon2IDProducer.NeedsRehash(creds)).To(gm.BeFalse())
+		gm.Expect(stronger.NeedsRehash(creds)).To(gm.BeTrue())
+	})
+}
+
 type ErrRandReader struct {
 	i   int
 	err error
@@ -13,6 +13,10 @@ var (
 
 	SignatureNotValid = errors.NewCause(errors.UnauthorizedCategory, "signature_not_valid")
 
+	// UnknownSigningKey occurs when a token was signed by a key that is
+	// unknown or has been retired
+	UnknownSigningKey = errors.NewCause(errors.UnauthorizedCategory, "unknown_signing_key")
+
 	BadAPITokenVersion = errors.NewCause(errors.BadRequestCategory, "bad_apitoken_version")
 	BadSaltLength      = errors.NewCause(errors.BadRequestCategory, "bad_salt_length")
 	BadSecretLength    = errors.NewCause(errors.BadRequestCategory, "bad_secret_length")
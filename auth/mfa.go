@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/capeprivacy/cape/models"
+)
+
+// VerifyMFA checks the code against the enrollment's TOTP secret and, if
+// allowRecovery is set, its unused recovery codes. The enrollment is
+// modified to record the used code so the caller must persist it when the
+// code is valid.
+func VerifyMFA(enrollment *models.MFAEnrollment, code string, allowRecovery bool) bool {
+	totp := &TOTP{Secret: []byte(*enrollment.Secret)}
+
+	step, ok := totp.Validate(code, time.Now(), enrollment.LastUsedStep)
+	if ok {
+		enrollment.LastUsedStep = step
+		return true
+	}
+
+	return allowRecovery && enrollment.UseRecoveryCode(code)
+}
@@ -2,6 +2,8 @@ package auth
 
 import (
 	"crypto/ed25519"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/manifoldco/go-base64"
@@ -12,39 +14,110 @@ import (
 )
 
 var (
-	// TokenDuration how long auth tokens last
+	// TokenDuration is how long tokens created with Generate last, it is the
+	// default session lifetime
 	TokenDuration = time.Hour * 24
+
+	// KeyRefreshInterval is how often a TokenAuthority backed by a KeySource
+	// reloads its signing keys
+	KeyRefreshInterval = time.Minute
+
+	// minKeyRefreshInterval limits how often a token with an unknown key id
+	// can cause the signing keys to be reloaded
+	minKeyRefreshInterval = time.Second * 5
 )
 
+// SigningKey is a keypair used by the TokenAuthority. The ID is set as the
+// kid header of the tokens signed by the key so the matching key can be found
+// when they are verified.
+type SigningKey struct {
+	ID      string
+	Keypair *Keypair
+
+	// RetiresAt is set once the key has been replaced, tokens signed by it
+	// are accepted until then
+	RetiresAt *time.Time
+}
+
+// Retired returns whether the key can no longer be used to verify tokens
+func (s *SigningKey) Retired() bool {
+	return s.RetiresAt != nil && !time.Now().UTC().Before(*s.RetiresAt)
+}
+
+// KeySource returns the signing keys a TokenAuthority should use. The first
+// key returned is used to sign new tokens, the rest are only used to verify
+// tokens signed before the key was rotated.
+type KeySource func() ([]*SigningKey, error)
+
 // TokenAuthority is the authority over token, it generates
 // and verifies tokens based on the private/public keys it owns
 type TokenAuthority struct {
-	keypair      *Keypair
 	serviceEmail string
+	source       KeySource
+
+	lock        sync.RWMutex
+	keys        []*SigningKey
+	refreshedAt time.Time
+
+	// refreshing serializes reloads of the keys so the source is only
+	// called once for every caller that finds the keys stale, while lock
+	// is only held to swap the keys in
+	refreshing sync.Mutex
+
+	// reloading is set while a reload started in the background is running
+	reloading int32
 }
 
 // NewTokenAuthority returns a new token authority
 func NewTokenAuthority(keypair *Keypair, serviceEmail string) (*TokenAuthority, error) {
+	var keys []*SigningKey
+	if keypair != nil {
+		keys = []*SigningKey{{Keypair: keypair}}
+	}
+
 	return &TokenAuthority{
-		keypair:      keypair,
+		keys:         keys,
 		serviceEmail: serviceEmail,
 	}, nil
 }
 
+// NewRotatingTokenAuthority returns a token authority that loads its keys
+// from the given source and periodically reloads them so that keys rotated
+// by another process are picked up
+func NewRotatingTokenAuthority(source KeySource, serviceEmail string) (*TokenAuthority, error) {
+	t := &TokenAuthority{
+		source:       source,
+		serviceEmail: serviceEmail,
+	}
+
+	err := t.refresh(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 // Verify verifies that a JWT token was signed by the correct private key. Returns
 // the session ID contained inside of the token.
 func (t *TokenAuthority) Verify(signedToken *base64.Value) (string, error) {
-	if t.keypair == nil {
-		return "", errors.New(MissingKeyPair, "Missing key pair cannot verify token")
+	tok, err := jwt.ParseSigned(string(*signedToken))
+	if err != nil {
+		return "", err
 	}
 
-	tok, err := jwt.ParseSigned(string(*signedToken))
+	kid := ""
+	if len(tok.Headers) > 0 {
+		kid = tok.Headers[0].KeyID
+	}
+
+	key, err := t.verificationKey(kid)
 	if err != nil {
 		return "", err
 	}
 
 	claims := jwt.Claims{}
-	err = tok.Claims(t.PublicKey(), &claims)
+	err = tok.Claims(key.Keypair.PublicKey, &claims)
 	if err != nil {
 		return "", err
 	}
@@ -60,9 +133,39 @@ func (t *TokenAuthority) Verify(signedToken *base64.Value) (string, error) {
 	return claims.ID, nil
 }
 
-// PublicKey returns a copy of the ed25519 PublicKey
+// PublicKey returns a copy of the ed25519 PublicKey of the current signing key
 func (t *TokenAuthority) PublicKey() ed25519.PublicKey {
-	return t.keypair.PublicKey
+	key, err := t.signingKey()
+	if err != nil {
+		return nil
+	}
+
+	return key.Keypair.PublicKey
+}
+
+// PublicKeys returns the public keys that tokens can currently be verified
+// with, as a JSON Web Key Set, for use by external verifiers
+func (t *TokenAuthority) PublicKeys() jose.JSONWebKeySet {
+	t.refreshInBackground()
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+	for _, key := range t.keys {
+		if key.Retired() {
+			continue
+		}
+
+		set.Keys = append(set.Keys, jose.JSONWebKey{
+			Key:       key.Keypair.PublicKey,
+			KeyID:     key.ID,
+			Algorithm: string(jose.EdDSA),
+			Use:       "sig",
+		})
+	}
+
+	return set
 }
 
 // Generate generates a JWT with 4 claims:
@@ -71,31 +174,191 @@ func (t *TokenAuthority) PublicKey() ed25519.PublicKey {
 // - IssuedAt: time the JWT was issued
 // - NotBefore: the JWT will not be accepted before this time has passed
 // - Issuer: the service email of the issuing coordinator
+//
+// The id of the signing key is set as the kid header.
 func (t *TokenAuthority) Generate(sessionID string) (*base64.Value, time.Time, error) {
-	if t.keypair == nil {
-		return nil, time.Time{}, errors.New(MissingKeyPair, "Missing key pair cannot generate token")
+	expiresAt := time.Now().UTC().Add(TokenDuration)
+	token, err := t.GenerateUntil(sessionID, expiresAt)
+	if err != nil {
+		return nil, time.Time{}, err
 	}
 
-	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.EdDSA, Key: t.keypair.PrivateKey},
-		(&jose.SignerOptions{}).WithType("JWT"))
+	return token, expiresAt, nil
+}
+
+// GenerateUntil generates a JWT, like Generate, that expires at the given
+// time
+func (t *TokenAuthority) GenerateUntil(sessionID string, expiresAt time.Time) (*base64.Value, error) {
+	key, err := t.signingKey()
 	if err != nil {
-		return nil, time.Time{}, err
+		return nil, err
+	}
+
+	sig, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.EdDSA,
+		Key: jose.JSONWebKey{
+			Key:       key.Keypair.PrivateKey,
+			KeyID:     key.ID,
+			Algorithm: string(jose.EdDSA),
+		},
+	}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return nil, err
 	}
 
 	now := time.Now().UTC()
-	expiresIn := now.Add(TokenDuration)
 	cl := jwt.Claims{
 		ID:        sessionID,
 		Issuer:    t.serviceEmail,
 		IssuedAt:  jwt.NewNumericDate(now),
 		NotBefore: jwt.NewNumericDate(now),
-		Expiry:    jwt.NewNumericDate(expiresIn),
+		Expiry:    jwt.NewNumericDate(expiresAt),
 	}
 
 	signedToken, err := jwt.Signed(sig).Claims(cl).CompactSerialize()
 	if err != nil {
-		return nil, time.Time{}, err
+		return nil, err
+	}
+
+	return base64.New([]byte(signedToken)), nil
+}
+
+// Sign signs the message with the current signing key. The id and public
+// key of the signing key are returned so the signature can still be
+// verified once the key has been rotated and retired.
+func (t *TokenAuthority) Sign(msg []byte) (keyID string, publicKey ed25519.PublicKey, sig []byte, err error) {
+	key, err := t.signingKey()
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return key.ID, key.Keypair.PublicKey, ed25519.Sign(key.Keypair.PrivateKey, msg), nil
+}
+
+// signingKey returns the key new tokens are signed with
+func (t *TokenAuthority) signingKey() (*SigningKey, error) {
+	t.refreshInBackground()
+
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if len(t.keys) == 0 {
+		return nil, errors.New(MissingKeyPair, "Missing key pair cannot generate token")
+	}
+
+	return t.keys[0], nil
+}
+
+// verificationKey returns the key with the given id. Tokens signed before
+// key ids were introduced have no kid and match the key with an empty id.
+func (t *TokenAuthority) verificationKey(kid string) (*SigningKey, error) {
+	t.refreshInBackground()
+
+	key := t.findKey(kid)
+	if key == nil {
+		// The key may have been rotated in by another coordinator since we
+		// last looked
+		t.refresh(minKeyRefreshInterval) // nolint: errcheck
+		key = t.findKey(kid)
+	}
+
+	if key == nil {
+		if t.keyCount() == 0 {
+			return nil, errors.New(MissingKeyPair, "Missing key pair cannot verify token")
+		}
+
+		return nil, errors.New(UnknownSigningKey, "Token was not signed by a known key")
+	}
+
+	if key.Retired() {
+		return nil, errors.New(UnknownSigningKey, "Token was signed by a retired key")
+	}
+
+	return key, nil
+}
+
+func (t *TokenAuthority) findKey(kid string) *SigningKey {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	for _, key := range t.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (t *TokenAuthority) keyCount() int {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return len(t.keys)
+}
+
+// refreshInBackground reloads the keys if they're older than
+// KeyRefreshInterval without making the caller wait, the keys already
+// loaded keep being used until the reload finishes. A failed reload keeps
+// the keys that were previously loaded.
+func (t *TokenAuthority) refreshInBackground() {
+	if t.source == nil || !t.stale(KeyRefreshInterval) {
+		return
 	}
 
-	return base64.New([]byte(signedToken)), expiresIn, nil
+	// Without any keys there's nothing to use in the meantime
+	if t.keyCount() == 0 {
+		t.refresh(KeyRefreshInterval) // nolint: errcheck
+		return
+	}
+
+	if !atomic.CompareAndSwapInt32(&t.reloading, 0, 1) {
+		return
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&t.reloading, 0)
+		t.refresh(KeyRefreshInterval) // nolint: errcheck
+	}()
+}
+
+// stale returns whether the keys were last loaded longer than maxAge ago
+func (t *TokenAuthority) stale(maxAge time.Duration) bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.refreshedAt.IsZero() || time.Since(t.refreshedAt) >= maxAge
+}
+
+// refresh reloads the keys from the source if they were last loaded longer
+// than maxAge ago. The keys can still be read while the source is called.
+func (t *TokenAuthority) refresh(maxAge time.Duration) error {
+	if t.source == nil || !t.stale(maxAge) {
+		return nil
+	}
+
+	t.refreshing.Lock()
+	defer t.refreshing.Unlock()
+
+	// The keys may have been reloaded while waiting for another reload
+	if !t.stale(maxAge) {
+		return nil
+	}
+
+	keys, err := t.source()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return errors.New(MissingKeyPair, "Key source returned no signing keys")
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.keys = keys
+	t.refreshedAt = time.Now()
+
+	return nil
 }
@@ -1,12 +1,16 @@
 package auth
 
 import (
+	"crypto/ed25519"
 	"github.com/capeprivacy/cape/models"
 	"testing"
 	"time"
 
 	gm "github.com/onsi/gomega"
 	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
 func TestTokenAuthority(t *testing.T) {
@@ -85,3 +89,159 @@ func TestTokenAuthority(t *testing.T) {
 		gm.Expect(err).To(gm.Equal(jose.ErrCryptoFailure))
 	})
 }
+
+func TestRotatingTokenAuthority(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	oldKeypair, err := NewKeypair()
+	gm.Expect(err).To(gm.BeNil())
+
+	newKeypair, err := NewKeypair()
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("Sets the key id header", func(t *testing.T) {
+		tokenAuth, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return []*SigningKey{{ID: "new", Keypair: newKeypair}}, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		sig, _, err := tokenAuth.Generate(models.NewID())
+		gm.Expect(err).To(gm.BeNil())
+
+		tok, err := jwt.ParseSigned(string(*sig))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(tok.Headers[0].KeyID).To(gm.Equal("new"))
+	})
+
+	t.Run("Verifies tokens signed by a key that hasn't retired", func(t *testing.T) {
+		oldAuth, err := NewTokenAuthority(oldKeypair, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		id := models.NewID()
+		sig, _, err := oldAuth.Generate(id)
+		gm.Expect(err).To(gm.BeNil())
+
+		retiresAt := time.Now().UTC().Add(time.Hour)
+		tokenAuth, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return []*SigningKey{
+				{ID: "new", Keypair: newKeypair},
+				{ID: "", Keypair: oldKeypair, RetiresAt: &retiresAt},
+			}, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		otherID, err := tokenAuth.Verify(sig)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(otherID).To(gm.Equal(id))
+
+		gm.Expect(tokenAuth.PublicKey()).To(gm.Equal(newKeypair.PublicKey))
+		gm.Expect(tokenAuth.PublicKeys().Keys).To(gm.HaveLen(2))
+	})
+
+	t.Run("Can't verify tokens signed by a retired key", func(t *testing.T) {
+		oldAuth, err := NewTokenAuthority(oldKeypair, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		sig, _, err := oldAuth.Generate(models.NewID())
+		gm.Expect(err).To(gm.BeNil())
+
+		retiresAt := time.Now().UTC().Add(-time.Minute)
+		tokenAuth, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return []*SigningKey{
+				{ID: "new", Keypair: newKeypair},
+				{ID: "", Keypair: oldKeypair, RetiresAt: &retiresAt},
+			}, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = tokenAuth.Verify(sig)
+		gm.Expect(errors.CausedBy(err, UnknownSigningKey)).To(gm.BeTrue())
+
+		keys := tokenAuth.PublicKeys().Keys
+		gm.Expect(keys).To(gm.HaveLen(1))
+		gm.Expect(keys[0].KeyID).To(gm.Equal("new"))
+	})
+
+	t.Run("Reloads keys when it sees an unknown key id", func(t *testing.T) {
+		keys := []*SigningKey{{ID: "old", Keypair: oldKeypair}}
+		tokenAuth, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return keys, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		// Another coordinator rotated the key and signed a token with it
+		retiresAt := time.Now().UTC().Add(time.Hour)
+		keys = []*SigningKey{
+			{ID: "new", Keypair: newKeypair},
+			{ID: "old", Keypair: oldKeypair, RetiresAt: &retiresAt},
+		}
+
+		rotated, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return keys, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		id := models.NewID()
+		sig, _, err := rotated.Generate(id)
+		gm.Expect(err).To(gm.BeNil())
+
+		tokenAuth.refreshedAt = time.Now().Add(-minKeyRefreshInterval)
+
+		otherID, err := tokenAuth.Verify(sig)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(otherID).To(gm.Equal(id))
+	})
+
+	t.Run("Keeps using its keys while they're reloaded", func(t *testing.T) {
+		release := make(chan struct{})
+		reloads := 0
+		tokenAuth, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			reloads++
+			if reloads > 1 {
+				<-release
+			}
+
+			return []*SigningKey{{ID: "new", Keypair: newKeypair}}, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+		defer close(release)
+
+		id := models.NewID()
+		sig, _, err := tokenAuth.Generate(id)
+		gm.Expect(err).To(gm.BeNil())
+
+		tokenAuth.lock.Lock()
+		tokenAuth.refreshedAt = time.Now().Add(-KeyRefreshInterval)
+		tokenAuth.lock.Unlock()
+
+		// The reload is blocked but tokens are still verified and signed
+		// with the keys already loaded
+		otherID, err := tokenAuth.Verify(sig)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(otherID).To(gm.Equal(id))
+
+		_, _, err = tokenAuth.Generate(id)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("Signs messages with the current key", func(t *testing.T) {
+		tokenAuth, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return []*SigningKey{{ID: "new", Keypair: newKeypair}}, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(err).To(gm.BeNil())
+
+		msg := []byte("hello")
+		keyID, pub, sig, err := tokenAuth.Sign(msg)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(keyID).To(gm.Equal("new"))
+		gm.Expect(pub).To(gm.Equal(newKeypair.PublicKey))
+		gm.Expect(ed25519.Verify(pub, msg, sig)).To(gm.BeTrue())
+	})
+
+	t.Run("Requires at least one key", func(t *testing.T) {
+		_, err := NewRotatingTokenAuthority(func() ([]*SigningKey, error) {
+			return nil, nil
+		}, "coordinator@coordinator.ai")
+		gm.Expect(errors.CausedBy(err, MissingKeyPair)).To(gm.BeTrue())
+	})
+}
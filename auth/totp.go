@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // nolint: gosec
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPPeriod is the length of each time step a one-time code is valid for
+	TOTPPeriod = 30 * time.Second
+
+	// TOTPDigits is the number of digits in a one-time code
+	TOTPDigits = 6
+
+	// totpSkew is the number of time steps either side of the current one
+	// that a code is accepted for to allow for clock drift
+	totpSkew = 1
+
+	totpSecretLength = 20
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTP generates and validates time-based one-time passwords as described
+// by RFC 6238 using HMAC-SHA1, the only algorithm universally supported by
+// authenticator apps.
+type TOTP struct {
+	Secret []byte
+}
+
+// NewTOTP returns a TOTP with a randomly generated secret
+func NewTOTP() (*TOTP, error) {
+	secret := make([]byte, totpSecretLength)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTP{Secret: secret}, nil
+}
+
+// EncodedSecret returns the secret in the base32 form users type into their
+// authenticator app
+func (t *TOTP) EncodedSecret() string {
+	return b32.EncodeToString(t.Secret)
+}
+
+// URI returns an otpauth:// uri, usually displayed as a QR code, that
+// authenticator apps use to enroll the secret
+func (t *TOTP) URI(issuer, account string) string {
+	v := url.Values{}
+	v.Set("secret", t.EncodedSecret())
+	v.Set("issuer", issuer)
+	v.Set("digits", fmt.Sprintf("%d", TOTPDigits))
+	v.Set("period", fmt.Sprintf("%d", int(TOTPPeriod.Seconds())))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// Step returns the time step the given time falls within
+func (t *TOTP) Step(at time.Time) int64 {
+	return at.Unix() / int64(TOTPPeriod.Seconds())
+}
+
+// Code returns the one-time code for the given time
+func (t *TOTP) Code(at time.Time) string {
+	return t.codeForStep(t.Step(at))
+}
+
+// Validate checks the code against the time steps around the given time. To
+// prevent a code from being replayed, codes for steps at or before
+// lastStep are rejected. On success the step the code was valid for is
+// returned so it can be recorded as the new lastStep.
+func (t *TOTP) Validate(code string, at time.Time, lastStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := t.Step(at)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+
+		expected := t.codeForStep(step)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func (t *TOTP) codeForStep(step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, t.Secret)
+	mac.Write(msg[:]) // nolint: errcheck
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as described in RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod)
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestTOTP(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	// The SHA1 test vectors from RFC 6238 Appendix B truncated to 6 digits
+	rfc := &TOTP{Secret: []byte("12345678901234567890")}
+
+	t.Run("matches the rfc test vectors", func(t *testing.T) {
+		tests := []struct {
+			at   int64
+			code string
+		}{
+			{59, "287082"},
+			{1111111109, "081804"},
+			{1111111111, "050471"},
+			{1234567890, "005924"},
+			{2000000000, "279037"},
+			{20000000000, "353130"},
+		}
+
+		for _, tc := range tests {
+			gm.Expect(rfc.Code(time.Unix(tc.at, 0))).To(gm.Equal(tc.code))
+		}
+	})
+
+	t.Run("accepts codes from adjacent time steps", func(t *testing.T) {
+		now := time.Unix(1234567890, 0)
+
+		_, ok := rfc.Validate(rfc.Code(now.Add(-TOTPPeriod)), now, 0)
+		gm.Expect(ok).To(gm.BeTrue())
+
+		_, ok = rfc.Validate(rfc.Code(now.Add(TOTPPeriod)), now, 0)
+		gm.Expect(ok).To(gm.BeTrue())
+
+		_, ok = rfc.Validate(rfc.Code(now.Add(-3*TOTPPeriod)), now, 0)
+		gm.Expect(ok).To(gm.BeFalse())
+	})
+
+	t.Run("rejects replayed codes", func(t *testing.T) {
+		now := time.Unix(1234567890, 0)
+
+		step, ok := rfc.Validate(rfc.Code(now), now, 0)
+		gm.Expect(ok).To(gm.BeTrue())
+		gm.Expect(step).To(gm.Equal(rfc.Step(now)))
+
+		_, ok = rfc.Validate(rfc.Code(now), now, step)
+		gm.Expect(ok).To(gm.BeFalse())
+	})
+
+	t.Run("rejects malformed codes", func(t *testing.T) {
+		now := time.Unix(1234567890, 0)
+
+		_, ok := rfc.Validate("12345", now, 0)
+		gm.Expect(ok).To(gm.BeFalse())
+	})
+
+	t.Run("generates an enrollment uri", func(t *testing.T) {
+		totp, err := NewTOTP()
+		gm.Expect(err).To(gm.BeNil())
+
+		uri := totp.URI("Cape", "bob@cape.com")
+		gm.Expect(strings.HasPrefix(uri, "otpauth://totp/Cape:bob@cape.com?")).To(gm.BeTrue())
+		gm.Expect(uri).To(gm.ContainSubstring("secret=" + totp.EncodedSecret()))
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	totp, err := NewTOTP()
+	gm.Expect(err).To(gm.BeNil())
+
+	codes, err := models.GenerateRecoveryCodes()
+	gm.Expect(err).To(gm.BeNil())
+
+	enrollment := models.NewMFAEnrollment("user", base64.New(totp.Secret))
+	enrollment.SetRecoveryCodes(codes)
+
+	t.Run("accepts a code once", func(t *testing.T) {
+		code := totp.Code(time.Now())
+		gm.Expect(VerifyMFA(&enrollment, code, false)).To(gm.BeTrue())
+		gm.Expect(VerifyMFA(&enrollment, code, false)).To(gm.BeFalse())
+	})
+
+	t.Run("only accepts recovery codes when allowed", func(t *testing.T) {
+		gm.Expect(VerifyMFA(&enrollment, codes[0], false)).To(gm.BeFalse())
+		gm.Expect(VerifyMFA(&enrollment, codes[0], true)).To(gm.BeTrue())
+		gm.Expect(VerifyMFA(&enrollment, codes[0], true)).To(gm.BeFalse())
+	})
+}
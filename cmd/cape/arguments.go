@@ -2,12 +2,16 @@ package main
 
 import (
 	"fmt"
+	"strconv"
+
 	"github.com/capeprivacy/cape/models"
 )
 
 var (
 	ClusterLabelArg = LabelArg("cluster")
 	ProjectLabelArg = LabelArg("project-label")
+	RoleLabelArg    = LabelArg("role")
+	TeamLabelArg    = LabelArg("team")
 
 	ClusterURLArg = &Argument{
 		Name:        "url",
@@ -46,6 +50,69 @@ var (
 		},
 	}
 
+	SessionIDArg = &Argument{
+		Name:        "session-id",
+		Description: "The ID of the session.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return in, nil
+		},
+	}
+
+	SessionUserArg = &Argument{
+		Name:        "user",
+		Description: "The email of the user whose sessions are being revoked.",
+		Required:    false,
+		Processor: func(in string) (interface{}, error) {
+			return models.Email(in), nil
+		},
+	}
+
+	MFAUserArg = &Argument{
+		Name:        "user",
+		Description: "The email of the user whose multi-factor authentication is being reset.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return models.Email(in), nil
+		},
+	}
+
+	MFARequiredArg = &Argument{
+		Name:        "required",
+		Description: "Whether admins must login with a one-time code, either true or false.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return strconv.ParseBool(in)
+		},
+	}
+
+	EmailChangeIDArg = &Argument{
+		Name:        "change-id",
+		Description: "The ID of the email change sent to your new email address.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return in, nil
+		},
+	}
+
+	InvitationIDArg = &Argument{
+		Name:        "invitation-id",
+		Description: "The ID of the invitation.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return in, nil
+		},
+	}
+
+	RecoveryIDArg = &Argument{
+		Name:        "recovery-id",
+		Description: "The ID of the recovery.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return in, nil
+		},
+	}
+
 	ProjectNameArg = &Argument{
 		Name:        "name",
 		Description: "The name of your project.",
@@ -91,18 +158,61 @@ var (
 		},
 	}
 
+	SecretNameArg = &Argument{
+		Name:        "secret",
+		Description: "The name of a secret used by the project's transformations.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			if in == "" {
+				return nil, fmt.Errorf("invalid secret specified")
+			}
+
+			return in, nil
+		},
+	}
+
+	TeamNameArg = &Argument{
+		Name:        "name",
+		Description: "The display name of the team.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return in, nil
+		},
+	}
+
+	KeyFileArg = &Argument{
+		Name:        "file",
+		Description: "The file to write the key to, it must not already exist.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			if in == "" {
+				return nil, fmt.Errorf("invalid file specified")
+			}
+
+			return in, nil
+		},
+	}
+
+	AuditExportFileArg = &Argument{
+		Name:        "file",
+		Description: "An audit log export created by cape audit export.",
+		Required:    true,
+		Processor: func(in string) (interface{}, error) {
+			return in, nil
+		},
+	}
+
 	RoleArg = &Argument{
 		Name:        "role",
 		Description: "The role you wish to assign.",
 		Required:    true,
 		Processor: func(in string) (interface{}, error) {
-			for _, r := range models.SystemRoles {
-				if in == r.String() {
-					return models.Label(in), nil
-				}
+			// Custom roles are checked by the coordinator
+			if in == "" {
+				return nil, fmt.Errorf("invalid role specified")
 			}
 
-			return nil, fmt.Errorf("invalid role specified")
+			return models.Label(in), nil
 		},
 	}
 )
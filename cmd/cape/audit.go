@@ -0,0 +1,338 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator/audit"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func init() {
+	listCmd := &Command{
+		Usage: "List the security relevant actions recorded in the audit log.",
+		Examples: []*Example{
+			{
+				Example:     "cape audit list",
+				Description: "Lists the 50 most recent audit events",
+			},
+			{
+				Example:     "cape audit list --actor friend@cape.com --since 24h",
+				Description: "Lists the actions friend@cape.com performed in the last day",
+			},
+			{
+				Example:     "cape audit list --action login --target friend@cape.com",
+				Description: "Lists the attempts to login as friend@cape.com",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(auditListCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				auditActorFlag(),
+				auditActionFlag(),
+				auditTargetFlag(),
+				auditSinceFlag(),
+				auditLimitFlag(),
+			},
+		},
+	}
+
+	exportCmd := &Command{
+		Usage: "Export the audit log along with its signed checkpoints so it can be verified.",
+		Examples: []*Example{
+			{
+				Example:     "cape audit export --out audit.json",
+				Description: "Exports every audit event and checkpoint to audit.json",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "export",
+			Action: handleSessionOverrides(auditExportCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				auditExportOutFlag(),
+			},
+		},
+	}
+
+	verifyCmd := &Command{
+		Usage:     "Verify the hash chain and checkpoints of an exported audit log, reporting the first broken link.",
+		Arguments: []*Argument{AuditExportFileArg},
+		Examples: []*Example{
+			{
+				Example:     "cape audit verify --jwks jwks.json audit.json",
+				Description: "Verifies that no events in audit.json have been changed or removed using the signing keys saved in jwks.json",
+			},
+			{
+				Example:     "cape audit verify --public-key <key id>=<public key> audit.json",
+				Description: "Verifies audit.json using a single trusted signing key",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "verify",
+			Action: auditVerifyCmd,
+			Flags: []cli.Flag{
+				auditJWKSFlag(),
+				auditPublicKeyFlag(),
+			},
+		},
+	}
+
+	auditCmd := &Command{
+		Usage: "Commands for reviewing the audit log.",
+		Command: &cli.Command{
+			Name: "audit",
+			Subcommands: []*cli.Command{
+				listCmd.Package(),
+				exportCmd.Package(),
+				verifyCmd.Package(),
+			},
+		},
+	}
+
+	commands = append(commands, auditCmd.Package())
+}
+
+func auditListCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	filter := model.AuditEventFilter{}
+	if actor := c.String("actor"); actor != "" {
+		email := models.Email(actor)
+		filter.ActorEmail = &email
+	}
+
+	if action := c.String("action"); action != "" {
+		filter.Action = &action
+	}
+
+	if target := c.String("target"); target != "" {
+		filter.Target = &target
+	}
+
+	if since := c.String("since"); since != "" {
+		t, err := parseSince(since)
+		if err != nil {
+			return err
+		}
+
+		filter.Since = &t
+	}
+
+	events, err := client.ListAuditEvents(c.Context, filter, c.Int("limit"))
+	if err != nil {
+		return err
+	}
+
+	header := []string{"Time", "Actor", "Action", "Target", "Outcome", "Request ID"}
+	body := make([][]string, len(events))
+	for i, e := range events {
+		actor := e.ActorEmail.String()
+		if actor == "" {
+			actor = "-"
+		}
+
+		body[i] = []string{
+			e.CreatedAt.Format(time.RFC1123),
+			actor,
+			e.Action,
+			e.Target,
+			e.Outcome.String(),
+			e.RequestID,
+		}
+	}
+
+	u := provider.UI(c.Context)
+	err = u.Table(header, body)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} event{{ . | pluralize \"s\"}}\n", len(events))
+}
+
+func auditExportCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	export, err := client.ExportAudit(c.Context)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	out := c.String("out")
+	err = ioutil.WriteFile(out, b, 0600)
+	if err != nil {
+		return errors.New(CreateFileCause, "Unable to create file %s", out)
+	}
+
+	u := provider.UI(c.Context)
+	return u.Template("Exported {{ .events | toString | faded }} events and {{ .checkpoints | toString | faded }} checkpoints to {{ .out }}\n", map[string]interface{}{
+		"events":      len(export.Events),
+		"checkpoints": len(export.Checkpoints),
+		"out":         out,
+	})
+}
+
+func auditVerifyCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	file := Arguments(c.Context, AuditExportFileArg).(string)
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var export audit.Export
+	err = json.Unmarshal(b, &export)
+	if err != nil {
+		return errors.New(InvalidAuditExportCause, "Could not read audit export %s: %s", file, err)
+	}
+
+	keys, err := trustedKeys(c.StringSlice("jwks"), c.StringSlice("public-key"))
+	if err != nil {
+		return err
+	}
+
+	if len(export.Checkpoints) > 0 && len(keys) == 0 {
+		return errors.New(MissingTrustedKeysCause, "Checkpoints can only be verified with trusted signing keys, provide them using --jwks or --public-key")
+	}
+
+	res := audit.Verify(export, keys)
+	if res.Break != nil {
+		return errors.New(AuditChainBrokenCause, "Audit chain is broken at event %d: %s", res.Break.Sequence, res.Break.Reason)
+	}
+
+	u := provider.UI(c.Context)
+	var last int64
+	if len(export.Events) > 0 {
+		last = export.Events[len(export.Events)-1].Sequence
+	}
+
+	if res.Checkpoints == 0 {
+		err = u.Notify(ui.Warn, "The export has no checkpoints, events removed from the end of the chain can't be detected")
+		if err != nil {
+			return err
+		}
+	} else if last > res.LastCheckpoint {
+		err = u.Notify(ui.Warn, "%d events after the last checkpoint aren't covered by a signature", last-res.LastCheckpoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	details := ui.Details{
+		"Events":          strconv.Itoa(res.Events),
+		"Unchained":       strconv.Itoa(res.Unchained),
+		"Checkpoints":     strconv.Itoa(res.Checkpoints),
+		"Last Checkpoint": strconv.FormatInt(res.LastCheckpoint, 10),
+		"Signing Keys":    strings.Join(res.KeyIDs, ", "),
+	}
+
+	err = u.Details(details)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nThe audit chain is intact\n", nil)
+}
+
+// trustedKeys reads the keys audit checkpoints can be signed with from JSON
+// Web Key Set files and <key id>=<public key> pairs
+func trustedKeys(jwksFiles []string, publicKeys []string) (audit.TrustedKeys, error) {
+	keys := audit.TrustedKeys{}
+	for _, file := range jwksFiles {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var set jose.JSONWebKeySet
+		err = json.Unmarshal(b, &set)
+		if err != nil {
+			return nil, errors.New(InvalidTrustedKeyCause, "Could not read JSON Web Key Set %s: %s", file, err)
+		}
+
+		for id, key := range audit.KeysFromJWKS(set) {
+			keys[id] = key
+		}
+	}
+
+	for _, in := range publicKeys {
+		parts := strings.SplitN(in, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.New(InvalidTrustedKeyCause, "Invalid public key '%s', expected <key id>=<public key>", in)
+		}
+
+		key, err := decodePublicKey(parts[1])
+		if err != nil {
+			return nil, errors.New(InvalidTrustedKeyCause, "Invalid public key for %s: %s", parts[0], err)
+		}
+
+		keys[parts[0]] = key
+	}
+
+	return keys, nil
+}
+
+// decodePublicKey accepts ed25519 public keys in either of the base64
+// encodings, JSON Web Keys use the unpadded url encoding
+func decodePublicKey(in string) (ed25519.PublicKey, error) {
+	for _, enc := range []*base64.Encoding{base64.RawURLEncoding, base64.URLEncoding, base64.StdEncoding, base64.RawStdEncoding} {
+		b, err := enc.DecodeString(in)
+		if err != nil {
+			continue
+		}
+
+		if len(b) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("expected a %d byte key but found %d bytes", ed25519.PublicKeySize, len(b))
+		}
+
+		return ed25519.PublicKey(b), nil
+	}
+
+	return nil, fmt.Errorf("key is not base64 encoded")
+}
+
+// parseSince parses either a duration before now or a date
+func parseSince(in string) (time.Time, error) {
+	d, err := time.ParseDuration(in)
+	if err == nil {
+		return time.Now().UTC().Add(-d), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		t, err := time.Parse(layout, in)
+		if err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.New(InvalidSinceCause, "Invalid since '%s', expected a duration such as 24h or a date such as 2021-01-31", in)
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/audit"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestAuditList(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	event := models.NewAuditEvent("request-id", "setOrgRole")
+	event.ActorEmail = "admin@cape.com"
+	event.Target = "friend@cape.com"
+
+	login := models.NewAuditEvent("other-request-id", "login")
+	login.Target = "friend@cape.com"
+	login.Outcome = models.AuditFailure
+
+	resp := struct {
+		Events []*models.AuditEvent `json:"auditEvents"`
+	}{Events: []*models.AuditEvent{&event, &login}}
+
+	t.Run("Lists events", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+		err := app.Run([]string{"cape", "audit", "list"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+
+		body := u.Calls[0].Args[1].(ui.TableBody)
+		gm.Expect(body[0][1:]).To(gm.Equal([]string{"admin@cape.com", "setOrgRole", "friend@cape.com", "success", "request-id"}))
+		gm.Expect(body[1][1:]).To(gm.Equal([]string{"-", "login", "friend@cape.com", "failure", "other-request-id"}))
+	})
+
+	t.Run("Accepts filters", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+		err := app.Run([]string{"cape", "audit", "list", "--actor", "admin@cape.com", "--action", "setOrgRole",
+			"--since", "2020-06-01", "--limit", "10"})
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("Rejects an invalid since", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "audit", "list", "--since", "last tuesday"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
+
+func testAuditChain(n int) []models.AuditEvent {
+	events := make([]models.AuditEvent, n)
+	for i := range events {
+		event := models.NewAuditEvent("request-id", "createProject")
+		event.Sequence = int64(i + 1)
+		if i > 0 {
+			event.PrevHash = events[i-1].Hash
+		}
+
+		event.Hash = event.ComputeHash()
+		events[i] = event
+	}
+
+	return events
+}
+
+func TestAuditExport(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	events := testAuditChain(2)
+	docs := make([]string, len(events))
+	for i, e := range events {
+		b, err := json.Marshal(e)
+		gm.Expect(err).To(gm.BeNil())
+		docs[i] = string(b)
+	}
+
+	checkpoints := struct {
+		Checkpoints []string `json:"auditCheckpoints"`
+	}{}
+
+	chain := func(docs []string) interface{} {
+		return struct {
+			Chain []string `json:"auditChain"`
+		}{Chain: docs}
+	}
+
+	dir, err := ioutil.TempDir("", "cape-audit")
+	gm.Expect(err).To(gm.BeNil())
+	defer os.RemoveAll(dir)
+
+	out := filepath.Join(dir, "audit.json")
+	app, u := NewHarness([]*coordinator.MockResponse{
+		{Value: checkpoints},
+		{Value: chain(docs)},
+		{Value: chain(nil)},
+	})
+
+	err = app.Run([]string{"cape", "audit", "export", "--out", out})
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+
+	b, err := ioutil.ReadFile(out)
+	gm.Expect(err).To(gm.BeNil())
+
+	var export audit.Export
+	err = json.Unmarshal(b, &export)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(export.Events)).To(gm.Equal(2))
+	gm.Expect(export.Events[1].ComputeHash()).To(gm.Equal(events[1].Hash))
+}
+
+func TestAuditVerify(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	gm.Expect(err).To(gm.BeNil())
+
+	events := testAuditChain(3)
+	checkpoint := models.NewAuditCheckpoint(events[2])
+	checkpoint.KeyID = "key-id"
+	checkpoint.PublicKey = pub
+	checkpoint.Signature = ed25519.Sign(priv, checkpoint.Message())
+
+	dir, err := ioutil.TempDir("", "cape-audit")
+	gm.Expect(err).To(gm.BeNil())
+	defer os.RemoveAll(dir)
+
+	write := func(export audit.Export) string {
+		b, err := json.Marshal(export)
+		gm.Expect(err).To(gm.BeNil())
+
+		f := filepath.Join(dir, "audit.json")
+		err = ioutil.WriteFile(f, b, 0600)
+		gm.Expect(err).To(gm.BeNil())
+		return f
+	}
+
+	jwks := filepath.Join(dir, "jwks.json")
+	b, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: pub, KeyID: "key-id", Algorithm: string(jose.EdDSA), Use: "sig"},
+	}})
+	gm.Expect(err).To(gm.BeNil())
+	err = ioutil.WriteFile(jwks, b, 0600)
+	gm.Expect(err).To(gm.BeNil())
+
+	// Values of repeated flags are kept between runs of the app so the tests
+	// without trusted keys run first
+	t.Run("Requires trusted keys to verify checkpoints", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		f := write(audit.Export{Events: events, Checkpoints: []models.AuditCheckpoint{checkpoint}})
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "audit", "verify", f})
+		gm.Expect(errors.CausedBy(err, MissingTrustedKeysCause)).To(gm.BeTrue())
+	})
+
+	t.Run("Rejects checkpoints signed by an unknown key", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		f := write(audit.Export{Events: events, Checkpoints: []models.AuditCheckpoint{checkpoint}})
+
+		other, _, err := ed25519.GenerateKey(rand.Reader)
+		gm.Expect(err).To(gm.BeNil())
+
+		app, _ := NewHarness(nil)
+		err = app.Run([]string{"cape", "audit", "verify",
+			"--public-key", "other-key=" + base64.StdEncoding.EncodeToString(other), f})
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("was signed by unknown key key-id"))
+	})
+
+	t.Run("Verifies an intact export", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		f := write(audit.Export{Events: events, Checkpoints: []models.AuditCheckpoint{checkpoint}})
+
+		app, u := NewHarness(nil)
+		err := app.Run([]string{"cape", "audit", "verify", "--jwks", jwks, f})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("details"))
+		details := u.Calls[0].Args[0].(ui.Details)
+		gm.Expect(details["Events"]).To(gm.Equal("3"))
+		gm.Expect(details["Signing Keys"]).To(gm.Equal("key-id"))
+	})
+
+	t.Run("Reports the first broken link", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		tampered := make([]models.AuditEvent, len(events))
+		copy(tampered, events)
+		tampered[1].Target = "someone-else"
+
+		f := write(audit.Export{Events: tampered, Checkpoints: []models.AuditCheckpoint{checkpoint}})
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "audit", "verify", "--jwks", jwks, f})
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.Equal(
+			"audit_chain_broken: Audit chain is broken at event 2: hash does not match the contents of the event"))
+	})
+
+	t.Run("Verifies using a public key", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		f := write(audit.Export{Events: events, Checkpoints: []models.AuditCheckpoint{checkpoint}})
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "audit", "verify",
+			"--public-key", "key-id=" + base64.RawURLEncoding.EncodeToString(pub), f})
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("Ignores the key stored in the checkpoint", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		forgerPub, forgerPriv, err := ed25519.GenerateKey(rand.Reader)
+		gm.Expect(err).To(gm.BeNil())
+
+		forged := checkpoint
+		forged.PublicKey = forgerPub
+		forged.Signature = ed25519.Sign(forgerPriv, forged.Message())
+
+		f := write(audit.Export{Events: events, Checkpoints: []models.AuditCheckpoint{forged}})
+
+		app, _ := NewHarness(nil)
+		err = app.Run([]string{"cape", "audit", "verify", "--jwks", jwks, f})
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(errors.CausedBy(err, AuditChainBrokenCause)).To(gm.BeTrue())
+	})
+
+	t.Run("Warns when there are no checkpoints", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		f := write(audit.Export{Events: events})
+
+		app, u := NewHarness(nil)
+		err := app.Run([]string{"cape", "audit", "verify", f})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("notify"))
+	})
+}
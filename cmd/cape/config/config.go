@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"time"
 
 	"github.com/manifoldco/go-base64"
 	"sigs.k8s.io/yaml"
@@ -237,6 +238,11 @@ type Cluster struct {
 	URL       *models.URL  `json:"url"`
 	Label     models.Label `json:"label"`
 	CertFile  string       `json:"tls_cert,omitempty"`
+
+	// RefreshToken is used to renew the session identified by the AuthToken
+	// before it expires at ExpiresAt
+	RefreshToken string     `json:"refresh_token,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
 }
 
 // Validate returns an error if the cluster configuration is invalid
@@ -266,8 +272,11 @@ func (c *Cluster) Token() (*base64.Value, error) {
 	return base64.NewFromString(c.AuthToken)
 }
 
-// SetToken sets the token on the cluster
+// SetToken sets the token on the cluster, removing any refresh token
 func (c *Cluster) SetToken(token *base64.Value) {
+	c.RefreshToken = ""
+	c.ExpiresAt = nil
+
 	if token == nil {
 		c.AuthToken = ""
 		return
@@ -276,6 +285,19 @@ func (c *Cluster) SetToken(token *base64.Value) {
 	c.AuthToken = token.String()
 }
 
+// SetSession sets the token and refresh token from the session on the
+// cluster
+func (c *Cluster) SetSession(session *models.Session) {
+	c.SetToken(session.Token)
+	if session.RefreshToken == nil {
+		return
+	}
+
+	expiresAt := session.ExpiresAt
+	c.RefreshToken = session.RefreshToken.String()
+	c.ExpiresAt = &expiresAt
+}
+
 // String completes the Stringer interface
 func (c *Cluster) String() string {
 	return fmt.Sprintf("%s (%s)", c.Label, c.URL.String())
@@ -294,7 +316,23 @@ func (c *Cluster) Transport() (coordinator.ClientTransport, error) {
 		return nil, err
 	}
 
-	return coordinator.NewHTTPTransport(clusterURL, token, c.CertFile), nil
+	transport := coordinator.NewHTTPTransport(clusterURL, token, c.CertFile)
+	if c.RefreshToken == "" || c.ExpiresAt == nil {
+		return transport, nil
+	}
+
+	refreshToken, err := base64.NewFromString(c.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	transport.SetSession(&models.Session{
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresAt:    *c.ExpiresAt,
+	})
+
+	return transport, nil
 }
 
 // Path returns the path to local configuration yaml file.
@@ -16,6 +16,7 @@ import (
 
 	"github.com/capeprivacy/cape/cmd/cape/ui"
 	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
 	"github.com/capeprivacy/cape/coordinator/mailer"
 	"github.com/capeprivacy/cape/framework"
 	"github.com/capeprivacy/cape/logging"
@@ -83,11 +84,99 @@ func init() {
 		},
 	}
 
+	rotateSigningKeyCmd := &Command{
+		Usage: "Replaces the key used to sign session tokens.",
+		Description: "Generates a new signing key and stores it in the coordinator's database. " +
+			"Running coordinators start signing with the new key within a minute, tokens signed by " +
+			"the previous key are accepted until the retirement period has passed.",
+		Examples: []*Example{
+			{
+				Example:     "cape coordinator rotate-signing-key --file config.yaml",
+				Description: "Rotates the signing key of the coordinator configured by config.yaml.",
+			},
+			{
+				Example:     "cape coordinator rotate-signing-key --file config.yaml --retire-after 0s",
+				Description: "Rotates the signing key and immediately stops accepting tokens signed by the previous key, signing everyone out.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "rotate-signing-key",
+			Action: rotateSigningKeyCmd,
+			Flags: []cli.Flag{
+				configFilesFlag(),
+				retireAfterFlag(),
+			},
+		},
+	}
+
+	rotateKeysCmd := &Command{
+		Usage: "Rotates the root key and the data key used to encrypt secrets in the database.",
+		Description: "Re-wraps the keys stored in the coordinator's database with a new root key and, with --reencrypt, " +
+			"re-encrypts every row under a fresh data key in batches, including project specs stored before specs " +
+			"were encrypted. An interrupted re-encryption is resumed by " +
+			"running the command again. Coordinators only load their keys at start up so they should be stopped " +
+			"while keys are rotated and started with the new root key afterwards.",
+		Examples: []*Example{
+			{
+				Example:     "cape coordinator rotate-keys --file config.yaml --new-root-key base64key://...",
+				Description: "Wraps the data and signing keys with a new root key.",
+			},
+			{
+				Example:     "cape coordinator rotate-keys --file config.yaml --rewrap",
+				Description: "Re-wraps the keys with the latest version of a Vault transit root key.",
+			},
+			{
+				Example:     "cape coordinator rotate-keys --file config.yaml --reencrypt",
+				Description: "Re-encrypts every row with a new data key.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "rotate-keys",
+			Action: rotateKeysCmd,
+			Flags: []cli.Flag{
+				configFilesFlag(),
+				newRootKeyFlag(),
+				rewrapFlag(),
+				reencryptFlag(),
+				batchSizeFlag(),
+			},
+		},
+	}
+
+	generateKeyCmd := &Command{
+		Usage: "Generates a root key and writes it to a file.",
+		Description: "Writes a new random root key, base64 encoded, to a file that only its owner can read or write. " +
+			"Point the coordinator at it by setting root_key to the printed file:// url, or load the file's contents " +
+			"into an environment variable and set root_key to an env:// url naming it. Key files are read again " +
+			"when they change, when mounting one from a Kubernetes secret set the volume's defaultMode to 0400.",
+		Arguments: []*Argument{KeyFileArg},
+		Examples: []*Example{
+			{
+				Example:     "cape coordinator generate-key /etc/cape/root.key",
+				Description: "Generates a root key in /etc/cape/root.key for a coordinator whose root_key is file:///etc/cape/root.key.",
+			},
+			{
+				Example:     "cape coordinator generate-key root.key && export CAPE_ROOT_KEY=$(cat root.key)",
+				Description: "Generates a root key and exports it for a coordinator whose root_key is env://CAPE_ROOT_KEY.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "generate-key",
+			Action: generateKeyCmd,
+		},
+	}
+
 	coordinatorCmd := &Command{
 		Usage: "Commands for starting and managing Cape coordinators.",
 		Command: &cli.Command{
-			Name:        "coordinator",
-			Subcommands: []*cli.Command{startCmd.Package(), configureCmd.Package()},
+			Name: "coordinator",
+			Subcommands: []*cli.Command{
+				startCmd.Package(),
+				configureCmd.Package(),
+				rotateSigningKeyCmd.Package(),
+				rotateKeysCmd.Package(),
+				generateKeyCmd.Package(),
+			},
 		},
 	}
 
@@ -321,6 +410,115 @@ func startCoordinatorCmd(c *cli.Context) error {
 	return server.Start(c.Context)
 }
 
+func rotateSigningKeyCmd(c *cli.Context) error {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return err
+	}
+
+	err = envconfig.Process("cape", cfg)
+	if err != nil {
+		return err
+	}
+
+	retireAfter := coordinator.DefaultSigningKeyRetirement(cfg)
+	if c.IsSet("retire-after") {
+		retireAfter = c.Duration("retire-after")
+	}
+
+	id, err := coordinator.RotateSigningKey(c.Context, cfg, retireAfter)
+	if err != nil {
+		return err
+	}
+
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	return u.Template("Rotated the signing key, the new key id is {{ . | bold }}.\n", id)
+}
+
+func rotateKeysCmd(c *cli.Context) error {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return err
+	}
+
+	err = envconfig.Process("cape", cfg)
+	if err != nil {
+		return err
+	}
+
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	res, err := coordinator.RotateKeys(c.Context, cfg, coordinator.RotateKeysOptions{
+		NewRootKey: c.String("new-root-key"),
+		Rewrap:     c.Bool("rewrap"),
+		Reencrypt:  c.Bool("reencrypt"),
+		BatchSize:  c.Int("batch-size"),
+		Progress: func(table string, rows int) {
+			u.Template("Re-encrypted {{ .rows | toString | faded }} rows in {{ .table }}\n", map[string]interface{}{ // nolint: errcheck
+				"table": table,
+				"rows":  rows,
+			})
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.Resumed {
+		err = u.Template("Resumed re-encrypting with data key {{ . | bold }}.\n", res.DataKeyID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Bool("reencrypt") {
+		err = u.Template("Every row is now encrypted with data key {{ . | bold }}.\n", res.DataKeyID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if res.Rewrapped {
+		err = u.Template("The keys are now wrapped by the latest version of the root key.\n", nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if res.RootKeyRotated {
+		return u.Notify(ui.Warn, "The keys are now wrapped by the new root key, update the root key in your configuration before starting the coordinator.")
+	}
+
+	return nil
+}
+
+func generateKeyCmd(c *cli.Context) error {
+	path := Arguments(c.Context, KeyFileArg).(string)
+
+	err := crypto.WriteKeyFile(path)
+	if err != nil {
+		return err
+	}
+
+	keyURL, err := crypto.NewFileKeyURL(path)
+	if err != nil {
+		return err
+	}
+
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	err = u.Template("Generated a root key, set the coordinator's root_key to {{ . | bold }}\n", keyURL.String())
+	if err != nil {
+		return err
+	}
+
+	return u.Notify(ui.Warn, "%s holds the root key, keep it safe and back it up. Losing it makes the coordinator's data unrecoverable.", path)
+}
+
 type FormatType string
 
 func (f FormatType) String() string {
@@ -114,3 +114,43 @@ func TestCoordinatorConfiguration(t *testing.T) {
 		gm.Expect(cfg.DB.Addr.String()).To(gm.Equal(url))
 	})
 }
+
+func TestCoordinatorGenerateKey(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	tmpDir, err := ioutil.TempDir("", "cape-test-tempdir")
+	gm.Expect(err).To(gm.BeNil())
+	defer os.RemoveAll(tmpDir)
+
+	file := path.Join(tmpDir, "root.key")
+
+	t.Run("Writes a key only its owner can read", func(t *testing.T) {
+		app, u := NewHarness(nil)
+
+		err := app.Run([]string{"cape", "coordinator", "generate-key", file})
+		gm.Expect(err).To(gm.BeNil())
+
+		info, err := os.Stat(file)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(info.Mode().Perm()).To(gm.Equal(os.FileMode(0600)))
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal("file://" + file))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("notify"))
+	})
+
+	t.Run("Won't overwrite an existing key", func(t *testing.T) {
+		before, err := ioutil.ReadFile(file)
+		gm.Expect(err).To(gm.BeNil())
+
+		app, _ := NewHarness(nil)
+
+		err = app.Run([]string{"cape", "coordinator", "generate-key", file})
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		after, err := ioutil.ReadFile(file)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(after).To(gm.Equal(before))
+	})
+}
@@ -13,7 +13,52 @@ var (
 	// or does not exist
 	NoUserCause = errors.NewCause(errors.BadRequestCategory, "user_not_found")
 
+	// PasswordMismatchCause happens when a password and its confirmation do not match
+	PasswordMismatchCause = errors.NewCause(errors.BadRequestCategory, "password_mismatch")
+
+	// NothingToUpdateCause happens when an update command is run without anything to update
+	NothingToUpdateCause = errors.NewCause(errors.BadRequestCategory, "nothing_to_update")
+
+	// InvalidMembershipCause happens when a project membership is not in the form <project-label>:<role>
+	InvalidMembershipCause = errors.NewCause(errors.BadRequestCategory, "invalid_membership")
+
 	InvalidPortCause = errors.NewCause(errors.BadRequestCategory, "invalid_port")
 
 	CreateFileCause = errors.NewCause(errors.BadRequestCategory, "create_file")
+
+	// InvalidScopeCause happens when a token is created with an unknown
+	// permission in its scope
+	InvalidScopeCause = errors.NewCause(errors.BadRequestCategory, "invalid_scope")
+
+	// InvalidRolePermissionCause happens when a role is created with an
+	// unknown permission
+	InvalidRolePermissionCause = errors.NewCause(errors.BadRequestCategory, "invalid_role_permission")
+
+	// InvalidExpiryCause happens when a token expiry isn't a duration or
+	// date
+	InvalidExpiryCause = errors.NewCause(errors.BadRequestCategory, "invalid_expiry")
+
+	// MissingCodeCause happens when a one-time code is required but an empty
+	// one was entered
+	MissingCodeCause = errors.NewCause(errors.BadRequestCategory, "missing_code")
+
+	// InvalidSinceCause happens when listing audit events since something
+	// that isn't a duration or date
+	InvalidSinceCause = errors.NewCause(errors.BadRequestCategory, "invalid_since")
+
+	// InvalidAuditExportCause happens when verifying a file that isn't an
+	// audit export
+	InvalidAuditExportCause = errors.NewCause(errors.BadRequestCategory, "invalid_audit_export")
+
+	// AuditChainBrokenCause happens when an exported audit chain has been
+	// changed or events have been removed from it
+	AuditChainBrokenCause = errors.NewCause(errors.BadRequestCategory, "audit_chain_broken")
+
+	// InvalidTrustedKeyCause happens when a key given to verify audit
+	// checkpoints with can't be read
+	InvalidTrustedKeyCause = errors.NewCause(errors.BadRequestCategory, "invalid_trusted_key")
+
+	// MissingTrustedKeysCause happens when verifying an audit export with
+	// checkpoints without any keys to verify them with
+	MissingTrustedKeysCause = errors.NewCause(errors.BadRequestCategory, "missing_trusted_keys")
 )
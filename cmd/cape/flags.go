@@ -6,7 +6,9 @@ import (
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/capeprivacy/cape/coordinator"
 	"github.com/capeprivacy/cape/logging"
+	"github.com/capeprivacy/cape/models"
 )
 
 func portFlag(name string, value int) cli.Flag {
@@ -98,6 +100,171 @@ func emailFlag() cli.Flag {
 	}
 }
 
+func ssoFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "sso",
+		Usage: "Sign in through your organization's identity provider using a web browser.",
+	}
+}
+
+func codeFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "code",
+		Usage: "A one-time code from your authenticator app or one of your recovery codes.",
+	}
+}
+
+func tokenNameFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "name",
+		Usage: "A name to help identify the token, for example where it is used.",
+	}
+}
+
+func tokenExpiresFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "expires",
+		Usage: "When the token expires, either a duration such as 720h or a date such as 2021-01-31. Tokens don't expire by default.",
+	}
+}
+
+func tokenScopeFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name: "scope",
+		Usage: fmt.Sprintf("A permission to limit the token to, can be repeated. Tokens have all of the user's permissions by default (options: %s)",
+			strings.Join(models.PermissionNames(), ", ")),
+	}
+}
+
+func roleKindFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "kind",
+		Usage: "Whether the role is assigned within the org or within a project (options: org, project)",
+		Value: models.OrgRoleKind.String(),
+	}
+}
+
+func rolePermissionFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:     "permission",
+		Required: true,
+		Usage: fmt.Sprintf("A permission granted by the role, can be repeated (options: %s)",
+			strings.Join(models.PermissionNames(), ", ")),
+	}
+}
+
+func teamMaintainerFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "maintainer",
+		Usage: "If specified, the member can add and remove the other members of the team.",
+	}
+}
+
+func teamProjectFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     "project",
+		Usage:    "The label identifier for the project the team contributes to.",
+		Required: true,
+		EnvVars:  []string{"CAPE_PROJECT_LABEL"},
+	}
+}
+
+func auditActorFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "actor",
+		Usage: "Only list events performed by the user with this email.",
+	}
+}
+
+func auditActionFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "action",
+		Usage: "Only list events for this action, either the name of a mutation such as setOrgRole or login.",
+	}
+}
+
+func auditTargetFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "target",
+		Usage: "Only list events acting on this target, such as a project label or user email.",
+	}
+}
+
+func auditSinceFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "since",
+		Usage: "Only list events since this long ago, either a duration such as 24h or a date such as 2021-01-31.",
+	}
+}
+
+func auditLimitFlag() cli.Flag {
+	return &cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum number of events to list.",
+		Value: 50,
+	}
+}
+
+func auditExportOutFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "out",
+		Aliases: []string{"o"},
+		Usage:   "Where to write the audit export.",
+		Value:   "audit.json",
+	}
+}
+
+func auditJWKSFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  "jwks",
+		Usage: "A JSON Web Key Set file holding keys checkpoints can be signed with, such as one saved from the coordinator's /v1/jwks endpoint, can be repeated",
+	}
+}
+
+func auditPublicKeyFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  "public-key",
+		Usage: "A key checkpoints can be signed with as <key id>=<base64 ed25519 public key>, can be repeated",
+	}
+}
+
+func userNameFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "name",
+		Usage: "The new name for the user.",
+	}
+}
+
+func userEmailFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "email",
+		Usage: "The new email for the user. The change must be confirmed before it takes effect.",
+	}
+}
+
+func orgRoleFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "role",
+		Usage: "The org role to grant the user.",
+		Value: models.UserRole.String(),
+	}
+}
+
+func inviteProjectFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  "project",
+		Usage: "A project membership to grant the user in the form <project-label>:<role>. Can be repeated.",
+	}
+}
+
+func recoveryIDFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     "id",
+		Usage:    "The ID of the recovery sent to your email address.",
+		Required: true,
+	}
+}
+
 func clusterFlag() cli.Flag {
 	usage := "The cluster to login to."
 	return &cli.StringFlag{
@@ -116,6 +283,43 @@ func configFilesFlag() cli.Flag {
 	}
 }
 
+func retireAfterFlag() cli.Flag {
+	return &cli.DurationFlag{
+		Name:  "retire-after",
+		Usage: "How long tokens signed by the previous key are still accepted for. Defaults to the session lifetime.",
+	}
+}
+
+func newRootKeyFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "new-root-key",
+		Usage:   "The url of the root key to wrap the coordinator's keys with, such as base64key://..., file:///..., env://..., azurekeyvault://..., awskms://..., gcpkms://... or vault://...",
+		EnvVars: []string{"CAPE_NEW_ROOT_KEY"},
+	}
+}
+
+func rewrapFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "rewrap",
+		Usage: "Re-wrap the keys with the latest version of the root key, for root keys that support it such as vault://...",
+	}
+}
+
+func reencryptFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "reencrypt",
+		Usage: "Generate a new data key and re-encrypt every row with it, resuming any unfinished re-encryption.",
+	}
+}
+
+func batchSizeFlag() cli.Flag {
+	return &cli.IntFlag{
+		Name:  "batch-size",
+		Usage: "The number of rows to re-encrypt in each transaction.",
+		Value: coordinator.DefaultReencryptBatchSize,
+	}
+}
+
 func configFileOutFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:    "out",
@@ -165,6 +369,13 @@ func projectDescriptionFlag() cli.Flag {
 	}
 }
 
+func secretVersionFlag() cli.Flag {
+	return &cli.IntFlag{
+		Name:  "version",
+		Usage: "The `VERSION` of the secret, the latest version is used if omitted.",
+	}
+}
+
 func projectSpecFlag() cli.Flag {
 	return &cli.StringFlag{
 		Name:    "from-spec",
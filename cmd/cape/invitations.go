@@ -0,0 +1,171 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func parseMemberships(in []string) ([]models.InvitationProject, error) {
+	projects := make([]models.InvitationProject, len(in))
+	for i, membership := range in {
+		parts := strings.Split(membership, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.New(InvalidMembershipCause, "Invalid project membership '%s', expected <project-label>:<role>", membership)
+		}
+
+		projects[i] = models.InvitationProject{
+			Label: models.Label(parts[0]),
+			Role:  models.Label(parts[1]),
+		}
+	}
+
+	return projects, nil
+}
+
+func usersInviteCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	email := Arguments(c.Context, UserEmailArg).(models.Email)
+	projects, err := parseMemberships(c.StringSlice("project"))
+	if err != nil {
+		return err
+	}
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := client.CreateInvitation(c.Context, email, models.Label(c.String("role")), projects)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("An invitation has been sent to {{ .Email | bold }} and expires on {{ .ExpiresAt | faded }}\n", struct {
+		Email     string
+		ExpiresAt string
+	}{
+		invitation.Email.String(),
+		invitation.ExpiresAt.Format(time.RFC1123),
+	})
+}
+
+func usersAcceptCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := Arguments(c.Context, InvitationIDArg).(string)
+	secret, err := u.Secret("Please enter the secret from your invitation", nil)
+	if err != nil {
+		return err
+	}
+
+	name, err := getName(c, "")
+	if err != nil {
+		return err
+	}
+
+	password, err := getNewPassword(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.AcceptInvitation(c.Context, ID, models.Password(secret), name, password)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Your account has been created! You can now log in using {{ \"cape login\" | bold }}\n", nil)
+}
+
+func invitationsListCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	invitations, err := client.Invitations(c.Context)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"ID", "Email", "Role", "Projects", "Invited By", "Expires At"}
+	body := make([][]string, len(invitations))
+	for i, invitation := range invitations {
+		projects := make([]string, len(invitation.Projects))
+		for j, p := range invitation.Projects {
+			projects[j] = p.Label.String() + ":" + p.Role.String()
+		}
+
+		invitedBy := ""
+		if invitation.InvitedBy != nil {
+			invitedBy = invitation.InvitedBy.Email.String()
+		}
+
+		body[i] = []string{
+			invitation.ID,
+			invitation.Email.String(),
+			invitation.Role.String(),
+			strings.Join(projects, ", "),
+			invitedBy,
+			invitation.ExpiresAt.Format(time.RFC1123),
+		}
+	}
+
+	err = u.Table(header, body)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} invitation{{ . | pluralize \"s\"}}\n", len(invitations))
+}
+
+func invitationsResendCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := Arguments(c.Context, InvitationIDArg).(string)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := client.ResendInvitation(c.Context, ID)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("The invitation has been resent to {{ . | bold }}\n", invitation.Email.String())
+}
+
+func invitationsRevokeCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := Arguments(c.Context, InvitationIDArg).(string)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.RevokeInvitation(c.Context, ID)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Revoked the invitation with ID {{ . | toString | faded }}\n", ID)
+}
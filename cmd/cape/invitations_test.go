@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestInvitations(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	admin := &models.User{
+		ID:    models.NewID(),
+		Email: models.Email("admin@cape.com"),
+	}
+
+	invitation := models.NewInvitation("invited@cape.com", models.UserRole, []models.InvitationProject{
+		{Label: "my-project", Role: models.ProjectReaderRole},
+	}, admin.ID, models.GenerateCredentials())
+
+	t.Run("Can invite a user", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.CreateInvitationResponse{
+					Invitation: coordinator.InvitationResponse{Invitation: &invitation},
+				},
+			},
+		})
+		err := app.Run([]string{"cape", "users", "invite", "--project", "my-project:project-reader", "invited@cape.com"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Can't invite with an invalid project membership", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "invite", "--project", "my-project", "invited@cape.com"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can accept an invitation", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "accept", invitation.ID})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(5))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("question"))
+		gm.Expect(u.Calls[2].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[3].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[4].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Can list invitations", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.ListInvitationsResponse{
+					Invitations: []coordinator.InvitationResponse{
+						{Invitation: &invitation, InvitedBy: admin},
+					},
+				},
+			},
+		})
+		err := app.Run([]string{"cape", "users", "invitations", "list"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(ui.TableBody{{
+			invitation.ID,
+			"invited@cape.com",
+			"user",
+			"my-project:project-reader",
+			"admin@cape.com",
+			invitation.ExpiresAt.Format(time.RFC1123),
+		}}))
+
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[1].Args[1]).To(gm.Equal(1))
+	})
+
+	t.Run("Can revoke an invitation", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "invitations", "revoke", invitation.ID})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(invitation.ID))
+	})
+}
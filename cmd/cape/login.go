@@ -1,19 +1,48 @@
 package main
 
 import (
+	"os/exec"
+	"runtime"
+	"time"
+
 	"github.com/urfave/cli/v2"
+
+	"github.com/capeprivacy/cape/cmd/cape/config"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
+// ssoPollInterval is how often the coordinator is checked to see if the user
+// has finished signing in through their identity provider
+var ssoPollInterval = 2 * time.Second
+
 func init() {
 	loginCmd := &Command{
 
 		Usage:     "Creates a session on the coordinator.",
 		Variables: []*EnvVar{capePasswordVar},
+		Examples: []*Example{
+			{
+				Example:     "cape login --email user@cape.com",
+				Description: "Logs in with your email and prompts for your password.",
+			},
+			{
+				Example:     "cape login --email user@cape.com --code 123456",
+				Description: "Logs in with your email, password and a one-time code from your authenticator app.",
+			},
+			{
+				Example:     "cape login --sso",
+				Description: "Logs in through your organization's identity provider using a web browser.",
+			},
+		},
 		Command: &cli.Command{
 			Name:   "login",
 			Action: handleSessionOverrides(loginCmd),
 			Flags: []cli.Flag{
 				emailFlag(),
+				codeFlag(),
+				ssoFlag(),
 				clusterFlag(),
 			},
 		},
@@ -31,6 +60,10 @@ func loginCmd(c *cli.Context) error {
 		return err
 	}
 
+	if c.Bool("sso") {
+		return ssoLogin(c, cfg, cluster)
+	}
+
 	email, err := getEmail(c, c.String("email"))
 	if err != nil {
 		return err
@@ -46,12 +79,12 @@ func loginCmd(c *cli.Context) error {
 		return err
 	}
 
-	session, err := client.EmailLogin(c.Context, email, password)
+	session, err := emailLogin(c, client, email, password)
 	if err != nil {
 		return err
 	}
 
-	cluster.SetToken(session.Token)
+	cluster.SetSession(session)
 	err = cfg.Write()
 	if err != nil {
 		return err
@@ -68,3 +101,111 @@ func loginCmd(c *cli.Context) error {
 	u := provider.UI(c.Context)
 	return u.Template("You are now authenticated to {{ .ClusterURL | bold }} as {{ .Email | bold }}\n", args)
 }
+
+// emailLogin logs in using the email and password, prompting for a one-time
+// code if the user has enabled multi-factor authentication and didn't
+// provide one using the --code flag
+func emailLogin(c *cli.Context, client *coordinator.Client, email models.Email, password models.Password) (*models.Session, error) {
+	if code := c.String("code"); code != "" {
+		return client.MFALogin(c.Context, email, password, code)
+	}
+
+	session, err := client.EmailLogin(c.Context, email, password)
+	if err == nil || !errors.FromCause(err, coordinator.MFARequiredCause) {
+		return session, err
+	}
+
+	code, err := getOTP(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MFALogin(c.Context, email, password, code)
+}
+
+// ssoLogin signs in through the coordinator's identity provider. The user
+// completes signing in using their browser, which may be on another device,
+// while we poll the coordinator for the resulting session.
+func ssoLogin(c *cli.Context, cfg *config.Config, cluster *config.Cluster) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	start, err := client.StartSSO(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = u.Template("To sign in, visit the following url in your browser:\n\n  {{ .AuthURL | bold }}\n\n"+
+		"Once you've signed in, enter the following code when asked:\n\n  {{ .UserCode | bold }}\n\n", start)
+	if err != nil {
+		return err
+	}
+
+	// Opening the browser is a convenience, the user can always visit the
+	// url themselves so any failure is ignored.
+	openBrowser(start.AuthURL) // nolint: errcheck
+
+	session, err := waitForSSO(c, client, start)
+	if err != nil {
+		return err
+	}
+
+	cluster.SetSession(session)
+	err = cfg.Write()
+	if err != nil {
+		return err
+	}
+
+	user, err := client.Me(c.Context)
+	if err != nil {
+		return err
+	}
+
+	args := struct {
+		Email      string
+		ClusterURL string
+	}{
+		user.Email.String(),
+		cluster.URL.String(),
+	}
+
+	return u.Template("You are now authenticated to {{ .ClusterURL | bold }} as {{ .Email | bold }}\n", args)
+}
+
+func waitForSSO(c *cli.Context, client *coordinator.Client, start *coordinator.SSOStartResponse) (*models.Session, error) {
+	ticker := time.NewTicker(ssoPollInterval)
+	defer ticker.Stop()
+
+	for {
+		session, err := client.PollSSO(c.Context, start.ID, start.Secret)
+		if err == nil {
+			return session, nil
+		}
+
+		if !errors.FromCause(err, coordinator.SSOPendingCause) {
+			return nil, err
+		}
+
+		select {
+		case <-c.Context.Done():
+			return nil, c.Context.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func openBrowser(url string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	default:
+		return exec.Command("xdg-open", url).Start()
+	}
+}
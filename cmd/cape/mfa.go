@@ -0,0 +1,238 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+)
+
+func init() {
+	mfaEnrollCmd := &Command{
+		Usage: "Enables multi-factor authentication using an authenticator app.",
+		Examples: []*Example{
+			{
+				Example:     "cape mfa enroll",
+				Description: "Displays a secret to add to your authenticator app and prompts for a one-time code to confirm it.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "enroll",
+			Action: handleSessionOverrides(mfaEnrollCmd),
+		},
+	}
+
+	mfaStatusCmd := &Command{
+		Usage: "Shows whether you have enabled multi-factor authentication.",
+		Examples: []*Example{
+			{
+				Example:     "cape mfa status",
+				Description: "Shows whether multi-factor authentication is enabled for the current user.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "status",
+			Action: handleSessionOverrides(mfaStatusCmd),
+		},
+	}
+
+	mfaDisableCmd := &Command{
+		Usage: "Disables multi-factor authentication.",
+		Examples: []*Example{
+			{
+				Example:     "cape mfa disable",
+				Description: "Prompts for a one-time code and disables multi-factor authentication for the current user.",
+			},
+			{
+				Example:     "cape mfa disable --code 123456",
+				Description: "Disables multi-factor authentication using the given one-time code.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "disable",
+			Action: handleSessionOverrides(mfaDisableCmd),
+			Flags: []cli.Flag{
+				codeFlag(),
+			},
+		},
+	}
+
+	mfaResetCmd := &Command{
+		Usage: "Resets multi-factor authentication for a user who has lost their authenticator app and recovery codes.",
+		Examples: []*Example{
+			{
+				Example:     "cape mfa reset user@cape.com",
+				Description: "Disables multi-factor authentication for the user with the email user@cape.com.",
+			},
+		},
+		Arguments: []*Argument{MFAUserArg},
+		Command: &cli.Command{
+			Name:   "reset",
+			Action: handleSessionOverrides(mfaResetCmd),
+		},
+	}
+
+	mfaRequireAdminsCmd := &Command{
+		Usage: "Sets whether admins must login with a one-time code to use their admin permissions.",
+		Examples: []*Example{
+			{
+				Example:     "cape mfa require-admins true",
+				Description: "Requires admins to login with a one-time code.",
+			},
+			{
+				Example:     "cape mfa require-admins false",
+				Description: "Allows admins to use their admin permissions after logging in with only their password.",
+			},
+		},
+		Arguments: []*Argument{MFARequiredArg},
+		Command: &cli.Command{
+			Name:   "require-admins",
+			Action: handleSessionOverrides(mfaRequireAdminsCmd),
+		},
+	}
+
+	mfaCmd := &Command{
+		Usage: "Commands for managing multi-factor authentication.",
+		Command: &cli.Command{
+			Name: "mfa",
+			Subcommands: []*cli.Command{
+				mfaEnrollCmd.Package(),
+				mfaStatusCmd.Package(),
+				mfaDisableCmd.Package(),
+				mfaResetCmd.Package(),
+				mfaRequireAdminsCmd.Package(),
+			},
+		},
+	}
+
+	commands = append(commands, mfaCmd.Package())
+}
+
+func mfaEnrollCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	enrollment, err := client.EnrollMFA(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = u.Template("Add the following secret to your authenticator app:\n\n  {{ .Secret | bold }}\n\n"+
+		"Or use this uri if your app supports it:\n\n  {{ .URI | faded }}\n\n", enrollment)
+	if err != nil {
+		return err
+	}
+
+	code, err := getOTP(c)
+	if err != nil {
+		return err
+	}
+
+	codes, err := client.ConfirmMFA(c.Context, code)
+	if err != nil {
+		return err
+	}
+
+	err = u.Template("\nMulti-factor authentication is now enabled. Store these recovery codes somewhere safe, "+
+		"each can be used once in place of a one-time code:\n\n", nil)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("{{ range . }}  {{ . | bold }}\n{{ end }}", codes)
+}
+
+func mfaStatusCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	status, err := client.MFAStatus(c.Context)
+	if err != nil {
+		return err
+	}
+
+	details := ui.Details{
+		"Enabled":                  strconv.FormatBool(status.Enrolled),
+		"Recovery Codes Remaining": strconv.Itoa(status.RecoveryCodesRemaining),
+		"Required For Admins":      strconv.FormatBool(status.AdminMfaRequired),
+	}
+
+	return u.Details(details)
+}
+
+func mfaDisableCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	code, err := getOTP(c)
+	if err != nil {
+		return err
+	}
+
+	err = client.DisableMFA(c.Context, code)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Multi-factor authentication is now disabled\n", nil)
+}
+
+func mfaResetCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	user, err := getUser(c.Context, client, MFAUserArg)
+	if err != nil {
+		return err
+	}
+
+	err = client.ResetMFA(c.Context, user)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Reset multi-factor authentication for {{ . | bold }}\n", user.Email.String())
+}
+
+func mfaRequireAdminsCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	required := Arguments(c.Context, MFARequiredArg).(bool)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.SetAdminMFARequired(c.Context, required)
+	if err != nil {
+		return err
+	}
+
+	if required {
+		return u.Template("Admins must now login with a one-time code to use their admin permissions\n", nil)
+	}
+
+	return u.Template("Admins no longer need to login with a one-time code\n", nil)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+)
+
+func TestMFA(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("Can enroll", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		enrollment := &model.EnrollMFAResponse{
+			Secret: "JBSWY3DPEHPK3PXP",
+			URI:    "otpauth://totp/Cape:bob@cape.com?secret=JBSWY3DPEHPK3PXP",
+		}
+		codes := []string{"abcde-12345", "fghij-67890"}
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.EnrollMFAResponse{Enrollment: enrollment},
+			},
+			{
+				Value: coordinator.ConfirmMFAResponse{
+					Confirmation: &model.ConfirmMFAResponse{RecoveryCodes: codes},
+				},
+			},
+		})
+		err := app.Run([]string{"cape", "mfa", "enroll"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(4))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(enrollment))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("question"))
+		gm.Expect(u.Calls[3].Args[1]).To(gm.Equal(codes))
+	})
+
+	t.Run("Can show status", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.MFAStatusResponse{
+					Status: &model.MFAStatus{Enrolled: true, RecoveryCodesRemaining: 8},
+				},
+			},
+		})
+		err := app.Run([]string{"cape", "mfa", "status"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("details"))
+		gm.Expect(u.Calls[0].Args[0]).To(gm.Equal(ui.Details{
+			"Enabled":                  "true",
+			"Recovery Codes Remaining": "8",
+			"Required For Admins":      "false",
+		}))
+	})
+
+	t.Run("Can disable with a code", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{{}})
+		err := app.Run([]string{"cape", "mfa", "disable", "--code", "123456"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Can't require admin mfa without a setting", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "mfa", "require-admins"})
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		app, _ = NewHarness([]*coordinator.MockResponse{})
+		err = app.Run([]string{"cape", "mfa", "require-admins", "sometimes"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can require admin mfa", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{{}})
+		err := app.Run([]string{"cape", "mfa", "require-admins", "true"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+}
@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/capeprivacy/cape/models"
 	"sigs.k8s.io/yaml"
 
@@ -89,6 +92,99 @@ func init() {
 		},
 	}
 
+	projectsDestroyKeyCmd := &Command{
+		Usage: "Destroys the key a project's secrets are encrypted with.",
+		Description: "Crypto-shreds the project's secrets by destroying the key they're encrypted with. " +
+			"The secrets can never be decrypted again, a new key is created for any secrets added afterwards.",
+		Arguments: []*Argument{ProjectLabelArg},
+		Examples: []*Example{
+			{
+				Example:     `cape projects destroy-key my-project`,
+				Description: `Destroys the key of "my-project" after asking for confirmation`,
+			},
+		},
+		Command: &cli.Command{
+			Name:   "destroy-key",
+			Action: handleSessionOverrides(projectsDestroyKey),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				yesFlag(),
+			},
+		},
+	}
+
+	// Secret subcommands
+
+	secretsListCmd := &Command{
+		Usage:       "List the secrets in a project.",
+		Description: "Lists the versions of each of the project's secrets along with the specs that use them.",
+		Arguments:   []*Argument{ProjectLabelArg},
+		Examples: []*Example{
+			{
+				Example:     `cape projects secrets list my-project`,
+				Description: `Lists the secrets in "my-project"`,
+			},
+		},
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(secretsList),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	secretsRotateCmd := &Command{
+		Usage: "Rotate a secret in a project.",
+		Description: "Creates a new version of the secret and suggests a policy change updating the project's " +
+			"current spec to use it. The current spec keeps using the old version until the suggestion is approved.",
+		Arguments: []*Argument{ProjectLabelArg, SecretNameArg},
+		Examples: []*Example{
+			{
+				Example:     `cape projects secrets rotate my-project my-key`,
+				Description: `Rotates the secret "my-key" in "my-project"`,
+			},
+		},
+		Command: &cli.Command{
+			Name:   "rotate",
+			Action: handleSessionOverrides(secretsRotate),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	secretsRevealCmd := &Command{
+		Usage:     "Reveal the value of a secret in a project.",
+		Arguments: []*Argument{ProjectLabelArg, SecretNameArg},
+		Examples: []*Example{
+			{
+				Example:     `cape projects secrets reveal my-project my-key --version 2`,
+				Description: `Prints the base64 encoded value of the second version of "my-key"`,
+			},
+		},
+		Command: &cli.Command{
+			Name:   "reveal",
+			Action: handleSessionOverrides(secretsReveal),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				secretVersionFlag(),
+			},
+		},
+	}
+
+	secretsCmd := &Command{
+		Usage: "Commands for managing the secrets used by a project's transformations.",
+		Command: &cli.Command{
+			Name: "secrets",
+			Subcommands: []*cli.Command{
+				secretsListCmd.Package(),
+				secretsRotateCmd.Package(),
+				secretsRevealCmd.Package(),
+			},
+		},
+	}
+
 	// Policy subcommands
 
 	policyCreateCmd := &Command{
@@ -271,10 +367,12 @@ func init() {
 			Subcommands: []*cli.Command{
 				policyCmd.Package(),
 				contributorsCmd.Package(),
+				secretsCmd.Package(),
 				projectsCreateCmd.Package(),
 				projectsListCmd.Package(),
 				projectsUpdateCmd.Package(),
 				projectsGetCmd.Package(),
+				projectsDestroyKeyCmd.Package(),
 			},
 		},
 	}
@@ -652,6 +750,133 @@ func updateProjectSpec(c *cli.Context, specFile string) error {
 	return u.Template("Applied {{ .FileName | bold }} to {{ .ProjectName | bold }}\n", args)
 }
 
+func projectsDestroyKey(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, ProjectLabelArg).(models.Label)
+
+	u := provider.UI(c.Context)
+	if !c.Bool("yes") {
+		err := u.Confirm(fmt.Sprintf("Do you want to destroy the key of '%s'? Its secrets can never be recovered", label))
+		if err != nil {
+			return err
+		}
+	}
+
+	project, err := client.DestroyProjectKey(c.Context, label)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Destroyed the key of {{ . | bold }}, its secrets can no longer be decrypted.\n", project.Label.String())
+}
+
+func secretsList(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, ProjectLabelArg).(models.Label)
+
+	secrets, err := client.ListSecrets(c.Context, label)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	if len(secrets) > 0 {
+		header := []string{"Name", "Type", "Latest Version", "Used By"}
+		body := make([][]string, len(secrets))
+		for i, s := range secrets {
+			var used []string
+			for _, ref := range s.References {
+				spec := fmt.Sprintf("%s (v%d)", ref.SpecID, ref.Version)
+				if ref.Current {
+					spec += " current"
+				}
+
+				used = append(used, spec)
+			}
+
+			latest := ""
+			if len(s.Versions) > 0 {
+				latest = strconv.Itoa(s.Versions[len(s.Versions)-1])
+			}
+
+			body[i] = []string{s.Name, s.Type, latest, strings.Join(used, ", ")}
+		}
+
+		err = u.Table(header, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} secret{{ . | pluralize \"s\"}}\n", len(secrets))
+}
+
+func secretsRotate(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, ProjectLabelArg).(models.Label)
+	name := Arguments(c.Context, SecretNameArg).(string)
+
+	suggestion, err := client.RotateSecret(c.Context, label, name)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	err = u.Template("Rotated {{ . | bold }}, approve the suggestion to start using the new version.\n", name)
+	if err != nil {
+		return err
+	}
+
+	details := ui.Details{
+		"ID":          suggestion.ID,
+		"Title":       suggestion.Title,
+		"Description": suggestion.Description,
+		"Status":      suggestion.State.String(),
+	}
+
+	return u.Details(details)
+}
+
+func secretsReveal(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, ProjectLabelArg).(models.Label)
+	name := Arguments(c.Context, SecretNameArg).(string)
+
+	var version *int
+	if c.IsSet("version") {
+		v := c.Int("version")
+		version = &v
+	}
+
+	value, err := client.RevealSecret(c.Context, label, name, version)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	return u.Template("{{ . }}\n", value)
+}
+
 func projectsUpdate(c *cli.Context) error {
 	updateSpec := c.String("from-spec")
 	if updateSpec != "" {
@@ -326,3 +326,115 @@ func TestProjectsList(t *testing.T) {
 		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
 	})
 }
+
+func TestProjectsDestroyKey(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	p := models.NewProject("My Project", "my-project", "What is this project even about")
+	resp := coordinator.DestroyProjectKeyResponse{Project: &p}
+
+	t.Run("Asks for confirmation", func(t *testing.T) {
+		app, u := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+		err := app.Run([]string{"cape", "projects", "destroy-key", p.Label.String()})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("confirm"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[1].Args[1]).To(gm.Equal(p.Label.String()))
+	})
+
+	t.Run("Skips confirmation", func(t *testing.T) {
+		app, u := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+		err := app.Run([]string{"cape", "projects", "destroy-key", "--yes", p.Label.String()})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Requires a project", func(t *testing.T) {
+		app, _ := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+		err := app.Run([]string{"cape", "projects", "destroy-key"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
+
+func TestSecretsList(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ref := models.NewSecretReference("project-id", "spec-id", "my-key", 2)
+	resp := coordinator.ListSecretsResponse{
+		Secrets: []coordinator.SecretResponse{
+			{
+				Name:     "my-key",
+				Type:     "secret",
+				Versions: []int{1, 2},
+				References: []coordinator.SecretReferenceResponse{
+					{SecretReference: &ref, Current: true},
+				},
+			},
+		},
+	}
+
+	app, u := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+	err := app.Run([]string{"cape", "projects", "secrets", "list", "my-project"})
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(len(u.Calls)).To(gm.Equal(2))
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+	gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(ui.TableBody{
+		{"my-key", "secret", "2", "spec-id (v2) current"},
+	}))
+}
+
+func TestSecretsRotate(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	suggestion := models.Suggestion{
+		ID:          "suggestion-id",
+		Title:       "Rotate secret my-key",
+		Description: "Use version 2 of secret my-key",
+		State:       models.SuggestionPending,
+	}
+	resp := coordinator.RotateSecretResponse{Suggestion: suggestion}
+
+	t.Run("Rotates a secret", func(t *testing.T) {
+		app, u := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+		err := app.Run([]string{"cape", "projects", "secrets", "rotate", "my-project", "my-key"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal("my-key"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("details"))
+		gm.Expect(u.Calls[1].Args[0].(ui.Details)["ID"]).To(gm.Equal("suggestion-id"))
+	})
+
+	t.Run("Requires a secret", func(t *testing.T) {
+		app, _ := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+		err := app.Run([]string{"cape", "projects", "secrets", "rotate", "my-project"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
+
+func TestSecretsReveal(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resp := coordinator.RevealSecretResponse{Value: "c2VjcmV0"}
+
+	app, u := NewHarness([]*coordinator.MockResponse{{Value: resp}})
+
+	err := app.Run([]string{"cape", "projects", "secrets", "reveal", "--version", "1", "my-project", "my-key"})
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(len(u.Calls)).To(gm.Equal(1))
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	gm.Expect(u.Calls[0].Args[1]).To(gm.Equal("c2VjcmV0"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/capeprivacy/cape/cmd/cape/ui"
 	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
 )
 
 // Provider is an interface that gives different cape commands/components
@@ -26,7 +27,23 @@ func (a *AppProvider) transport(ctx context.Context) (coordinator.ClientTranspor
 		return nil, err
 	}
 
-	return cluster.Transport()
+	transport, err := cluster.Transport()
+	if err != nil {
+		return nil, err
+	}
+
+	// Save sessions the transport refreshes so the next command uses them,
+	// if saving fails the user will have to login again once the session
+	// they saved expires
+	if t, ok := transport.(*coordinator.HTTPTransport); ok {
+		cfg := Config(ctx)
+		t.OnRefresh(func(session *models.Session) {
+			cluster.SetSession(session)
+			cfg.Write() // nolint: errcheck
+		})
+	}
+
+	return transport, nil
 }
 
 // Client implements Client on the Provider interface
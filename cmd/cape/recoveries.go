@@ -0,0 +1,116 @@
+package main
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/capeprivacy/cape/models"
+)
+
+func usersRecoverCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	email, err := getEmail(c, "")
+	if err != nil {
+		return err
+	}
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.CreateRecovery(c.Context, email)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("If an account exists for {{ . | bold }} an email has been sent containing "+
+		"instructions to recover it.\n", email.String())
+}
+
+func usersRecoverCompleteCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := c.String("id")
+	secret, err := u.Secret("Please enter the secret sent to your email address", nil)
+	if err != nil {
+		return err
+	}
+
+	password, err := getNewPassword(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.AttemptRecovery(c.Context, ID, models.Password(secret), password)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Your account has been recovered! You can now log in using {{ \"cape login\" | bold }}\n", nil)
+}
+
+func recoveriesListCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	recoveries, err := client.Recoveries(c.Context)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"ID", "Email", "Created At", "Expires At"}
+	body := make([][]string, len(recoveries))
+	for i, r := range recoveries {
+		email := ""
+		if r.User != nil {
+			email = r.User.Email.String()
+		}
+
+		body[i] = []string{
+			r.ID,
+			email,
+			r.CreatedAt.Format(time.RFC1123),
+			r.ExpiresAt.Format(time.RFC1123),
+		}
+	}
+
+	err = u.Table(header, body)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} recover{{ . | pluralize \"y,ies\"}}\n", len(recoveries))
+}
+
+func recoveriesDeleteCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := Arguments(c.Context, RecoveryIDArg).(string)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteRecoveries(c.Context, []string{ID})
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Deleted the recovery with ID {{ . | toString | faded }}\n", ID)
+}
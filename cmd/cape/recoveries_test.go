@@ -0,0 +1,89 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestRecoveries(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	_, user := models.GenerateUser("bob", "bob@bob.bob")
+	recovery := models.NewRecovery(user.ID, models.GenerateCredentials())
+
+	t.Run("Can request a recovery", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "recover", "--email", "bob@bob.bob"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal("bob@bob.bob"))
+	})
+
+	t.Run("Can complete a recovery", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "recover", "complete", "--id", recovery.ID})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(4))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[2].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[3].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Can't complete a recovery without an id", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "recover", "complete"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can list recoveries", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.ListRecoveriesResponse{
+					Recoveries: []coordinator.RecoveryResponse{
+						{Recovery: &recovery, User: &user},
+					},
+				},
+			},
+		})
+		err := app.Run([]string{"cape", "users", "recoveries", "list"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(ui.TableBody{{
+			recovery.ID,
+			"bob@bob.bob",
+			recovery.CreatedAt.Format(time.RFC1123),
+			recovery.ExpiresAt.Format(time.RFC1123),
+		}}))
+	})
+
+	t.Run("Can delete a recovery", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "recoveries", "delete", recovery.ID})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(recovery.ID))
+	})
+}
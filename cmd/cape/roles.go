@@ -1,8 +1,14 @@
 package main
 
 import (
-	"github.com/capeprivacy/cape/models"
+	"strconv"
+	"strings"
+
 	"github.com/urfave/cli/v2"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
 func init() {
@@ -35,12 +41,12 @@ func init() {
 			{
 				Example: "cape roles set friend@cape.com admin",
 				Description: `Changes the user with email friend@cape.com to an admin
-			  Possible values are admin, user`,
+			  Possible values are admin, user or any custom org role`,
 			},
 			{
 				Example: "cape roles set --project my-project friend@cape.com project-reviewer",
 				Description: `Changes the user with email friend@cape.com to a project-review within my-project.
-			  Possible values are project-owner, project-contributor, project-member`,
+			  Possible values are project-owner, project-contributor, project-reader or any custom project role`,
 			},
 		},
 		Command: &cli.Command{
@@ -53,6 +59,61 @@ func init() {
 		},
 	}
 
+	createCmd := &Command{
+		Usage:     "Create a custom role that grants the chosen permissions.",
+		Arguments: []*Argument{RoleLabelArg},
+		Examples: []*Example{
+			{
+				Example:     "cape roles create --kind project --permission read-policy --permission accept-policy policy-reviewer",
+				Description: "Creates a project role that can read and approve policy but can't update the project",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "create",
+			Action: handleSessionOverrides(rolesCreateCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				roleKindFlag(),
+				rolePermissionFlag(),
+			},
+		},
+	}
+
+	listCmd := &Command{
+		Usage: "List the system and custom roles.",
+		Examples: []*Example{
+			{
+				Example:     "cape roles list",
+				Description: "Lists every role that can be assigned to users",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(rolesListCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	describeCmd := &Command{
+		Usage:     "Show the permissions granted by a role.",
+		Arguments: []*Argument{RoleLabelArg},
+		Examples: []*Example{
+			{
+				Example:     "cape roles describe policy-reviewer",
+				Description: "Shows the kind and permissions of the policy-reviewer role",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "describe",
+			Action: handleSessionOverrides(rolesDescribeCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
 	rolesCmd := &Command{
 		Usage: "Commands for querying information about roles and modifying them.",
 		Command: &cli.Command{
@@ -60,6 +121,9 @@ func init() {
 			Subcommands: []*cli.Command{
 				setCmd.Package(),
 				meCmd.Package(),
+				createCmd.Package(),
+				listCmd.Package(),
+				describeCmd.Package(),
 			},
 		},
 	}
@@ -122,3 +186,108 @@ func rolesMeCmd(c *cli.Context) error {
 	u := provider.UI(c.Context)
 	return u.Template("Role: {{ . | bold }}\n", role.Label.String())
 }
+
+func rolesCreateCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, RoleLabelArg).(models.Label)
+	kind := models.RoleKind(c.String("kind"))
+	err = kind.Validate()
+	if err != nil {
+		return err
+	}
+
+	perms := make([]models.Permission, 0, len(c.StringSlice("permission")))
+	for _, name := range c.StringSlice("permission") {
+		p, err := models.ParsePermission(name)
+		if err != nil {
+			return errors.New(InvalidRolePermissionCause, "Invalid permission: %s", err)
+		}
+
+		perms = append(perms, p)
+	}
+
+	role, err := client.CreateRole(c.Context, label, kind, perms)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	return u.Template("Created {{ .Kind }} role {{ .Label | bold }}\n", role)
+}
+
+func rolesListCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	roles, err := client.ListRoles(c.Context)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"Label", "Kind", "System", "Permissions"}
+	body := make([][]string, len(roles))
+	for i, r := range roles {
+		body[i] = []string{
+			r.Label.String(),
+			r.Kind.String(),
+			strconv.FormatBool(r.System),
+			rolePermissions(r),
+		}
+	}
+
+	u := provider.UI(c.Context)
+	err = u.Table(header, body)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} role{{ . | pluralize \"s\"}}\n", len(roles))
+}
+
+func rolesDescribeCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, RoleLabelArg).(models.Label)
+	role, err := client.GetRoleByLabel(c.Context, label)
+	if err != nil {
+		return err
+	}
+
+	details := ui.Details{
+		"Label":       role.Label.String(),
+		"Kind":        role.Kind.String(),
+		"System":      strconv.FormatBool(role.System),
+		"Permissions": rolePermissions(role),
+	}
+
+	u := provider.UI(c.Context)
+	return u.Details(details)
+}
+
+// rolePermissions lists the names of the permissions granted by a role,
+// system roles are listed using their default permissions
+func rolePermissions(role *models.Role) string {
+	rules := role.Rules()
+
+	var names []string
+	for _, name := range models.PermissionNames() {
+		p, _ := models.ParsePermission(name)
+		if rules&p != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"github.com/capeprivacy/cape/cmd/cape/ui"
 	"github.com/capeprivacy/cape/coordinator"
 	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
 	gm "github.com/onsi/gomega"
 	"testing"
 )
@@ -92,12 +94,99 @@ func TestRolesSet(t *testing.T) {
 	})
 
 	t.Run("Project set with fake role", func(t *testing.T) {
+		// Custom roles are checked by the coordinator so an unknown role is
+		// rejected there
 		app, _ := NewHarness([]*coordinator.MockResponse{
 			{
-				Value: nil,
+				Error: errors.New(models.InvalidRoleCause, "Role projectzzzzzz-owner does not exist"),
 			},
 		})
 		err := app.Run([]string{"cape", "roles", "set", "--project", "my-project", "person@website.com", "projectzzzzzz-owner"})
 		gm.Expect(err).ToNot(gm.BeNil())
 	})
 }
+
+func TestRolesCreate(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	role := models.NewCustomRole("policy-reviewer", models.ProjectRoleKind,
+		[]models.Permission{models.ReadPolicy, models.AcceptPolicy})
+
+	t.Run("Can create a role", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: struct {
+					Role models.Role `json:"createRole"`
+				}{Role: role},
+			},
+		})
+		err := app.Run([]string{"cape", "roles", "create", "--kind", "project",
+			"--permission", "read-policy", "--permission", "accept-policy", "policy-reviewer"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Rejects an unknown permission", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "roles", "create", "--permission", "launch-rockets", "policy-reviewer"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Rejects an unknown kind", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "roles", "create", "--kind", "team", "--permission", "read-policy", "policy-reviewer"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
+
+func TestRolesList(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	admin := models.NewRole(models.AdminRole, true)
+	reviewer := models.NewCustomRole("policy-reviewer", models.ProjectRoleKind,
+		[]models.Permission{models.ReadPolicy, models.AcceptPolicy})
+
+	app, u := NewHarness([]*coordinator.MockResponse{
+		{
+			Value: struct {
+				Roles []*models.Role `json:"roles"`
+			}{Roles: []*models.Role{&admin, &reviewer}},
+		},
+	})
+	err := app.Run([]string{"cape", "roles", "list"})
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(len(u.Calls)).To(gm.Equal(2))
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+
+	body := u.Calls[0].Args[1].(ui.TableBody)
+	gm.Expect(body[1]).To(gm.Equal([]string{"policy-reviewer", "project", "false", "accept-policy, read-policy"}))
+}
+
+func TestRolesDescribe(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	reviewer := models.NewCustomRole("policy-reviewer", models.ProjectRoleKind,
+		[]models.Permission{models.ReadPolicy, models.AcceptPolicy})
+
+	app, u := NewHarness([]*coordinator.MockResponse{
+		{
+			Value: struct {
+				Role models.Role `json:"role"`
+			}{Role: reviewer},
+		},
+	})
+	err := app.Run([]string{"cape", "roles", "describe", "policy-reviewer"})
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(len(u.Calls)).To(gm.Equal(1))
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("details"))
+}
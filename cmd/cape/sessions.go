@@ -0,0 +1,151 @@
+package main
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+func init() {
+	sessionsListCmd := &Command{
+		Usage: "Lists your active sessions.",
+		Examples: []*Example{
+			{
+				Example:     "cape sessions list",
+				Description: "Lists the active sessions for the current user.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(sessionsListCmd),
+		},
+	}
+
+	sessionsRevokeCmd := &Command{
+		Usage: "Revokes one of your sessions, logging it out.",
+		Examples: []*Example{
+			{
+				Example:     "cape sessions revoke 2015e9d9uwk4c3tmtjw6w2hcuy",
+				Description: "Revokes the session with the ID 2015e9d9uwk4c3tmtjw6w2hcuy.",
+			},
+		},
+		Arguments: []*Argument{SessionIDArg},
+		Command: &cli.Command{
+			Name:   "revoke",
+			Action: handleSessionOverrides(sessionsRevokeCmd),
+		},
+	}
+
+	sessionsRevokeAllCmd := &Command{
+		Usage: "Revokes all sessions for a user other than the one you are currently using.",
+		Examples: []*Example{
+			{
+				Example:     "cape sessions revoke-all",
+				Description: "Logs out all of your other sessions.",
+			},
+			{
+				Example:     "cape sessions revoke-all user@cape.com",
+				Description: "Logs out all sessions for the user with the email user@cape.com.",
+			},
+		},
+		Arguments: []*Argument{SessionUserArg},
+		Command: &cli.Command{
+			Name:   "revoke-all",
+			Action: handleSessionOverrides(sessionsRevokeAllCmd),
+		},
+	}
+
+	sessionsCmd := &Command{
+		Usage: "Commands for managing your sessions.",
+		Command: &cli.Command{
+			Name: "sessions",
+			Subcommands: []*cli.Command{
+				sessionsListCmd.Package(),
+				sessionsRevokeCmd.Package(),
+				sessionsRevokeAllCmd.Package(),
+			},
+		},
+	}
+
+	commands = append(commands, sessionsCmd.Package())
+}
+
+func sessionsListCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := client.MySessions(c.Context)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"ID", "Type", "IP Address", "User Agent", "Created At", "Expires At"}
+	body := make([][]string, len(sessions))
+	for i, s := range sessions {
+		ID := s.ID
+		if s.Current {
+			ID += " (current)"
+		}
+
+		body[i] = []string{
+			ID,
+			s.Type.String(),
+			s.IPAddress,
+			s.UserAgent,
+			s.CreatedAt.Format(time.RFC1123),
+			s.ExpiresAt.Format(time.RFC1123),
+		}
+	}
+
+	err = u.Table(header, body)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} session{{ . | pluralize \"s\"}}\n", len(sessions))
+}
+
+func sessionsRevokeCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := Arguments(c.Context, SessionIDArg).(string)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	err = client.RevokeSession(c.Context, ID)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Revoked the session with ID {{ . | toString | faded }}\n", ID)
+}
+
+func sessionsRevokeAllCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	user, err := getUser(c.Context, client, SessionUserArg)
+	if err != nil {
+		return err
+	}
+
+	err = client.RevokeAllSessions(c.Context, user)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Revoked all other sessions for {{ . | bold }}\n", user.Email.String())
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestSessions(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	_, user := models.GenerateUser("bob", "bob@bob.bob")
+	session := models.NewSession(&user)
+	session.IPAddress = "127.0.0.1"
+	session.UserAgent = "cape"
+	session.ExpiresAt = time.Now().UTC().Add(time.Hour)
+
+	t.Run("Can list sessions", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.MySessionsResponse{
+					Sessions: []coordinator.MySessionResponse{
+						{Session: &session, Current: true},
+					},
+				},
+			},
+		})
+		err := app.Run([]string{"cape", "sessions", "list"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(ui.TableBody{{
+			session.ID + " (current)",
+			"password",
+			"127.0.0.1",
+			"cape",
+			session.CreatedAt.Format(time.RFC1123),
+			session.ExpiresAt.Format(time.RFC1123),
+		}}))
+	})
+
+	t.Run("Can revoke a session", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "sessions", "revoke", session.ID})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(session.ID))
+	})
+
+	t.Run("Can't revoke a session without an id", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "sessions", "revoke"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can revoke all of your other sessions", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.MeResponse{User: &user},
+			},
+			{},
+		})
+		err := app.Run([]string{"cape", "sessions", "revoke-all"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal("bob@bob.bob"))
+	})
+}
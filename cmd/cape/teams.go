@@ -0,0 +1,396 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/models"
+)
+
+func init() {
+	createCmd := &Command{
+		Usage:     "Create a team that can be granted a role within projects.",
+		Arguments: []*Argument{TeamLabelArg, TeamNameArg},
+		Examples: []*Example{
+			{
+				Example:     "cape teams create data-science 'Data Science'",
+				Description: "Creates a team with the label data-science",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "create",
+			Action: handleSessionOverrides(teamsCreateCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	listCmd := &Command{
+		Usage: "List the teams in your org.",
+		Examples: []*Example{
+			{
+				Example:     "cape teams list",
+				Description: "Lists every team",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(teamsListCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	describeCmd := &Command{
+		Usage:     "Show the members of a team and the projects it contributes to.",
+		Arguments: []*Argument{TeamLabelArg},
+		Examples: []*Example{
+			{
+				Example:     "cape teams describe data-science",
+				Description: "Shows the members and projects of the data-science team",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "describe",
+			Action: handleSessionOverrides(teamsDescribeCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	deleteCmd := &Command{
+		Usage:     "Delete a team, its members lose any roles granted through it.",
+		Arguments: []*Argument{TeamLabelArg},
+		Examples: []*Example{
+			{
+				Example:     "cape teams delete data-science",
+				Description: "Deletes the data-science team",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "delete",
+			Action: handleSessionOverrides(teamsDeleteCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	addMemberCmd := &Command{
+		Usage:     "Add a user to a team.",
+		Arguments: []*Argument{TeamLabelArg, UserEmailArg},
+		Examples: []*Example{
+			{
+				Example:     "cape teams add-member data-science friend@cape.com",
+				Description: "Adds the user with email friend@cape.com to the data-science team",
+			},
+			{
+				Example:     "cape teams add-member --maintainer data-science friend@cape.com",
+				Description: "Makes friend@cape.com a maintainer who can manage the members of the data-science team",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "add-member",
+			Action: handleSessionOverrides(teamsAddMemberCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				teamMaintainerFlag(),
+			},
+		},
+	}
+
+	removeMemberCmd := &Command{
+		Usage:     "Remove a user from a team.",
+		Arguments: []*Argument{TeamLabelArg, UserEmailArg},
+		Examples: []*Example{
+			{
+				Example:     "cape teams remove-member data-science friend@cape.com",
+				Description: "Removes the user with email friend@cape.com from the data-science team",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "remove-member",
+			Action: handleSessionOverrides(teamsRemoveMemberCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	setRoleCmd := &Command{
+		Usage:     "Make a team a contributor to a project with the given project role.",
+		Arguments: []*Argument{TeamLabelArg, RoleArg},
+		Examples: []*Example{
+			{
+				Example: "cape teams set-role --project my-project data-science project-reader",
+				Description: `Grants every member of the data-science team the project-reader role within my-project.
+			  Members keep their own role in the project if it grants more permissions`,
+			},
+		},
+		Command: &cli.Command{
+			Name:   "set-role",
+			Action: handleSessionOverrides(teamsSetRoleCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				teamProjectFlag(),
+			},
+		},
+	}
+
+	unsetRoleCmd := &Command{
+		Usage:     "Remove a team from a project's contributors.",
+		Arguments: []*Argument{TeamLabelArg},
+		Examples: []*Example{
+			{
+				Example:     "cape teams unset-role --project my-project data-science",
+				Description: "Removes the role the data-science team was granted within my-project",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "unset-role",
+			Action: handleSessionOverrides(teamsUnsetRoleCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				teamProjectFlag(),
+			},
+		},
+	}
+
+	teamsCmd := &Command{
+		Usage: "Commands for managing teams and their membership.",
+		Command: &cli.Command{
+			Name: "teams",
+			Subcommands: []*cli.Command{
+				createCmd.Package(),
+				listCmd.Package(),
+				describeCmd.Package(),
+				deleteCmd.Package(),
+				addMemberCmd.Package(),
+				removeMemberCmd.Package(),
+				setRoleCmd.Package(),
+				unsetRoleCmd.Package(),
+			},
+		},
+	}
+
+	commands = append(commands, teamsCmd.Package())
+}
+
+func teamsCreateCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	name := Arguments(c.Context, TeamNameArg).(string)
+
+	team, err := client.CreateTeam(c.Context, label, name)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	return u.Template("Created team {{ .Label | bold }}\n", team)
+}
+
+func teamsListCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	teams, err := client.ListTeams(c.Context)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"Label", "Name"}
+	body := make([][]string, len(teams))
+	for i, t := range teams {
+		body[i] = []string{t.Label.String(), t.Name}
+	}
+
+	u := provider.UI(c.Context)
+	err = u.Table(header, body)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("\nFound {{ . | toString | faded }} team{{ . | pluralize \"s\"}}\n", len(teams))
+}
+
+func teamsDescribeCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	team, err := client.GetTeam(c.Context, label)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	err = u.Details(ui.Details{
+		"Label": team.Label.String(),
+		"Name":  team.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	members := make([][]string, len(team.Members))
+	for i, m := range team.Members {
+		members[i] = []string{m.User.Email.String(), m.User.Name.String(), strconv.FormatBool(m.Maintainer)}
+	}
+
+	err = u.Table([]string{"Member", "Name", "Maintainer"}, members)
+	if err != nil {
+		return err
+	}
+
+	projects := make([][]string, len(team.Projects))
+	for i, p := range team.Projects {
+		projects[i] = []string{p.Project.Label.String(), p.Role.Label.String()}
+	}
+
+	return u.Table([]string{"Project", "Role"}, projects)
+}
+
+func teamsDeleteCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	err = client.DeleteTeam(c.Context, label)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	return u.Template("Deleted team {{ . | bold }}\n", label.String())
+}
+
+func teamsAddMemberCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	email := Arguments(c.Context, UserEmailArg).(models.Email)
+
+	err = client.AddTeamMember(c.Context, label, email, c.Bool("maintainer"))
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	args := struct {
+		Team string
+		User string
+	}{
+		Team: label.String(),
+		User: email.String(),
+	}
+
+	return u.Template("Added {{ .User | bold }} to team {{ .Team | bold }}\n", args)
+}
+
+func teamsRemoveMemberCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	email := Arguments(c.Context, UserEmailArg).(models.Email)
+
+	err = client.RemoveTeamMember(c.Context, label, email)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	args := struct {
+		Team string
+		User string
+	}{
+		Team: label.String(),
+		User: email.String(),
+	}
+
+	return u.Template("Removed {{ .User | bold }} from team {{ .Team | bold }}\n", args)
+}
+
+func teamsSetRoleCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	roleLabel := Arguments(c.Context, RoleArg).(models.Label)
+	project := models.Label(c.String("project"))
+
+	err = client.SetTeamProjectRole(c.Context, label, project, roleLabel)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	args := struct {
+		Team    string
+		Project string
+		Role    string
+	}{
+		Team:    label.String(),
+		Project: project.String(),
+		Role:    roleLabel.String(),
+	}
+
+	return u.Template("Granted team {{ .Team | bold }} the {{ .Role | bold }} role in {{ .Project | bold }}\n", args)
+}
+
+func teamsUnsetRoleCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	label := Arguments(c.Context, TeamLabelArg).(models.Label)
+	project := models.Label(c.String("project"))
+
+	err = client.RemoveTeamProject(c.Context, label, project)
+	if err != nil {
+		return err
+	}
+
+	u := provider.UI(c.Context)
+	args := struct {
+		Team    string
+		Project string
+	}{
+		Team:    label.String(),
+		Project: project.String(),
+	}
+
+	return u.Template("Removed team {{ .Team | bold }} from {{ .Project | bold }}\n", args)
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/graph"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestTeamsCreate(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	team := models.NewTeam("data-science", "Data Science")
+
+	t.Run("Can create a team", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: struct {
+					Team models.Team `json:"createTeam"`
+				}{Team: team},
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "create", "data-science", "Data Science"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Requires a name", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "teams", "create", "data-science"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
+
+func TestTeamsList(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	team := models.NewTeam("data-science", "Data Science")
+
+	app, u := NewHarness([]*coordinator.MockResponse{
+		{
+			Value: struct {
+				Teams []coordinator.GQLTeam `json:"teams"`
+			}{Teams: []coordinator.GQLTeam{{Team: &team}}},
+		},
+	})
+	err := app.Run([]string{"cape", "teams", "list"})
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(len(u.Calls)).To(gm.Equal(2))
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
+
+	body := u.Calls[0].Args[1].(ui.TableBody)
+	gm.Expect(body[0]).To(gm.Equal([]string{"data-science", "Data Science"}))
+}
+
+func TestTeamsDescribe(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	team := models.NewTeam("data-science", "Data Science")
+	member := models.NewTeamMember(team.ID, "user-id", true)
+	tp := models.NewTeamProject(team.ID, "project-id", "role-id")
+
+	app, u := NewHarness([]*coordinator.MockResponse{
+		{
+			Value: struct {
+				Team coordinator.GQLTeam `json:"team"`
+			}{Team: coordinator.GQLTeam{
+				Team: &team,
+				Members: []coordinator.GQLTeamMember{
+					{TeamMember: &member, User: models.User{Email: "friend@cape.com", Name: "Friend"}},
+				},
+				Projects: []coordinator.GQLTeamProject{
+					{TeamProject: &tp, Project: models.Project{Label: "my-project"}, Role: models.Role{Label: models.ProjectReaderRole}},
+				},
+			}},
+		},
+	})
+	err := app.Run([]string{"cape", "teams", "describe", "data-science"})
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(len(u.Calls)).To(gm.Equal(3))
+	gm.Expect(u.Calls[0].Name).To(gm.Equal("details"))
+
+	members := u.Calls[1].Args[1].(ui.TableBody)
+	gm.Expect(members[0]).To(gm.Equal([]string{"friend@cape.com", "Friend", "true"}))
+
+	projects := u.Calls[2].Args[1].(ui.TableBody)
+	gm.Expect(projects[0]).To(gm.Equal([]string{"my-project", "project-reader"}))
+}
+
+func TestTeamsDelete(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("Can delete a team", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: nil,
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "delete", "data-science"})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Reports a missing team", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{
+			{
+				Error: errors.New(graph.TeamNotFoundCause, "Team data-science does not exist"),
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "delete", "data-science"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
+
+func TestTeamsMembers(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("Can add a member", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: nil,
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "add-member", "--maintainer", "data-science", "friend@cape.com"})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Add member requires an email", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "teams", "add-member", "data-science"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can remove a member", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: nil,
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "remove-member", "data-science", "friend@cape.com"})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+}
+
+func TestTeamsRoles(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("Can set a team's project role", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: nil,
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "set-role", "--project", "my-project", "data-science", "project-reader"})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Set role requires a project", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness(nil)
+		err := app.Run([]string{"cape", "teams", "set-role", "data-science", "project-reader"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can remove a team from a project", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: nil,
+			},
+		})
+		err := app.Run([]string{"cape", "teams", "unset-role", "--project", "my-project", "data-science"})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+	})
+}
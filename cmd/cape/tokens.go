@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
@@ -23,24 +25,33 @@ func init() {
 				Example:     "cape tokens create user@cape.com",
 				Description: "Creates a token for the user with the email user@cape.com.",
 			},
+			{
+				Example:     "cape tokens create --name ci --expires 720h --scope read-policy",
+				Description: "Creates a token named ci that expires in 30 days and can only read policies.",
+			},
 		},
 		Arguments: []*Argument{TokenUserArg},
 		Command: &cli.Command{
 			Name:   "create",
 			Action: handleSessionOverrides(createTokenCmd),
+			Flags: []cli.Flag{
+				tokenNameFlag(),
+				tokenExpiresFlag(),
+				tokenScopeFlag(),
+			},
 		},
 	}
 
 	tokensListCmd := &Command{
-		Usage: "Lists the tokens IDs for a specified user.",
+		Usage: "Lists the tokens for a specified user.",
 		Examples: []*Example{
 			{
 				Example:     "cape tokens list",
-				Description: "Lists the token ids for the current user.",
+				Description: "Lists the tokens for the current user.",
 			},
 			{
-				Example:     "cape tokens create data-user@cape.com",
-				Description: "Lists the token ids for the user with email data-user@cape.com.",
+				Example:     "cape tokens list data-user@cape.com",
+				Description: "Lists the tokens for the user with email data-user@cape.com.",
 			},
 		},
 		Arguments: []*Argument{TokenUserArg},
@@ -80,9 +91,11 @@ func init() {
 	commands = append(commands, tokensCmd.Package())
 }
 
-func getUser(ctx context.Context, client *coordinator.Client) (*models.User, error) {
+// getUser returns the user identified by the email passed in the given
+// argument, defaulting to the current user if none was provided
+func getUser(ctx context.Context, client *coordinator.Client, arg *Argument) (*models.User, error) {
 	var user *models.User
-	identifier, ok := Arguments(ctx, TokenUserArg).(models.Email)
+	identifier, ok := Arguments(ctx, arg).(models.Email)
 	if ok {
 		users, err := client.GetUsers(ctx, []models.Email{identifier})
 		if err != nil {
@@ -113,12 +126,17 @@ func createTokenCmd(c *cli.Context) error {
 		return err
 	}
 
-	user, err := getUser(c.Context, client)
+	opts, err := getTokenOptions(c)
+	if err != nil {
+		return err
+	}
+
+	user, err := getUser(c.Context, client, TokenUserArg)
 	if err != nil {
 		return err
 	}
 
-	apiToken, _, err := client.CreateToken(c.Context, user)
+	apiToken, _, err := client.CreateToken(c.Context, user, opts)
 	if err != nil {
 		return err
 	}
@@ -149,21 +167,51 @@ func listTokenCmd(c *cli.Context) error {
 		return err
 	}
 
-	user, err := getUser(c.Context, client)
+	user, err := getUser(c.Context, client, TokenUserArg)
 	if err != nil {
 		return err
 	}
 
-	tokenIDs, err := client.ListTokens(c.Context, user)
+	tokens, err := client.ListTokens(c.Context, user)
 	if err != nil {
 		return err
 	}
 
-	header := []string{"Token ID"}
-	body := make([][]string, len(tokenIDs))
+	header := []string{"Token ID", "Name", "Scope", "Created At", "Expires At", "Last Used At", "Last Used IP"}
+	body := make([][]string, len(tokens))
 
-	for i, t := range tokenIDs {
-		body[i] = []string{t}
+	for i, t := range tokens {
+		scope := "all"
+		if len(t.Scope) > 0 {
+			names := make([]string, len(t.Scope))
+			for j, p := range t.Scope {
+				names[j] = p.String()
+			}
+			scope = strings.Join(names, ", ")
+		}
+
+		expiresAt := "never"
+		if t.ExpiresAt != nil {
+			expiresAt = t.ExpiresAt.Format(time.RFC1123)
+			if t.Expired() {
+				expiresAt += " (expired)"
+			}
+		}
+
+		lastUsedAt := "never"
+		if t.LastUsedAt != nil {
+			lastUsedAt = t.LastUsedAt.Format(time.RFC1123)
+		}
+
+		body[i] = []string{
+			t.ID,
+			t.Name,
+			scope,
+			t.CreatedAt.Format(time.RFC1123),
+			expiresAt,
+			lastUsedAt,
+			t.LastUsedIP,
+		}
 	}
 
 	u := provider.UI(c.Context)
@@ -172,7 +220,7 @@ func listTokenCmd(c *cli.Context) error {
 		return err
 	}
 
-	return u.Template("\nFound {{ . | toString | faded }} token{{ . | pluralize \"s\"}}\n", len(tokenIDs))
+	return u.Template("\nFound {{ . | toString | faded }} token{{ . | pluralize \"s\"}}\n", len(tokens))
 }
 
 func removeTokenCmd(c *cli.Context) error {
@@ -191,3 +239,52 @@ func removeTokenCmd(c *cli.Context) error {
 	u := provider.UI(c.Context)
 	return u.Template("Removed the token with ID {{ . | toString | faded }} from Cape\n", ID)
 }
+
+// getTokenOptions returns the name, expiry and scope provided for a new
+// token
+func getTokenOptions(c *cli.Context) (*coordinator.TokenOptions, error) {
+	opts := &coordinator.TokenOptions{
+		Name: c.String("name"),
+	}
+
+	if expires := c.String("expires"); expires != "" {
+		expiresAt, err := parseExpiry(expires)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.ExpiresAt = &expiresAt
+	}
+
+	for _, name := range c.StringSlice("scope") {
+		p, err := models.ParsePermission(name)
+		if err != nil {
+			return nil, errors.New(InvalidScopeCause, "Invalid scope: %s", err)
+		}
+
+		opts.Scope = append(opts.Scope, p)
+	}
+
+	return opts, nil
+}
+
+// parseExpiry parses either a duration from now or a date
+func parseExpiry(in string) (time.Time, error) {
+	d, err := time.ParseDuration(in)
+	if err == nil {
+		if d <= 0 {
+			return time.Time{}, errors.New(InvalidExpiryCause, "Expiry must be in the future")
+		}
+
+		return time.Now().UTC().Add(d), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		t, err := time.Parse(layout, in)
+		if err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.New(InvalidExpiryCause, "Invalid expiry '%s', expected a duration such as 720h or a date such as 2021-01-31", in)
+}
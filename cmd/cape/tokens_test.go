@@ -2,6 +2,7 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	gm "github.com/onsi/gomega"
 
@@ -54,18 +55,68 @@ func TestCreateToken(t *testing.T) {
 		gm.Expect(u.Calls[2].Name).To(gm.Equal("notify"))
 	})
 
-	t.Run("Can list tokens", func(t *testing.T) {
+	t.Run("Can create a scoped token", func(t *testing.T) {
 		gm.RegisterTestingT(t)
 
-		IDs := []string{
-			"2018d9x3ntbca95dda3bu9wnrr",
-			"2015338ejcum4rzncvnugucvtc",
-			"2011e949qta0quff3n4yx7ny3r",
-			"201dandy989092yebk2m0143p4",
+		token := models.NewToken(user.ID, creds)
+
+		resp := coordinator.CreateTokenResponse{
+			Response: &coordinator.CreateTokenMutation{
+				Secret: password,
+				Token:  &token,
+			},
 		}
 
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: me,
+			},
+			{
+				Value: resp,
+			},
+		})
+		err := app.Run([]string{"cape", "tokens", "create", "--name", "ci", "--expires", "720h", "--scope", "read-policy"})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(u.Calls)).To(gm.Equal(3))
+	})
+
+	t.Run("Can't create a token with an unknown scope", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "tokens", "create", "--scope", "launch-rockets"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can't create a token with an invalid expiry", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "tokens", "create", "--expires", "tomorrow"})
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		app, _ = NewHarness([]*coordinator.MockResponse{})
+		err = app.Run([]string{"cape", "tokens", "create", "--expires", "-1h"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can list tokens", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		expiresAt := time.Now().UTC().Add(-time.Hour)
+		lastUsedAt := time.Now().UTC().Add(-2 * time.Hour)
+
+		unscoped := models.NewToken(user.ID, creds)
+
+		scoped := models.NewToken(user.ID, creds)
+		scoped.Name = "ci"
+		scoped.Scope = []models.Permission{models.ReadPolicy, models.ListOwnTokens}
+		scoped.ExpiresAt = &expiresAt
+		scoped.LastUsedAt = &lastUsedAt
+		scoped.LastUsedIP = "127.0.0.1"
+
 		resp := coordinator.ListTokensResponse{
-			IDs: IDs,
+			Tokens: []*models.Token{&unscoped, &scoped},
 		}
 
 		app, u := NewHarness([]*coordinator.MockResponse{
@@ -82,12 +133,33 @@ func TestCreateToken(t *testing.T) {
 		gm.Expect(len(u.Calls)).To(gm.Equal(2))
 
 		gm.Expect(u.Calls[0].Name).To(gm.Equal("table"))
-		gm.Expect(u.Calls[0].Args[0]).To(gm.Equal(ui.TableHeader{"Token ID"}))
-		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(ui.TableBody{{IDs[0]}, {IDs[1]}, {IDs[2]}, {IDs[3]}}))
+		gm.Expect(u.Calls[0].Args[0]).To(gm.Equal(ui.TableHeader{
+			"Token ID", "Name", "Scope", "Created At", "Expires At", "Last Used At", "Last Used IP",
+		}))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(ui.TableBody{
+			{
+				unscoped.ID,
+				"",
+				"all",
+				unscoped.CreatedAt.Format(time.RFC1123),
+				"never",
+				"never",
+				"",
+			},
+			{
+				scoped.ID,
+				"ci",
+				"read-policy, list-own-tokens",
+				scoped.CreatedAt.Format(time.RFC1123),
+				expiresAt.Format(time.RFC1123) + " (expired)",
+				lastUsedAt.Format(time.RFC1123),
+				"127.0.0.1",
+			},
+		}))
 
 		gm.Expect(u.Calls[1].Name).To(gm.Equal("template"))
 		gm.Expect(u.Calls[1].Args[0]).To(gm.Equal("\nFound {{ . | toString | faded }} token{{ . | pluralize \"s\"}}\n"))
-		gm.Expect(u.Calls[1].Args[1]).To(gm.Equal(4))
+		gm.Expect(u.Calls[1].Args[1]).To(gm.Equal(2))
 	})
 
 	t.Run("Can remove a token", func(t *testing.T) {
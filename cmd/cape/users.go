@@ -1,32 +1,258 @@
 package main
 
 import (
-	"fmt"
-
 	"github.com/urfave/cli/v2"
 
 	"github.com/capeprivacy/cape/cmd/cape/ui"
 	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
 func init() {
-	createCmd := &Command{
-		Usage:     "Create a new user.",
+	passwdCmd := &Command{
+		Usage: "Change the password of the current user.",
+		Examples: []*Example{
+			{
+				Example: "cape users passwd",
+				Description: "Prompts for your current password and a new password. All of your " +
+					"other sessions will be logged out once the password has been changed.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "passwd",
+			Action: handleSessionOverrides(usersPasswdCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	updateCmd := &Command{
+		Usage: "Update the name or email of the current user.",
+		Examples: []*Example{
+			{
+				Example:     "cape users update --name 'Jerry Berry'",
+				Description: "Changes the name of the current user to 'Jerry Berry'.",
+			},
+			{
+				Example: "cape users update --email jerry@jerry.berry",
+				Description: "Requests a change of email to 'jerry@jerry.berry'. An email containing " +
+					"a confirmation id and secret will be sent to the new address.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "update",
+			Action: handleSessionOverrides(usersUpdateCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				userNameFlag(),
+				userEmailFlag(),
+			},
+		},
+	}
+
+	confirmEmailCmd := &Command{
+		Usage:     "Confirm a change of email for the current user.",
+		Arguments: []*Argument{EmailChangeIDArg},
+		Examples: []*Example{
+			{
+				Example:     "cape users confirm-email 2015338ejcum4rzncvnugucvtc",
+				Description: "Prompts for the secret sent to the new email address and applies the change.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "confirm-email",
+			Action: handleSessionOverrides(usersConfirmEmailCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	inviteCmd := &Command{
+		Usage:     "Invite someone to create an account.",
 		Arguments: []*Argument{UserEmailArg},
 		Examples: []*Example{
 			{
-				Example: "cape users create email@email.com",
-				Description: "Creates a new user with the email 'email@email.com'. Will prompt " +
-					"for name and generate a password.",
+				Example: "cape users invite email@email.com",
+				Description: "Sends an invitation to 'email@email.com'. They will choose their own " +
+					"name and password when accepting it.",
+			},
+			{
+				Example: "cape users invite --role admin --project my-project:project-owner email@email.com",
+				Description: "Invites 'email@email.com' as an admin who will own the project " +
+					"'my-project' once they accept.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "invite",
+			Action: handleSessionOverrides(usersInviteCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				orgRoleFlag(),
+				inviteProjectFlag(),
+			},
+		},
+	}
+
+	acceptCmd := &Command{
+		Usage:     "Accept an invitation and create your account.",
+		Arguments: []*Argument{InvitationIDArg},
+		Examples: []*Example{
+			{
+				Example: "cape users accept 2015338ejcum4rzncvnugucvtc",
+				Description: "Prompts for the secret from your invitation email, your name and " +
+					"a password, then creates your account.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "accept",
+			Action: handleSessionOverrides(usersAcceptCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	listCmd := &Command{
+		Usage: "List outstanding invitations.",
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(invitationsListCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	resendCmd := &Command{
+		Usage:     "Resend an invitation with a new secret.",
+		Arguments: []*Argument{InvitationIDArg},
+		Examples: []*Example{
+			{
+				Example:     "cape users invitations resend 2015338ejcum4rzncvnugucvtc",
+				Description: "Sends a new secret to the invitee. The previous secret can no longer be used.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "resend",
+			Action: handleSessionOverrides(invitationsResendCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	revokeCmd := &Command{
+		Usage:     "Revoke an outstanding invitation.",
+		Arguments: []*Argument{InvitationIDArg},
+		Command: &cli.Command{
+			Name:   "revoke",
+			Action: handleSessionOverrides(invitationsRevokeCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	invitationsCmd := &Command{
+		Usage: "Commands for managing outstanding invitations.",
+		Command: &cli.Command{
+			Name: "invitations",
+			Subcommands: []*cli.Command{
+				listCmd.Package(),
+				resendCmd.Package(),
+				revokeCmd.Package(),
+			},
+		},
+	}
+
+	recoverCompleteCmd := &Command{
+		Usage: "Complete the recovery of your account by choosing a new password.",
+		Examples: []*Example{
+			{
+				Example: "cape users recover complete --id 2015338ejcum4rzncvnugucvtc",
+				Description: "Prompts for the secret sent to your email address and a new password " +
+					"for your account.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "complete",
+			Action: handleSessionOverrides(usersRecoverCompleteCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				recoveryIDFlag(),
+			},
+		},
+	}
+
+	recoverCmd := &Command{
+		Usage: "Recover access to your account if you have forgotten your password.",
+		Examples: []*Example{
+			{
+				Example: "cape users recover --email email@email.com",
+				Description: "Sends an email to 'email@email.com' containing a recovery id and secret " +
+					"which can be used with 'cape users recover complete'.",
+			},
+		},
+		Command: &cli.Command{
+			Name:   "recover",
+			Action: handleSessionOverrides(usersRecoverCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+				emailFlag(),
+			},
+			Subcommands: []*cli.Command{recoverCompleteCmd.Package()},
+		},
+	}
+
+	recoveriesListCmd := &Command{
+		Usage: "List outstanding account recoveries.",
+		Command: &cli.Command{
+			Name:   "list",
+			Action: handleSessionOverrides(recoveriesListCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
+			},
+		},
+	}
+
+	recoveriesDeleteCmd := &Command{
+		Usage:     "Delete an outstanding account recovery so it can no longer be used.",
+		Arguments: []*Argument{RecoveryIDArg},
+		Command: &cli.Command{
+			Name:   "delete",
+			Action: handleSessionOverrides(recoveriesDeleteCmd),
+			Flags: []cli.Flag{
+				clusterFlag(),
 			},
+		},
+	}
+
+	recoveriesCmd := &Command{
+		Usage: "Commands for managing outstanding account recoveries.",
+		Command: &cli.Command{
+			Name: "recoveries",
+			Subcommands: []*cli.Command{
+				recoveriesListCmd.Package(),
+				recoveriesDeleteCmd.Package(),
+			},
+		},
+	}
+
+	unlockCmd := &Command{
+		Usage:     "Unlock a user who has been locked out after too many failed logins.",
+		Arguments: []*Argument{UserEmailArg},
+		Examples: []*Example{
 			{
-				Example:     "CAPE_CLUSTER=prod cape users create email@email.com",
-				Description: "Creates a user on the cape cluster called prod.",
+				Example: "cape users unlock email@email.com",
+				Description: "Clears the failed login attempts of 'email@email.com' so they can login " +
+					"again straight away. Their password is not changed.",
 			},
 		},
 		Command: &cli.Command{
-			Name:   "create",
-			Action: handleSessionOverrides(usersCreateCmd),
+			Name:   "unlock",
+			Action: handleSessionOverrides(usersUnlockCmd),
 			Flags: []cli.Flag{
 				clusterFlag(),
 			},
@@ -36,20 +262,34 @@ func init() {
 	usersCmd := &Command{
 		Usage: "Commands for querying information about users and modifying them.",
 		Command: &cli.Command{
-			Name:        "users",
-			Subcommands: []*cli.Command{createCmd.Package()},
+			Name: "users",
+			Subcommands: []*cli.Command{
+				passwdCmd.Package(),
+				updateCmd.Package(),
+				confirmEmailCmd.Package(),
+				inviteCmd.Package(),
+				acceptCmd.Package(),
+				invitationsCmd.Package(),
+				recoverCmd.Package(),
+				recoveriesCmd.Package(),
+				unlockCmd.Package(),
+			},
 		},
 	}
 
 	commands = append(commands, usersCmd.Package())
 }
 
-func usersCreateCmd(c *cli.Context) error {
+func usersPasswdCmd(c *cli.Context) error {
 	provider := GetProvider(c.Context)
 	u := provider.UI(c.Context)
 
-	email := Arguments(c.Context, UserEmailArg).(models.Email)
-	name, err := getName(c, "Please enter the persons name")
+	current, err := u.Secret("Please enter your current password", nil)
+	if err != nil {
+		return err
+	}
+
+	newPassword, err := getNewPassword(c)
 	if err != nil {
 		return err
 	}
@@ -59,21 +299,101 @@ func usersCreateCmd(c *cli.Context) error {
 		return err
 	}
 
-	_, password, err := client.CreateUser(c.Context, name, email)
+	err = client.ChangePassword(c.Context, models.Password(current), newPassword)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Your password has been changed. All of your other sessions have been logged out.\n", nil)
+}
+
+func usersUpdateCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	var name *models.Name
+	if n := c.String("name"); n != "" {
+		mn := models.Name(n)
+		name = &mn
+	}
+
+	var email *models.Email
+	if e := c.String("email"); e != "" {
+		me := models.Email(e)
+		email = &me
+	}
+
+	if name == nil && email == nil {
+		return errors.New(NothingToUpdateCause, "Please provide a new name or email to update")
+	}
+
+	client, err := provider.Client(c.Context)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("A user has been created with the following credentials:\n\n")
+	user, err := client.UpdateMe(c.Context, name, email)
+	if err != nil {
+		return err
+	}
 
 	err = u.Details(ui.Details{
-		"Name":     name,
-		"Email":    email,
-		"Password": password,
+		"Name":  user.Name,
+		"Email": user.Email,
 	})
 	if err != nil {
 		return err
 	}
 
-	return u.Notify(ui.Remember, "Please keep the password safe and share it only over secure channels.")
+	if email != nil {
+		return u.Notify(ui.Remember, "A confirmation has been sent to %s. Your email will not change "+
+			"until it has been confirmed with 'cape users confirm-email'.", email.String())
+	}
+
+	return nil
+}
+
+func usersConfirmEmailCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	ID := Arguments(c.Context, EmailChangeIDArg).(string)
+	secret, err := u.Secret("Please enter the secret sent to your new email address", nil)
+	if err != nil {
+		return err
+	}
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	user, err := client.ConfirmEmailChange(c.Context, ID, models.Password(secret))
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Your email has been changed to {{ . | bold }}\n", user.Email.String())
+}
+
+func usersUnlockCmd(c *cli.Context) error {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	client, err := provider.Client(c.Context)
+	if err != nil {
+		return err
+	}
+
+	user, err := getUser(c.Context, client, UserEmailArg)
+	if err != nil {
+		return err
+	}
+
+	err = client.UnlockUser(c.Context, user)
+	if err != nil {
+		return err
+	}
+
+	return u.Template("Unlocked {{ . | bold }}\n", user.Email.String())
 }
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/cmd/cape/ui"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestUsers(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	user := &models.User{
+		ID:    models.NewID(),
+		Name:  models.Name("Jerry Berry"),
+		Email: models.Email("jerry@jerry.berry"),
+	}
+
+	t.Run("Can change password", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "passwd"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(4))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[2].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[3].Name).To(gm.Equal("template"))
+	})
+
+	t.Run("Can update name", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.UpdateMeResponse{User: user},
+			},
+		})
+		err := app.Run([]string{"cape", "users", "update", "--name", "Jerry Berry"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("details"))
+		gm.Expect(u.Calls[0].Args[0]).To(gm.Equal(ui.Details{
+			"Name":  user.Name,
+			"Email": user.Email,
+		}))
+	})
+
+	t.Run("Updating email reminds about confirmation", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.UpdateMeResponse{User: user},
+			},
+		})
+		err := app.Run([]string{"cape", "users", "update", "--email", "berry@jerry.berry"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("details"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("notify"))
+		gm.Expect(u.Calls[1].Args[0]).To(gm.Equal(ui.Remember))
+	})
+
+	t.Run("Can't update without a name or email", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, _ := NewHarness([]*coordinator.MockResponse{})
+		err := app.Run([]string{"cape", "users", "update"})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Can confirm email change", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: coordinator.ConfirmEmailChangeResponse{User: user},
+			},
+		})
+		err := app.Run([]string{"cape", "users", "confirm-email", "2015338ejcum4rzncvnugucvtc"})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(2))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("secret"))
+		gm.Expect(u.Calls[1].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[1].Args[1]).To(gm.Equal(user.Email.String()))
+	})
+
+	t.Run("Can unlock a user", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		app, u := NewHarness([]*coordinator.MockResponse{
+			{
+				Value: struct {
+					Users []*models.User `json:"identities"`
+				}{Users: []*models.User{user}},
+			},
+			{
+				Value: nil,
+			},
+		})
+		err := app.Run([]string{"cape", "users", "unlock", user.Email.String()})
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(len(u.Calls)).To(gm.Equal(1))
+		gm.Expect(u.Calls[0].Name).To(gm.Equal("template"))
+		gm.Expect(u.Calls[0].Args[1]).To(gm.Equal(user.Email.String()))
+	})
+}
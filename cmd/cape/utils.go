@@ -161,3 +161,51 @@ func getPassword(c *cli.Context) (models.Password, error) {
 
 	return models.NewPassword(out)
 }
+
+// getNewPassword prompts the user to choose a new password and then to
+// confirm it, returning an error if the two do not match.
+func getNewPassword(c *cli.Context) (models.Password, error) {
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	validatePassword := func(input string) error {
+		_, err := models.NewPassword(input)
+		return err
+	}
+
+	password, err := u.Secret("Please enter a new password", validatePassword)
+	if err != nil {
+		return "", err
+	}
+
+	confirmation, err := u.Secret("Please confirm your new password", validatePassword)
+	if err != nil {
+		return "", err
+	}
+
+	if password != confirmation {
+		return "", errors.New(PasswordMismatchCause, "The passwords entered do not match")
+	}
+
+	return models.Password(password), nil
+}
+
+// getOTP returns the one-time code provided using the --code flag or
+// prompts the user for it
+func getOTP(c *cli.Context) (string, error) {
+	code := c.String("code")
+	if code != "" {
+		return code, nil
+	}
+
+	provider := GetProvider(c.Context)
+	u := provider.UI(c.Context)
+
+	return u.Question("Please enter the one-time code from your authenticator app", func(input string) error {
+		if input == "" {
+			return errors.New(MissingCodeCause, "A one-time code is required")
+		}
+
+		return nil
+	})
+}
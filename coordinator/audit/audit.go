@@ -0,0 +1,208 @@
+// Package audit records who performed security relevant actions, such as
+// mutations and logins, and what they changed.
+//
+// Every mutation is recorded by FieldMiddleware along with a summary of its
+// arguments. Resolvers can describe what they're about to change using
+// Before and Target so the event shows the state prior to the mutation.
+// Events are append-only, they're never updated or removed once recorded.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+)
+
+// LoginAction is the action recorded for logins, every other action is the
+// name of the mutation performed
+const LoginAction = "login"
+
+// redacted replaces the value of any argument that looks like a secret
+const redacted = "[redacted]"
+
+// secretArgs are substrings of argument names whose values are never
+// included in an event
+var secretArgs = []string{"password", "secret", "code", "credentials"}
+
+// targetArgs are the arguments used to identify the target of a mutation,
+// in order of preference
+var targetArgs = []string{
+	"project_label", "team_label", "label", "user_email", "email", "id", "user_id", "token_id",
+}
+
+type contextKey struct{}
+
+// pending holds the details resolvers add to an event that's being recorded
+type pending struct {
+	target string
+	before string
+}
+
+// Log records audit events
+type Log struct {
+	db db.AuditDB
+}
+
+// New returns a Log that stores events in the given database
+func New(database db.AuditDB) *Log {
+	return &Log{db: database}
+}
+
+// Record stores the event, filling in the actor and ip address from the
+// request. Failing to record an event is logged rather than returned as the
+// action it describes has already happened.
+func (l *Log) Record(ctx context.Context, event models.AuditEvent) {
+	if fw.Authenticated(ctx) {
+		session := fw.Session(ctx)
+		event.ActorID = session.User.ID
+		event.ActorEmail = session.User.Email
+	}
+
+	if event.IPAddress == "" {
+		event.IPAddress = fw.RemoteIP(ctx)
+	}
+
+	err := l.db.Create(ctx, event)
+	if err != nil {
+		logger := fw.Logger(ctx)
+		logger.Error().Err(err).Str("action", event.Action).Msg("Could not record audit event")
+	}
+}
+
+// FieldMiddleware records an event for every mutation once it has been
+// resolved, whether or not it succeeded
+func (l *Log) FieldMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	p := &pending{}
+	ctx = context.WithValue(ctx, contextKey{}, p)
+
+	res, err := next(ctx)
+
+	event := models.NewAuditEvent(fw.RequestID(ctx).String(), fc.Field.Name)
+	event.Target = p.target
+	if event.Target == "" {
+		event.Target = argsTarget(fc.Args)
+	}
+
+	event.Before = p.before
+	event.After = Summarize(fc.Args)
+
+	if err != nil {
+		event.Outcome = models.AuditFailure
+		event.Error = err.Error()
+	}
+
+	l.Record(ctx, event)
+	return res, err
+}
+
+// Target sets the target of the event being recorded for the current
+// mutation, it's only needed when it can't be worked out from the
+// mutation's arguments
+func Target(ctx context.Context, target string) {
+	if p, ok := ctx.Value(contextKey{}).(*pending); ok {
+		p.target = target
+	}
+}
+
+// Before records a summary of the target of the current mutation before
+// it's changed
+func Before(ctx context.Context, v interface{}) {
+	if p, ok := ctx.Value(contextKey{}).(*pending); ok {
+		p.before = Summarize(v)
+	}
+}
+
+// Summarize returns a JSON summary of the value with any secrets redacted
+func Summarize(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	var doc interface{}
+	err = json.Unmarshal(b, &doc)
+	if err != nil {
+		return ""
+	}
+
+	b, err = json.Marshal(redact(doc))
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func redact(v interface{}) interface{} {
+	switch doc := v.(type) {
+	case map[string]interface{}:
+		for k, val := range doc {
+			if isSecret(k) {
+				doc[k] = redacted
+				continue
+			}
+
+			doc[k] = redact(val)
+		}
+	case []interface{}:
+		for i, val := range doc {
+			doc[i] = redact(val)
+		}
+	}
+
+	return v
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range secretArgs {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func argsTarget(args map[string]interface{}) string {
+	for _, name := range targetArgs {
+		var target string
+		switch v := args[name].(type) {
+		case string:
+			target = v
+		case *string:
+			if v != nil {
+				target = *v
+			}
+		case models.Label:
+			target = v.String()
+		case *models.Label:
+			if v != nil {
+				target = v.String()
+			}
+		case models.Email:
+			target = v.String()
+		case *models.Email:
+			if v != nil {
+				target = v.String()
+			}
+		}
+
+		if target != "" {
+			return target
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,164 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gofrs/uuid"
+	gm "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+)
+
+type testAuditDB struct {
+	events      []models.AuditEvent
+	checkpoints []models.AuditCheckpoint
+}
+
+func (t *testAuditDB) Create(ctx context.Context, event models.AuditEvent) error {
+	event.Sequence = int64(len(t.events)) + 1
+	if len(t.events) > 0 {
+		event.PrevHash = t.events[len(t.events)-1].Hash
+	}
+
+	event.Hash = event.ComputeHash()
+	t.events = append(t.events, event)
+	return nil
+}
+
+func (t *testAuditDB) List(ctx context.Context, opts *db.ListAuditEventOptions) ([]models.AuditEvent, error) {
+	return t.events, nil
+}
+
+func (t *testAuditDB) Last(ctx context.Context) (*models.AuditEvent, error) {
+	if len(t.events) == 0 {
+		return nil, db.ErrNoRows
+	}
+
+	return &t.events[len(t.events)-1], nil
+}
+
+func (t *testAuditDB) Chain(ctx context.Context, after int64, limit uint64) ([]models.AuditEvent, error) {
+	var events []models.AuditEvent
+	for _, e := range t.events {
+		if e.Sequence > after && uint64(len(events)) < limit {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (t *testAuditDB) CreateCheckpoint(ctx context.Context, checkpoint models.AuditCheckpoint) error {
+	for _, c := range t.checkpoints {
+		if c.Sequence == checkpoint.Sequence {
+			return nil
+		}
+	}
+
+	t.checkpoints = append(t.checkpoints, checkpoint)
+	return nil
+}
+
+func (t *testAuditDB) ListCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error) {
+	return t.checkpoints, nil
+}
+
+func fieldCtx(object string, name string, args map[string]interface{}) context.Context {
+	ctx := context.WithValue(context.Background(), fw.LoggerContextKey, *fw.TestLogger())
+	ctx = context.WithValue(ctx, fw.RequestIDContextKey, uuid.Must(uuid.NewV4()))
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: object,
+		Args:   args,
+		Field: graphql.CollectedField{
+			Field: &ast.Field{Name: name},
+		},
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	summary := Summarize(map[string]interface{}{
+		"label": "my-project",
+		"input": map[string]interface{}{
+			"current_password": "hunter2",
+			"new_password":     "hunter3",
+		},
+		"code": "123456",
+	})
+
+	gm.Expect(summary).To(gm.Equal(
+		`{"code":"[redacted]","input":{"current_password":"[redacted]","new_password":"[redacted]"},"label":"my-project"}`))
+}
+
+func TestFieldMiddleware(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resolved := func(ctx context.Context) (interface{}, error) {
+		Before(ctx, map[string]string{"role": "user"})
+		return "resolved", nil
+	}
+
+	t.Run("Records mutations", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		database := &testAuditDB{}
+		log := New(database)
+
+		user := models.User{ID: "user-id", Email: "admin@cape.com"}
+		ctx := fieldCtx("Mutation", "setOrgRole", map[string]interface{}{
+			"user_email": models.Email("friend@cape.com"),
+			"role_label": models.Label("admin"),
+		})
+		ctx = context.WithValue(ctx, fw.SessionContextKey, &auth.Session{User: &user})
+
+		res, err := log.FieldMiddleware(ctx, resolved)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(res).To(gm.Equal("resolved"))
+
+		gm.Expect(len(database.events)).To(gm.Equal(1))
+		event := database.events[0]
+		gm.Expect(event.Action).To(gm.Equal("setOrgRole"))
+		gm.Expect(event.ActorEmail).To(gm.Equal(models.Email("admin@cape.com")))
+		gm.Expect(event.Target).To(gm.Equal("friend@cape.com"))
+		gm.Expect(event.Outcome).To(gm.Equal(models.AuditSuccess))
+		gm.Expect(event.Before).To(gm.Equal(`{"role":"user"}`))
+		gm.Expect(event.After).To(gm.Equal(`{"role_label":"admin","user_email":"friend@cape.com"}`))
+	})
+
+	t.Run("Records failed mutations", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		database := &testAuditDB{}
+		log := New(database)
+
+		ctx := fieldCtx("Mutation", "deleteProject", map[string]interface{}{"label": models.Label("my-project")})
+		_, err := log.FieldMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
+			return nil, fmt.Errorf("no way")
+		})
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		gm.Expect(len(database.events)).To(gm.Equal(1))
+		gm.Expect(database.events[0].Outcome).To(gm.Equal(models.AuditFailure))
+		gm.Expect(database.events[0].Error).To(gm.Equal("no way"))
+		gm.Expect(database.events[0].ActorID).To(gm.Equal(""))
+	})
+
+	t.Run("Ignores queries", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		database := &testAuditDB{}
+		log := New(database)
+
+		_, err := log.FieldMiddleware(fieldCtx("Query", "projects", nil), resolved)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(database.events)).To(gm.Equal(0))
+	})
+}
@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+// DefaultCheckpointInterval is how often the audit chain is checkpointed if
+// no interval has been configured
+var DefaultCheckpointInterval = time.Hour
+
+// Signer signs a message, returning the id and public key of the key used
+// so the signature can be verified after the key has been rotated
+type Signer func(msg []byte) (keyID string, publicKey ed25519.PublicKey, sig []byte, err error)
+
+// Checkpointer periodically signs the hash of the most recent event in the
+// audit chain. Hashes alone can't reveal events removed from the end of the
+// chain, a signed checkpoint proves the chain reached at least its event.
+//
+// Many coordinators can share a database, a checkpoint of an event that has
+// already been checkpointed is ignored so it doesn't matter which of them
+// signs it.
+type Checkpointer struct {
+	db       db.AuditDB
+	sign     Signer
+	interval time.Duration
+	logger   *zerolog.Logger
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewCheckpointer returns a Checkpointer that runs every interval once
+// started
+func NewCheckpointer(database db.AuditDB, sign Signer, interval time.Duration, logger *zerolog.Logger) *Checkpointer {
+	return &Checkpointer{
+		db:       database,
+		sign:     sign,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start checkpoints the chain in the background until Stop is called
+func (c *Checkpointer) Start() {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.run()
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts the checkpointer, waiting for any in-progress checkpoint to
+// finish
+func (c *Checkpointer) Stop() {
+	close(c.stop)
+	c.wg.Wait()
+}
+
+func (c *Checkpointer) run() {
+	checkpoint, err := c.Checkpoint(context.Background())
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Could not checkpoint audit log")
+		return
+	}
+
+	if checkpoint == nil {
+		c.logger.Debug().Msg("Audit log has no new events to checkpoint")
+		return
+	}
+
+	c.logger.Info().
+		Int64("sequence", checkpoint.Sequence).
+		Str("key_id", checkpoint.KeyID).
+		Msg("Checkpointed audit log")
+}
+
+// Checkpoint signs the most recent event in the chain. Nil is returned if
+// there isn't a hashed event to checkpoint.
+func (c *Checkpointer) Checkpoint(ctx context.Context) (*models.AuditCheckpoint, error) {
+	event, err := c.db.Last(ctx)
+	if err == db.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Hash == "" {
+		return nil, nil
+	}
+
+	checkpoint := models.NewAuditCheckpoint(*event)
+	checkpoint.KeyID, checkpoint.PublicKey, checkpoint.Signature, err = c.sign(checkpoint.Message())
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.db.CreateCheckpoint(ctx, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
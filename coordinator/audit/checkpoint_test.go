@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+)
+
+func testSigner(t *testing.T) (Signer, TrustedKeys) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(msg []byte) (string, ed25519.PublicKey, []byte, error) {
+		return "test-key", pub, ed25519.Sign(priv, msg), nil
+	}
+
+	return sign, TrustedKeys{"test-key": pub}
+}
+
+func testChain(n int) *testAuditDB {
+	database := &testAuditDB{}
+	for i := 0; i < n; i++ {
+		database.Create(context.Background(), models.NewAuditEvent("request-id", "createProject")) // nolint: errcheck
+	}
+
+	return database
+}
+
+func TestCheckpoint(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("Signs the most recent event", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		database := testChain(3)
+		sign, keys := testSigner(t)
+		c := NewCheckpointer(database, sign, DefaultCheckpointInterval, fw.TestLogger())
+
+		checkpoint, err := c.Checkpoint(context.Background())
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(checkpoint.Sequence).To(gm.Equal(int64(3)))
+		gm.Expect(checkpoint.Hash).To(gm.Equal(database.events[2].Hash))
+		gm.Expect(checkpoint.KeyID).To(gm.Equal("test-key"))
+		gm.Expect(checkpoint.Verify(keys["test-key"])).To(gm.BeTrue())
+		gm.Expect(len(database.checkpoints)).To(gm.Equal(1))
+	})
+
+	t.Run("Skips an empty log", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		database := testChain(0)
+		sign, _ := testSigner(t)
+		c := NewCheckpointer(database, sign, DefaultCheckpointInterval, fw.TestLogger())
+
+		checkpoint, err := c.Checkpoint(context.Background())
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(checkpoint).To(gm.BeNil())
+		gm.Expect(len(database.checkpoints)).To(gm.Equal(0))
+	})
+
+	t.Run("Rejects a tampered checkpoint", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		sign, keys := testSigner(t)
+		c := NewCheckpointer(testChain(1), sign, DefaultCheckpointInterval, fw.TestLogger())
+
+		checkpoint, err := c.Checkpoint(context.Background())
+		gm.Expect(err).To(gm.BeNil())
+
+		checkpoint.Sequence = 2
+		gm.Expect(checkpoint.Verify(keys["test-key"])).To(gm.BeFalse())
+	})
+}
@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"sort"
+
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/capeprivacy/cape/models"
+)
+
+// Export is a copy of the audit chain and its checkpoints that can be
+// verified without access to the coordinator
+type Export struct {
+	Events      []models.AuditEvent      `json:"events"`
+	Checkpoints []models.AuditCheckpoint `json:"checkpoints"`
+}
+
+// TrustedKeys are the public keys checkpoints can be signed with, by key ID.
+// They must come from somewhere other than the export, such as the
+// coordinator's JSON Web Key Set, as anyone able to rewrite the chain could
+// also sign it with a key of their own.
+type TrustedKeys map[string]ed25519.PublicKey
+
+// KeysFromJWKS returns the ed25519 keys of a JSON Web Key Set, other keys are
+// skipped as checkpoints are only signed with ed25519 keys
+func KeysFromJWKS(set jose.JSONWebKeySet) TrustedKeys {
+	keys := TrustedKeys{}
+	for _, key := range set.Keys {
+		if pub, ok := key.Key.(ed25519.PublicKey); ok {
+			keys[key.KeyID] = pub
+		}
+	}
+
+	return keys
+}
+
+// Break describes the first link in the chain that couldn't be verified
+type Break struct {
+	Sequence int64  `json:"sequence"`
+	Reason   string `json:"reason"`
+}
+
+func (b *Break) Error() string {
+	return fmt.Sprintf("audit chain is broken at event %d: %s", b.Sequence, b.Reason)
+}
+
+// VerifyResult summarizes the verification of an export
+type VerifyResult struct {
+	// Events is the number of events whose hashes were verified, Unchained
+	// is the number recorded before events were hash chained
+	Events    int
+	Unchained int
+
+	// Checkpoints is the number of valid checkpoints, LastCheckpoint is the
+	// sequence of the most recent one and KeyIDs the keys that signed them
+	Checkpoints    int
+	LastCheckpoint int64
+	KeyIDs         []string
+
+	// Break is the first broken link, nil if the entire chain is intact
+	Break *Break
+}
+
+// Verify recomputes the hash chain of the exported events and checks that
+// each checkpoint was signed over an event in the chain by one of the trusted
+// keys. Events must be in the order they were appended, as they're returned
+// by the coordinator.
+func Verify(export Export, keys TrustedKeys) *VerifyResult {
+	res := &VerifyResult{}
+	breaks := []*Break{}
+
+	hashes := map[int64]string{}
+	var prev *models.AuditEvent
+	for i := range export.Events {
+		event := &export.Events[i]
+		if b := verifyLink(prev, event, res.Events > 0); b != nil {
+			breaks = append(breaks, b)
+			break
+		}
+
+		if event.Hash == "" {
+			res.Unchained++
+		} else {
+			res.Events++
+			hashes[event.Sequence] = event.Hash
+		}
+
+		prev = event
+	}
+
+	var last int64
+	if prev != nil {
+		last = prev.Sequence
+	}
+
+	signers := map[string]bool{}
+	for _, checkpoint := range export.Checkpoints {
+		if b := verifyCheckpoint(checkpoint, keys, hashes, last); b != nil {
+			breaks = append(breaks, b)
+			continue
+		}
+
+		res.Checkpoints++
+		if checkpoint.Sequence > res.LastCheckpoint {
+			res.LastCheckpoint = checkpoint.Sequence
+		}
+
+		if !signers[checkpoint.KeyID] {
+			signers[checkpoint.KeyID] = true
+			res.KeyIDs = append(res.KeyIDs, checkpoint.KeyID)
+		}
+	}
+
+	// Everything after a broken link is suspect so only the earliest is
+	// reported
+	sort.SliceStable(breaks, func(i, j int) bool {
+		return breaks[i].Sequence < breaks[j].Sequence
+	})
+
+	if len(breaks) > 0 {
+		res.Break = breaks[0]
+	}
+
+	return res
+}
+
+func verifyLink(prev *models.AuditEvent, event *models.AuditEvent, chained bool) *Break {
+	expected := int64(1)
+	if prev != nil {
+		expected = prev.Sequence + 1
+	}
+
+	if event.Sequence != expected {
+		return &Break{
+			Sequence: expected,
+			Reason:   fmt.Sprintf("expected event %d but found event %d", expected, event.Sequence),
+		}
+	}
+
+	// Events recorded before chaining was introduced have no hash, they can
+	// only appear before the start of the chain
+	if event.Hash == "" {
+		if chained {
+			return &Break{Sequence: event.Sequence, Reason: "event has no hash"}
+		}
+
+		return nil
+	}
+
+	prevHash := ""
+	if prev != nil {
+		prevHash = prev.Hash
+	}
+
+	if event.PrevHash != prevHash {
+		return &Break{
+			Sequence: event.Sequence,
+			Reason:   fmt.Sprintf("previous hash does not match the hash of event %d", expected-1),
+		}
+	}
+
+	if event.ComputeHash() != event.Hash {
+		return &Break{Sequence: event.Sequence, Reason: "hash does not match the contents of the event"}
+	}
+
+	return nil
+}
+
+func verifyCheckpoint(checkpoint models.AuditCheckpoint, keys TrustedKeys, hashes map[int64]string, last int64) *Break {
+	key, ok := keys[checkpoint.KeyID]
+	if !ok {
+		return &Break{
+			Sequence: checkpoint.Sequence,
+			Reason:   fmt.Sprintf("checkpoint %s was signed by unknown key %s", checkpoint.ID, checkpoint.KeyID),
+		}
+	}
+
+	if !checkpoint.Verify(key) {
+		return &Break{
+			Sequence: checkpoint.Sequence,
+			Reason:   fmt.Sprintf("checkpoint %s has an invalid signature", checkpoint.ID),
+		}
+	}
+
+	if checkpoint.Sequence > last {
+		return &Break{
+			Sequence: last + 1,
+			Reason:   fmt.Sprintf("events up to %d were checkpointed but are missing", checkpoint.Sequence),
+		}
+	}
+
+	if hashes[checkpoint.Sequence] != checkpoint.Hash {
+		return &Break{
+			Sequence: checkpoint.Sequence,
+			Reason:   fmt.Sprintf("hash does not match checkpoint %s signed by key %s", checkpoint.ID, checkpoint.KeyID),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+)
+
+func testExport(t *testing.T, n int) (Export, TrustedKeys) {
+	database := testChain(n)
+	sign, keys := testSigner(t)
+	c := NewCheckpointer(database, sign, DefaultCheckpointInterval, fw.TestLogger())
+
+	_, err := c.Checkpoint(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return Export{Events: database.events, Checkpoints: database.checkpoints}, keys
+}
+
+func TestVerify(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("Verifies an intact chain", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		res := Verify(testExport(t, 5))
+		gm.Expect(res.Break).To(gm.BeNil())
+		gm.Expect(res.Events).To(gm.Equal(5))
+		gm.Expect(res.Checkpoints).To(gm.Equal(1))
+		gm.Expect(res.LastCheckpoint).To(gm.Equal(int64(5)))
+		gm.Expect(res.KeyIDs).To(gm.Equal([]string{"test-key"}))
+	})
+
+	t.Run("Reports an edited event", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 5)
+		export.Events[2].Target = "someone-else"
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Sequence).To(gm.Equal(int64(3)))
+		gm.Expect(res.Break.Reason).To(gm.Equal("hash does not match the contents of the event"))
+	})
+
+	t.Run("Reports a rehashed event", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 5)
+		export.Events[2].Target = "someone-else"
+		export.Events[2].Hash = export.Events[2].ComputeHash()
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Sequence).To(gm.Equal(int64(4)))
+		gm.Expect(res.Break.Reason).To(gm.Equal("previous hash does not match the hash of event 3"))
+	})
+
+	t.Run("Reports a removed event", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 5)
+		export.Events = append(export.Events[:1], export.Events[2:]...)
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Sequence).To(gm.Equal(int64(2)))
+	})
+
+	t.Run("Reports a truncated chain", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 5)
+		export.Events = export.Events[:3]
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Sequence).To(gm.Equal(int64(4)))
+		gm.Expect(res.Break.Reason).To(gm.Equal("events up to 5 were checkpointed but are missing"))
+	})
+
+	t.Run("Reports a forged checkpoint", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 5)
+		export.Checkpoints[0].Hash = export.Events[3].Hash
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Sequence).To(gm.Equal(int64(5)))
+		gm.Expect(res.Checkpoints).To(gm.Equal(0))
+	})
+
+	t.Run("Reports a checkpoint re-signed with an untrusted key", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 5)
+		export.Events[2].Target = "someone-else"
+		for i := 2; i < len(export.Events); i++ {
+			export.Events[i].PrevHash = export.Events[i-1].Hash
+			export.Events[i].Hash = export.Events[i].ComputeHash()
+		}
+
+		// The forger can rewrite the chain and sign it with their own key but
+		// not with one of the coordinator's
+		database := &testAuditDB{events: export.Events}
+		sign, _ := testSigner(t)
+		checkpoint, err := NewCheckpointer(database, sign, DefaultCheckpointInterval, fw.TestLogger()).Checkpoint(context.Background())
+		gm.Expect(err).To(gm.BeNil())
+		export.Checkpoints = []models.AuditCheckpoint{*checkpoint}
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Reason).To(gm.Equal(fmt.Sprintf("checkpoint %s has an invalid signature", checkpoint.ID)))
+
+		export.Checkpoints[0].KeyID = "forged-key"
+		res = Verify(export, keys)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Reason).To(gm.Equal(fmt.Sprintf("checkpoint %s was signed by unknown key forged-key", checkpoint.ID)))
+		gm.Expect(res.Checkpoints).To(gm.Equal(0))
+	})
+
+	t.Run("Skips events recorded before chaining", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		export, keys := testExport(t, 3)
+		legacy := export.Events[0]
+		legacy.Hash = ""
+		legacy.PrevHash = ""
+
+		export.Events[0] = legacy
+		export.Events[1].PrevHash = ""
+		export.Events[1].Hash = export.Events[1].ComputeHash()
+		export.Events[2].PrevHash = export.Events[1].Hash
+		export.Events[2].Hash = export.Events[2].ComputeHash()
+		export.Checkpoints = nil
+
+		res := Verify(export, keys)
+		gm.Expect(res.Break).To(gm.BeNil())
+		gm.Expect(res.Unchained).To(gm.Equal(1))
+		gm.Expect(res.Events).To(gm.Equal(2))
+	})
+}
@@ -0,0 +1,131 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// suggestionFields identify their project through the ID of a policy
+// suggestion rather than the project itself
+var suggestionFields = map[string]bool{
+	"approveProjectSuggestion": true,
+	"rejectProjectSuggestion":  true,
+	"getProjectSuggestion":     true,
+}
+
+// AuthenticatedDirective implements the @authenticated schema directive.
+// Requests without a session are already rejected by PublicFieldMiddleware
+// so there is nothing to do here other than resolve the field.
+func AuthenticatedDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	return next(ctx)
+}
+
+// HasPermissionDirective implements the @hasPermission schema directive. An
+// org scoped permission is checked against the caller's org role and a
+// project scoped permission against the caller's role in the project the
+// field acts on, which is found using the field's arguments.
+func HasPermissionDirective(database db.Interface) func(context.Context, interface{}, graphql.Resolver, models.Permission, model.PermissionScope) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, perm models.Permission, scope model.PermissionScope) (interface{}, error) {
+		if !fw.Authenticated(ctx) {
+			return nil, auth.ErrAuthentication
+		}
+
+		session := fw.Session(ctx)
+
+		fc := graphql.GetFieldContext(ctx)
+		logger := fw.Logger(ctx).With().
+			Str("field", fc.Field.Name).
+			Str("permission", perm.String()).
+			Logger()
+
+		role := &session.Roles.Global
+		if scope == model.PermissionScopeProject {
+			label, err := fieldProject(ctx, database, fc)
+			if err != nil {
+				return nil, err
+			}
+
+			role, err = session.Roles.Projects.Get(label)
+			if err != nil {
+				logger.Info().Str("project_label", label.String()).Msg("Caller is not a member of the project")
+				return nil, errors.New(auth.AuthorizationFailure, "invalid permissions: you are not a member of project %s", label)
+			}
+		}
+
+		if !role.Can(perm) {
+			logger.Info().Str("role", role.Label.String()).Msg("Caller's role does not grant the permission")
+			return nil, errors.New(auth.AuthorizationFailure, "invalid permissions: %s requires the %s permission", fc.Field.Name, perm)
+		}
+
+		return next(ctx)
+	}
+}
+
+// fieldProject returns the label of the project a field acts on. Fields
+// refer to a project using either a project_label or label argument, or an
+// id argument holding the ID of the project or of one of its suggestions.
+// Fields given both are rejected as resolvers could otherwise act on a
+// different project than the one the caller was authorized for.
+func fieldProject(ctx context.Context, database db.Interface, fc *graphql.FieldContext) (models.Label, error) {
+	var label models.Label
+	for _, name := range []string{"project_label", "label"} {
+		switch l := fc.Args[name].(type) {
+		case models.Label:
+			label = l
+		case *models.Label:
+			if l != nil {
+				label = *l
+			}
+		}
+
+		if label != "" {
+			break
+		}
+	}
+
+	var id string
+	switch v := fc.Args["id"].(type) {
+	case string:
+		id = v
+	case *string:
+		if v != nil {
+			id = *v
+		}
+	}
+
+	if label != "" && id != "" {
+		return "", errors.New(fw.InvalidParametersCause, "only one of id or label can be supplied to %s", fc.Field.Name)
+	}
+
+	if label != "" {
+		return label, nil
+	}
+
+	if id == "" {
+		return "", errors.New(fw.InvalidParametersCause, "either id or label must be supplied to %s", fc.Field.Name)
+	}
+
+	if suggestionFields[fc.Field.Name] {
+		suggestion, err := database.Projects().GetSuggestion(ctx, id)
+		if err != nil {
+			return "", err
+		}
+
+		id = suggestion.ProjectID
+	}
+
+	project, err := database.Projects().GetByID(ctx, id)
+	if err != nil {
+		return "", errors.New(fw.InvalidParametersCause, "could not find the requested project")
+	}
+
+	return project.Label, nil
+}
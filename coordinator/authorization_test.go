@@ -0,0 +1,117 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	gm "github.com/onsi/gomega"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/graph"
+	"github.com/capeprivacy/cape/coordinator/graph/generated"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestSchemaAuthorization(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}}).Schema()
+	directives := []string{"hasPermission", "authenticated", "public"}
+
+	for _, object := range []*ast.Definition{schema.Query, schema.Mutation} {
+		for _, field := range object.Fields {
+			if field.Name == "__schema" || field.Name == "__type" {
+				continue
+			}
+
+			found := 0
+			for _, name := range directives {
+				if field.Directives.ForName(name) != nil {
+					found++
+				}
+			}
+
+			gm.Expect(found).To(gm.Equal(1),
+				"%s.%s must be marked with exactly one of @hasPermission, @authenticated or @public", object.Name, field.Name)
+		}
+	}
+}
+
+func TestHasPermissionDirective(t *testing.T) {
+	roles := models.UserRoles{
+		Global: models.NewRole(models.UserRole, true),
+		Projects: models.ProjectRolesMap{
+			"my-project": models.NewRole(models.ProjectContributorRole, true),
+		},
+	}
+
+	fieldCtx := func(args map[string]interface{}, session *auth.Session) context.Context {
+		ctx := context.WithValue(context.Background(), fw.LoggerContextKey, *logger)
+		if session != nil {
+			ctx = context.WithValue(ctx, fw.SessionContextKey, session)
+		}
+
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object: "Mutation",
+			Args:   args,
+			Field: graphql.CollectedField{
+				Field: &ast.Field{Name: "someField"},
+			},
+		})
+	}
+
+	next := func(ctx context.Context) (interface{}, error) {
+		return "resolved", nil
+	}
+
+	label := models.Label("my-project")
+	other := models.Label("other-project")
+	id := models.NewID()
+
+	tests := []struct {
+		name    string
+		perm    models.Permission
+		scope   model.PermissionScope
+		args    map[string]interface{}
+		session *auth.Session
+		cause   *errors.Cause
+	}{
+		{"org permission granted", models.CreateProject, model.PermissionScopeOrg, nil, &auth.Session{Roles: roles}, nil},
+		{"org permission denied", models.AddUser, model.PermissionScopeOrg, nil, &auth.Session{Roles: roles}, &auth.AuthorizationFailure},
+		{"project permission granted", models.SuggestPolicy, model.PermissionScopeProject,
+			map[string]interface{}{"project_label": label}, &auth.Session{Roles: roles}, nil},
+		{"project permission granted by optional label", models.UpdateProject, model.PermissionScopeProject,
+			map[string]interface{}{"label": &label}, &auth.Session{Roles: roles}, nil},
+		{"project permission denied", models.AcceptPolicy, model.PermissionScopeProject,
+			map[string]interface{}{"label": label}, &auth.Session{Roles: roles}, &auth.AuthorizationFailure},
+		{"not a project member", models.ReadPolicy, model.PermissionScopeProject,
+			map[string]interface{}{"project_label": other}, &auth.Session{Roles: roles}, &auth.AuthorizationFailure},
+		{"project not supplied", models.ReadPolicy, model.PermissionScopeProject,
+			map[string]interface{}{}, &auth.Session{Roles: roles}, &fw.InvalidParametersCause},
+		{"both id and label supplied", models.UpdateProject, model.PermissionScopeProject,
+			map[string]interface{}{"id": &id, "label": &label}, &auth.Session{Roles: roles}, &fw.InvalidParametersCause},
+		{"no session", models.CreateProject, model.PermissionScopeOrg, nil, nil, &auth.AuthenticationFailure},
+	}
+
+	directive := HasPermissionDirective(nil)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			res, err := directive(fieldCtx(tc.args, tc.session), nil, next, tc.perm, tc.scope)
+			if tc.cause != nil {
+				gm.Expect(errors.CausedBy(err, *tc.cause)).To(gm.BeTrue())
+				gm.Expect(res).To(gm.BeNil())
+				return
+			}
+
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(res).To(gm.Equal("resolved"))
+		})
+	}
+}
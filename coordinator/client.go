@@ -2,6 +2,8 @@ package coordinator
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/capeprivacy/cape/models"
 	"github.com/manifoldco/go-base64"
@@ -9,12 +11,17 @@ import (
 	errors "github.com/capeprivacy/cape/partyerrors"
 
 	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/audit"
 	"github.com/capeprivacy/cape/coordinator/graph/model"
 )
 
 // NetworkCause occurs when the client cannot reach the server
 var NetworkCause = errors.NewCause(errors.RequestTimeoutCategory, "network_error")
 
+// auditExportPageSize is the number of audit events requested at a time when
+// exporting the audit chain
+const auditExportPageSize = 1000
+
 // Client is a wrapper around the graphql client that
 // connects to the coordinator and sends queries
 type Client struct {
@@ -89,64 +96,258 @@ func (c *Client) GetUser(ctx context.Context, id string) (*UserResponse, error)
 	return &resp.User, nil
 }
 
-// CreateUser creates a user and returns it
-func (c *Client) CreateUser(ctx context.Context, name models.Name, email models.Email) (*models.User, models.Password, error) {
+// ListUsers returns all of the users in the database
+func (c *Client) ListUsers(ctx context.Context) ([]*models.User, error) {
 	var resp struct {
-		Response struct {
-			Password models.Password `json:"password"`
-			User     *models.User    `json:"user"`
-		} `json:"createUser"`
+		Users []*models.User `json:"users"`
 	}
 
-	variables := make(map[string]interface{})
-	variables["name"] = name
-	variables["email"] = email
-
 	err := c.transport.Raw(ctx, `
-		mutation CreateUser ($name: Name!, $email: ModelEmail!) {
-			createUser(input: { name: $name, email: $email }) {
-				password
-				user {
+		query Users {
+			users {
+				id
+				name
+				email
+				role {
 					id
-					name
-					email
+					label
 				}
 			}
 		}
-	`, variables, &resp)
+	`, nil, &resp)
 
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	return resp.Response.User, resp.Response.Password, nil
+	return resp.Users, nil
 }
 
-// ListUsers returns all of the users in the database
-func (c *Client) ListUsers(ctx context.Context) ([]*models.User, error) {
-	var resp struct {
-		Users []*models.User `json:"users"`
+// ChangePassword changes the password of the current authenticated user. All
+// other sessions belonging to the user are invalidated.
+func (c *Client) ChangePassword(ctx context.Context, current models.Password, newPassword models.Password) error {
+	variables := map[string]interface{}{
+		"current_password": current.String(),
+		"new_password":     newPassword.String(),
+	}
+
+	query := `mutation ChangePassword($current_password: Password!, $new_password: Password!) {
+		changePassword(input: {
+			current_password: $current_password,
+			new_password: $new_password,
+		})
+	}`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+type UpdateMeResponse struct {
+	User *models.User `json:"updateMe"`
+}
+
+// UpdateMe updates the name and/or email of the current authenticated user.
+// A new email only takes effect once it has been confirmed using
+// ConfirmEmailChange.
+func (c *Client) UpdateMe(ctx context.Context, name *models.Name, email *models.Email) (*models.User, error) {
+	var resp UpdateMeResponse
+
+	variables := map[string]interface{}{
+		"name":  name,
+		"email": email,
 	}
 
 	err := c.transport.Raw(ctx, `
-		query Users {
-			users {
+		mutation UpdateMe($name: Name, $email: ModelEmail) {
+			updateMe(input: { name: $name, email: $email }) {
 				id
 				name
 				email
-				role {
-					id
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.User, nil
+}
+
+type ConfirmEmailChangeResponse struct {
+	User *models.User `json:"confirmEmailChange"`
+}
+
+// ConfirmEmailChange applies a pending email change using the secret that
+// was mailed to the new address.
+func (c *Client) ConfirmEmailChange(ctx context.Context, ID string, secret models.Password) (*models.User, error) {
+	var resp ConfirmEmailChangeResponse
+
+	variables := map[string]interface{}{
+		"id":     ID,
+		"secret": secret.String(),
+	}
+
+	err := c.transport.Raw(ctx, `
+		mutation ConfirmEmailChange($id: String!, $secret: Password!) {
+			confirmEmailChange(input: { id: $id, secret: $secret }) {
+				id
+				name
+				email
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.User, nil
+}
+
+// InvitationResponse is an Invitation with the inviting user attached
+type InvitationResponse struct {
+	*models.Invitation
+	InvitedBy *models.User `json:"invited_by"`
+}
+
+type CreateInvitationResponse struct {
+	Invitation InvitationResponse `json:"createInvitation"`
+}
+
+// CreateInvitation invites the given email to create an account with the
+// given org role. The invitee will be added to the provided projects once
+// they accept the invitation.
+func (c *Client) CreateInvitation(
+	ctx context.Context,
+	email models.Email,
+	role models.Label,
+	projects []models.InvitationProject,
+) (*InvitationResponse, error) {
+	var resp CreateInvitationResponse
+
+	variables := map[string]interface{}{
+		"email":    email,
+		"role":     role,
+		"projects": projects,
+	}
+
+	err := c.transport.Raw(ctx, `
+		mutation CreateInvitation($email: ModelEmail!, $role: ModelLabel!, $projects: [InvitationProjectInput!]) {
+			createInvitation(input: { email: $email, role: $role, projects: $projects }) {
+				id
+				email
+				role
+				projects {
+					label
+					role
+				}
+				expires_at
+				created_at
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Invitation, nil
+}
+
+type ListInvitationsResponse struct {
+	Invitations []InvitationResponse `json:"invitations"`
+}
+
+// Invitations returns all of the outstanding invitations
+func (c *Client) Invitations(ctx context.Context) ([]InvitationResponse, error) {
+	var resp ListInvitationsResponse
+
+	err := c.transport.Raw(ctx, `
+		query Invitations {
+			invitations {
+				id
+				email
+				role
+				projects {
 					label
+					role
 				}
+				invited_by {
+					id
+					email
+				}
+				expires_at
+				created_at
 			}
 		}
 	`, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Invitations, nil
+}
+
+type ResendInvitationResponse struct {
+	Invitation InvitationResponse `json:"resendInvitation"`
+}
+
+// ResendInvitation sends a new secret to the invitee and resets the
+// expiration of the invitation
+func (c *Client) ResendInvitation(ctx context.Context, ID string) (*InvitationResponse, error) {
+	var resp ResendInvitationResponse
 
+	variables := map[string]interface{}{
+		"id": ID,
+	}
+
+	err := c.transport.Raw(ctx, `
+		mutation ResendInvitation($id: String!) {
+			resendInvitation(id: $id) {
+				id
+				email
+				expires_at
+			}
+		}
+	`, variables, &resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp.Users, nil
+	return &resp.Invitation, nil
+}
+
+// RevokeInvitation deletes an outstanding invitation
+func (c *Client) RevokeInvitation(ctx context.Context, ID string) error {
+	variables := map[string]interface{}{
+		"id": ID,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation RevokeInvitation($id: String!) {
+			revokeInvitation(id: $id)
+		}
+	`, variables, nil)
+}
+
+// AcceptInvitation creates an account using the secret sent in an
+// invitation. This does not require the client to be authenticated.
+func (c *Client) AcceptInvitation(
+	ctx context.Context,
+	ID string,
+	secret models.Password,
+	name models.Name,
+	password models.Password,
+) error {
+	variables := map[string]interface{}{
+		"id":       ID,
+		"secret":   secret.String(),
+		"name":     name,
+		"password": password.String(),
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation AcceptInvitation($id: String!, $secret: Password!, $name: Name!, $password: Password!) {
+			acceptInvitation(input: { id: $id, secret: $secret, name: $name, password: $password })
+		}
+	`, variables, nil)
 }
 
 func (c *Client) Authenticated() bool {
@@ -162,6 +363,54 @@ func (c *Client) TokenLogin(ctx context.Context, token *auth.APIToken) (*models.
 	return c.transport.TokenLogin(ctx, token)
 }
 
+// StartSSO begins signing in through the coordinator's identity provider.
+// The user must visit the returned AuthURL after which the session can be
+// collected using PollSSO.
+func (c *Client) StartSSO(ctx context.Context) (*SSOStartResponse, error) {
+	body, err := c.transport.Post(c.transport.URL().String()+"/v1/sso/start", struct{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SSOStartResponse{}
+	err = json.Unmarshal(body, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// PollSSO returns the session for a sign in started with StartSSO. An error
+// with the SSOPendingCause is returned until the user has signed in.
+func (c *Client) PollSSO(ctx context.Context, id string, secret models.Password) (*models.Session, error) {
+	req := SSOPollRequest{
+		ID:     id,
+		Secret: secret,
+	}
+
+	body, err := c.transport.Post(c.transport.URL().String()+"/v1/sso/poll", req)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &models.Session{}
+	err = json.Unmarshal(body, session)
+	if err != nil {
+		return nil, err
+	}
+
+	c.transport.SetSession(session)
+	return session, nil
+}
+
+// Refresh exchanges the client's refresh token for a new session. The
+// transport refreshes the session before it expires so this is rarely
+// needed.
+func (c *Client) Refresh(ctx context.Context) (*models.Session, error) {
+	return c.transport.Refresh(ctx)
+}
+
 // Logout calls the deleteSession mutation
 func (c *Client) Logout(ctx context.Context, authToken *base64.Value) error {
 	return c.transport.Logout(ctx, authToken)
@@ -252,17 +501,115 @@ func (c *Client) SetProjectRole(ctx context.Context, user models.Email, project
 // GetRoleByLabel returns a specific role by label
 func (c *Client) GetRoleByLabel(ctx context.Context, label models.Label) (*models.Role, error) {
 	var resp struct {
-		Role models.Role `json:"roleByLabel"`
+		Role models.Role `json:"role"`
 	}
 
 	variables := make(map[string]interface{})
 	variables["label"] = label
 
 	err := c.transport.Raw(ctx, `
-		query RoleByLabel($label: Label!) {
-			roleByLabel(label: $label) {
+		query Role($label: ModelLabel!) {
+			role(label: $label) {
+				id
+				label
+				system
+				kind
+				permissions
+				created_at
+				updated_at
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Role, nil
+}
+
+// ListRoles returns every system and custom role
+func (c *Client) ListRoles(ctx context.Context) ([]*models.Role, error) {
+	var resp struct {
+		Roles []*models.Role `json:"roles"`
+	}
+
+	err := c.transport.Raw(ctx, `
+		query Roles {
+			roles {
+				id
+				label
+				system
+				kind
+				permissions
+				created_at
+				updated_at
+			}
+		}
+	`, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Roles, nil
+}
+
+// CreateRole creates a custom role that grants the given permissions
+func (c *Client) CreateRole(ctx context.Context, label models.Label, kind models.RoleKind, perms []models.Permission) (*models.Role, error) {
+	var resp struct {
+		Role models.Role `json:"createRole"`
+	}
+
+	variables := map[string]interface{}{
+		"input": model.CreateRoleRequest{
+			Label:       label,
+			Kind:        kind,
+			Permissions: perms,
+		},
+	}
+
+	err := c.transport.Raw(ctx, `
+		mutation CreateRole($input: CreateRoleRequest!) {
+			createRole(input: $input) {
+				id
+				label
+				system
+				kind
+				permissions
+				created_at
+				updated_at
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Role, nil
+}
+
+// UpdateRole replaces the permissions granted by a custom role
+func (c *Client) UpdateRole(ctx context.Context, label models.Label, perms []models.Permission) (*models.Role, error) {
+	var resp struct {
+		Role models.Role `json:"updateRole"`
+	}
+
+	variables := map[string]interface{}{
+		"input": model.UpdateRoleRequest{
+			Label:       label,
+			Permissions: perms,
+		},
+	}
+
+	err := c.transport.Raw(ctx, `
+		mutation UpdateRole($input: UpdateRoleRequest!) {
+			updateRole(input: $input) {
 				id
 				label
+				system
+				kind
+				permissions
+				created_at
+				updated_at
 			}
 		}
 	`, variables, &resp)
@@ -273,6 +620,19 @@ func (c *Client) GetRoleByLabel(ctx context.Context, label models.Label) (*model
 	return &resp.Role, nil
 }
 
+// DeleteRole deletes a custom role that's no longer assigned to anyone
+func (c *Client) DeleteRole(ctx context.Context, label models.Label) error {
+	variables := map[string]interface{}{
+		"label": label,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation DeleteRole($label: ModelLabel!) {
+			deleteRole(label: $label)
+		}
+	`, variables, nil)
+}
+
 // GetUsers returns all users for the given emails
 func (c *Client) GetUsers(ctx context.Context, emails []models.Email) ([]*models.User, error) {
 	var resp struct {
@@ -306,8 +666,17 @@ type CreateTokenResponse struct {
 	Response *CreateTokenMutation `json:"createToken"`
 }
 
+// TokenOptions are the optional properties of a new API token
+type TokenOptions struct {
+	Name      string
+	ExpiresAt *time.Time
+
+	// Scope limits sessions created using the token to these permissions
+	Scope []models.Permission
+}
+
 // CreateToken creates a new API token for the provided user. You can pass nil and it will return a token for you
-func (c *Client) CreateToken(ctx context.Context, user *models.User) (*auth.APIToken, *models.Token, error) {
+func (c *Client) CreateToken(ctx context.Context, user *models.User, opts *TokenOptions) (*auth.APIToken, *models.Token, error) {
 	// If the user provides no user, we will make a token for the current session user
 	if user == nil {
 		i, err := c.Me(ctx)
@@ -318,16 +687,34 @@ func (c *Client) CreateToken(ctx context.Context, user *models.User) (*auth.APIT
 		user = i
 	}
 
+	if opts == nil {
+		opts = &TokenOptions{}
+	}
+
+	req := model.CreateTokenRequest{
+		UserID:    user.ID,
+		ExpiresAt: opts.ExpiresAt,
+		Scope:     opts.Scope,
+	}
+
+	if opts.Name != "" {
+		req.Name = &opts.Name
+	}
+
 	variables := make(map[string]interface{})
-	variables["user_id"] = user.ID
+	variables["input"] = req
 
 	resp := &CreateTokenResponse{}
 	err := c.transport.Raw(ctx, `
-		mutation CreateToken($user_id: String!) {
-			createToken(input: { user_id: $user_id }) {
+		mutation CreateToken($input: CreateTokenRequest!) {
+			createToken(input: $input) {
 				secret
 				token {
 					id
+					name
+					scope
+					created_at
+					expires_at
 				}
 			}
         }
@@ -346,11 +733,11 @@ func (c *Client) CreateToken(ctx context.Context, user *models.User) (*auth.APIT
 }
 
 type ListTokensResponse struct {
-	IDs []string `json:"tokens"`
+	Tokens []*models.Token `json:"tokens"`
 }
 
 // ListTokens lists all of the auth tokens for the provided user
-func (c *Client) ListTokens(ctx context.Context, user *models.User) ([]string, error) {
+func (c *Client) ListTokens(ctx context.Context, user *models.User) ([]*models.Token, error) {
 	// If the user provides no user, we will make a token for the current session user
 	if user == nil {
 		i, err := c.Me(ctx)
@@ -368,7 +755,16 @@ func (c *Client) ListTokens(ctx context.Context, user *models.User) ([]string, e
 
 	err := c.transport.Raw(ctx, `
 		query Tokens($user_id: String!) {
-			tokens(user_id: $user_id)
+			tokens(user_id: $user_id) {
+				id
+				user_id
+				name
+				scope
+				created_at
+				expires_at
+				last_used_at
+				last_used_ip
+			}
 		}
     `, variables, &resp)
 
@@ -376,7 +772,7 @@ func (c *Client) ListTokens(ctx context.Context, user *models.User) ([]string, e
 		return nil, err
 	}
 
-	return resp.IDs, nil
+	return resp.Tokens, nil
 }
 
 // RemoveToken removes the provided token from the database
@@ -763,7 +1159,147 @@ func (c *Client) UpdateProject(
 	return resp.Project, nil
 }
 
-type UpdateContributorResponse struct {
+type DestroyProjectKeyResponse struct {
+	Project *models.Project `json:"destroyProjectKey"`
+}
+
+// DestroyProjectKey deletes the key the project's secrets are encrypted
+// with, they can't be recovered afterwards
+func (c *Client) DestroyProjectKey(ctx context.Context, label models.Label) (*models.Project, error) {
+	variables := map[string]interface{}{
+		"label": label,
+	}
+
+	var resp DestroyProjectKeyResponse
+	err := c.transport.Raw(ctx, `
+		mutation DestroyProjectKey($label: ModelLabel!) {
+			destroyProjectKey(label: $label) {
+				id,
+				name,
+				label,
+				description,
+				status
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Project, nil
+}
+
+// SecretReferenceResponse is a spec's reference to a version of a secret
+type SecretReferenceResponse struct {
+	*models.SecretReference
+	Current bool `json:"current"`
+}
+
+// SecretResponse describes a project's secret and the specs using it
+type SecretResponse struct {
+	Name       string                    `json:"name"`
+	Type       string                    `json:"type"`
+	Versions   []int                     `json:"versions"`
+	References []SecretReferenceResponse `json:"references"`
+}
+
+type ListSecretsResponse struct {
+	Secrets []SecretResponse `json:"projectSecrets"`
+}
+
+// ListSecrets returns the project's secrets along with the specs that use
+// each of them
+func (c *Client) ListSecrets(ctx context.Context, label models.Label) ([]SecretResponse, error) {
+	variables := map[string]interface{}{
+		"project_label": label,
+	}
+
+	var resp ListSecretsResponse
+	err := c.transport.Raw(ctx, `
+		query ProjectSecrets($project_label: ModelLabel!) {
+			projectSecrets(project_label: $project_label) {
+				name
+				type
+				versions
+				references {
+					id
+					spec_id
+					version
+					current
+					created_at
+				}
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Secrets, nil
+}
+
+type RevealSecretResponse struct {
+	Value string `json:"revealSecret"`
+}
+
+// RevealSecret returns the base64 encoded value of a version of the secret,
+// the latest version is returned if version is nil
+func (c *Client) RevealSecret(ctx context.Context, label models.Label, name string, version *int) (string, error) {
+	variables := map[string]interface{}{
+		"project_label": label,
+		"name":          name,
+	}
+
+	if version != nil {
+		variables["version"] = *version
+	}
+
+	var resp RevealSecretResponse
+	err := c.transport.Raw(ctx, `
+		query RevealSecret($project_label: ModelLabel!, $name: String!, $version: Int) {
+			revealSecret(project_label: $project_label, name: $name, version: $version)
+		}
+	`, variables, &resp)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Value, nil
+}
+
+type RotateSecretResponse struct {
+	Suggestion models.Suggestion `json:"rotateSecret"`
+}
+
+// RotateSecret creates a new version of the secret, returning the
+// suggestion that updates the project's current spec to use it
+func (c *Client) RotateSecret(ctx context.Context, label models.Label, name string) (*models.Suggestion, error) {
+	variables := map[string]interface{}{
+		"project_label": label,
+		"name":          name,
+	}
+
+	var resp RotateSecretResponse
+	err := c.transport.Raw(ctx, `
+		mutation RotateSecret($project_label: ModelLabel!, $name: String!) {
+			rotateSecret(project_label: $project_label, name: $name) {
+				id
+				state
+				title
+				description
+				created_at
+				updated_at
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Suggestion, nil
+}
+
+type UpdateContributorResponse struct {
 	*models.Contributor `json:"updateContributor"`
 	User                *models.User `json:"user"`
 }
@@ -903,16 +1439,27 @@ func (c *Client) AttemptRecovery(ctx context.Context, ID string, secret models.P
 	return c.transport.Raw(ctx, query, variables, nil)
 }
 
+// RecoveryResponse is a Recovery with the user being recovered attached
+type RecoveryResponse struct {
+	*models.Recovery
+	User *models.User `json:"user"`
+}
+
 type ListRecoveriesResponse struct {
-	Recoveries []models.Recovery `json:"recoveries"`
+	Recoveries []RecoveryResponse `json:"recoveries"`
 }
 
-func (c *Client) Recoveries(ctx context.Context) ([]models.Recovery, error) {
+func (c *Client) Recoveries(ctx context.Context) ([]RecoveryResponse, error) {
 	var resp ListRecoveriesResponse
 	query := `
-		query ListRecoveries() {
-			recoveries() {
+		query ListRecoveries {
+			recoveries {
 				id,
+				user {
+					id,
+					email
+				},
+				expires_at,
 				created_at,
 				updated_at
 			}
@@ -939,3 +1486,538 @@ func (c *Client) DeleteRecoveries(ctx context.Context, ids []string) error {
 
 	return c.transport.Raw(ctx, query, variables, nil)
 }
+
+// MySessionResponse is a Session along with whether it's the session
+// being used to make the request
+type MySessionResponse struct {
+	*models.Session
+	Current bool `json:"current"`
+}
+
+type MySessionsResponse struct {
+	Sessions []MySessionResponse `json:"mySessions"`
+}
+
+// MySessions returns the active sessions belonging to the current user
+func (c *Client) MySessions(ctx context.Context) ([]MySessionResponse, error) {
+	var resp MySessionsResponse
+	query := `
+		query MySessions {
+			mySessions {
+				id,
+				type,
+				ip_address,
+				user_agent,
+				current,
+				created_at,
+				expires_at
+			}
+		}
+	`
+	err := c.transport.Raw(ctx, query, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Sessions, nil
+}
+
+// RevokeSession revokes one of the current user's sessions
+func (c *Client) RevokeSession(ctx context.Context, id string) error {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	query := `
+		mutation RevokeSession($id: String!) {
+			revokeSession(id: $id)
+		}
+	`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+// RevokeAllSessions revokes all of the sessions belonging to the given user
+// other than the one being used to make the request
+func (c *Client) RevokeAllSessions(ctx context.Context, user *models.User) error {
+	variables := map[string]interface{}{
+		"user_id": user.ID,
+	}
+
+	query := `
+		mutation RevokeAllSessions($user_id: String!) {
+			revokeAllSessions(user_id: $user_id)
+		}
+	`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+// MFALogin logs in as a user who has enrolled in multi-factor
+// authentication using their one-time code or one of their recovery codes
+func (c *Client) MFALogin(ctx context.Context, email models.Email, password models.Password, code string) (*models.Session, error) {
+	return emailLogin(ctx, c.transport, email, password, code)
+}
+
+type MFAStatusResponse struct {
+	Status *model.MFAStatus `json:"mfaStatus"`
+}
+
+// MFAStatus returns whether the current user has enabled multi-factor
+// authentication
+func (c *Client) MFAStatus(ctx context.Context) (*model.MFAStatus, error) {
+	var resp MFAStatusResponse
+	query := `
+		query MFAStatus {
+			mfaStatus {
+				enrolled,
+				recovery_codes_remaining,
+				admin_mfa_required
+			}
+		}
+	`
+	err := c.transport.Raw(ctx, query, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Status, nil
+}
+
+type EnrollMFAResponse struct {
+	Enrollment *model.EnrollMFAResponse `json:"enrollMFA"`
+}
+
+// EnrollMFA generates a new TOTP secret for the current user which must be
+// confirmed with ConfirmMFA before it is required at login
+func (c *Client) EnrollMFA(ctx context.Context) (*model.EnrollMFAResponse, error) {
+	var resp EnrollMFAResponse
+	query := `
+		mutation EnrollMFA {
+			enrollMFA {
+				secret,
+				uri
+			}
+		}
+	`
+	err := c.transport.Raw(ctx, query, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Enrollment, nil
+}
+
+type ConfirmMFAResponse struct {
+	Confirmation *model.ConfirmMFAResponse `json:"confirmMFA"`
+}
+
+// ConfirmMFA enables multi-factor authentication for the current user,
+// returning their recovery codes
+func (c *Client) ConfirmMFA(ctx context.Context, code string) ([]string, error) {
+	variables := map[string]interface{}{
+		"code": code,
+	}
+
+	var resp ConfirmMFAResponse
+	query := `
+		mutation ConfirmMFA($code: String!) {
+			confirmMFA(code: $code) {
+				recovery_codes
+			}
+		}
+	`
+	err := c.transport.Raw(ctx, query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Confirmation.RecoveryCodes, nil
+}
+
+// DisableMFA turns off multi-factor authentication for the current user
+func (c *Client) DisableMFA(ctx context.Context, code string) error {
+	variables := map[string]interface{}{
+		"code": code,
+	}
+
+	query := `
+		mutation DisableMFA($code: String!) {
+			disableMFA(code: $code)
+		}
+	`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+// ResetMFA turns off multi-factor authentication for the given user so they
+// can enroll again
+func (c *Client) ResetMFA(ctx context.Context, user *models.User) error {
+	variables := map[string]interface{}{
+		"user_id": user.ID,
+	}
+
+	query := `
+		mutation ResetMFA($user_id: String!) {
+			resetMFA(user_id: $user_id)
+		}
+	`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+// UnlockUser clears the failed login attempts of the given user, lifting a
+// lockout so they can login again straight away
+func (c *Client) UnlockUser(ctx context.Context, user *models.User) error {
+	variables := map[string]interface{}{
+		"user_id": user.ID,
+	}
+
+	query := `
+		mutation UnlockUser($user_id: String!) {
+			unlockUser(user_id: $user_id)
+		}
+	`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+// SetAdminMFARequired changes whether admins must login with a one-time
+// code to use their admin permissions
+func (c *Client) SetAdminMFARequired(ctx context.Context, required bool) error {
+	variables := map[string]interface{}{
+		"required": required,
+	}
+
+	query := `
+		mutation SetAdminMFARequired($required: Boolean!) {
+			setAdminMFARequired(required: $required)
+		}
+	`
+
+	return c.transport.Raw(ctx, query, variables, nil)
+}
+
+type GQLTeam struct {
+	*models.Team
+	Members  []GQLTeamMember  `json:"members"`
+	Projects []GQLTeamProject `json:"projects"`
+}
+
+type GQLTeamMember struct {
+	*models.TeamMember
+	User models.User `json:"user"`
+}
+
+type GQLTeamProject struct {
+	*models.TeamProject
+	Project models.Project `json:"project"`
+	Role    models.Role    `json:"role"`
+}
+
+// ListTeams returns every team without their members or projects
+func (c *Client) ListTeams(ctx context.Context) ([]GQLTeam, error) {
+	var resp struct {
+		Teams []GQLTeam `json:"teams"`
+	}
+
+	err := c.transport.Raw(ctx, `
+		query Teams {
+			teams {
+				id
+				label
+				name
+				created_at
+				updated_at
+			}
+		}
+	`, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Teams, nil
+}
+
+// GetTeam returns a team along with its members and the projects it
+// contributes to
+func (c *Client) GetTeam(ctx context.Context, label models.Label) (*GQLTeam, error) {
+	var resp struct {
+		Team GQLTeam `json:"team"`
+	}
+
+	variables := map[string]interface{}{
+		"label": label,
+	}
+
+	err := c.transport.Raw(ctx, `
+		query Team($label: ModelLabel!) {
+			team(label: $label) {
+				id
+				label
+				name
+				created_at
+				updated_at
+
+				members {
+					id
+					maintainer
+					user {
+						id
+						name
+						email
+					}
+				}
+
+				projects {
+					id
+					project {
+						id
+						label
+					}
+					role {
+						id
+						label
+					}
+				}
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Team, nil
+}
+
+// CreateTeam creates a team without any members
+func (c *Client) CreateTeam(ctx context.Context, label models.Label, name string) (*models.Team, error) {
+	var resp struct {
+		Team models.Team `json:"createTeam"`
+	}
+
+	variables := map[string]interface{}{
+		"label": label,
+		"name":  name,
+	}
+
+	err := c.transport.Raw(ctx, `
+		mutation CreateTeam($label: ModelLabel!, $name: String!) {
+			createTeam(label: $label, name: $name) {
+				id
+				label
+				name
+				created_at
+				updated_at
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Team, nil
+}
+
+// DeleteTeam deletes a team, its members lose any roles granted through it
+func (c *Client) DeleteTeam(ctx context.Context, label models.Label) error {
+	variables := map[string]interface{}{
+		"label": label,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation DeleteTeam($label: ModelLabel!) {
+			deleteTeam(label: $label)
+		}
+	`, variables, nil)
+}
+
+// AddTeamMember adds a user to a team or changes whether an existing member
+// is a maintainer
+func (c *Client) AddTeamMember(ctx context.Context, team models.Label, email models.Email, maintainer bool) error {
+	variables := map[string]interface{}{
+		"team_label": team,
+		"user_email": email,
+		"maintainer": maintainer,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation AddTeamMember($team_label: ModelLabel!, $user_email: ModelEmail!, $maintainer: Boolean) {
+			addTeamMember(team_label: $team_label, user_email: $user_email, maintainer: $maintainer) {
+				id
+			}
+		}
+	`, variables, nil)
+}
+
+// RemoveTeamMember removes a user from a team
+func (c *Client) RemoveTeamMember(ctx context.Context, team models.Label, email models.Email) error {
+	variables := map[string]interface{}{
+		"team_label": team,
+		"user_email": email,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation RemoveTeamMember($team_label: ModelLabel!, $user_email: ModelEmail!) {
+			removeTeamMember(team_label: $team_label, user_email: $user_email)
+		}
+	`, variables, nil)
+}
+
+// SetTeamProjectRole makes a team a contributor to a project, granting its
+// members the given project role
+func (c *Client) SetTeamProjectRole(ctx context.Context, team models.Label, project models.Label, role models.Label) error {
+	variables := map[string]interface{}{
+		"team_label":    team,
+		"project_label": project,
+		"role_label":    role,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation SetTeamProjectRole($team_label: ModelLabel!, $project_label: ModelLabel!, $role_label: ModelLabel!) {
+			setTeamProjectRole(team_label: $team_label, project_label: $project_label, role_label: $role_label) {
+				id
+			}
+		}
+	`, variables, nil)
+}
+
+// RemoveTeamProject removes a team from a project's contributors
+func (c *Client) RemoveTeamProject(ctx context.Context, team models.Label, project models.Label) error {
+	variables := map[string]interface{}{
+		"team_label":    team,
+		"project_label": project,
+	}
+
+	return c.transport.Raw(ctx, `
+		mutation RemoveTeamProject($team_label: ModelLabel!, $project_label: ModelLabel!) {
+			removeTeamProject(team_label: $team_label, project_label: $project_label)
+		}
+	`, variables, nil)
+}
+
+// ListAuditEvents returns the audit events matching the filter, most recent
+// first
+func (c *Client) ListAuditEvents(ctx context.Context, filter model.AuditEventFilter, limit int) ([]*models.AuditEvent, error) {
+	var resp struct {
+		Events []*models.AuditEvent `json:"auditEvents"`
+	}
+
+	variables := map[string]interface{}{
+		"filter": filter,
+		"limit":  limit,
+	}
+
+	err := c.transport.Raw(ctx, `
+		query AuditEvents($filter: AuditEventFilter, $limit: Int) {
+			auditEvents(filter: $filter, limit: $limit) {
+				id
+				request_id
+				sequence
+				actor_id
+				actor_email
+				action
+				target
+				outcome
+				error
+				before
+				after
+				ip_address
+				created_at
+			}
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Events, nil
+}
+
+// AuditChain returns up to limit audit events appended after the given
+// sequence, in the order they were appended
+func (c *Client) AuditChain(ctx context.Context, after int64, limit int) ([]models.AuditEvent, error) {
+	var resp struct {
+		Chain []string `json:"auditChain"`
+	}
+
+	variables := map[string]interface{}{
+		"after_sequence": after,
+		"limit":          limit,
+	}
+
+	err := c.transport.Raw(ctx, `
+		query AuditChain($after_sequence: Int, $limit: Int) {
+			auditChain(after_sequence: $after_sequence, limit: $limit)
+		}
+	`, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]models.AuditEvent, len(resp.Chain))
+	for i, doc := range resp.Chain {
+		err := json.Unmarshal([]byte(doc), &events[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// AuditCheckpoints returns every signed checkpoint of the audit chain
+func (c *Client) AuditCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error) {
+	var resp struct {
+		Checkpoints []string `json:"auditCheckpoints"`
+	}
+
+	err := c.transport.Raw(ctx, `
+		query AuditCheckpoints {
+			auditCheckpoints
+		}
+	`, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoints := make([]models.AuditCheckpoint, len(resp.Checkpoints))
+	for i, doc := range resp.Checkpoints {
+		err := json.Unmarshal([]byte(doc), &checkpoints[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return checkpoints, nil
+}
+
+// ExportAudit returns the entire audit chain along with its checkpoints so
+// it can be verified offline
+func (c *Client) ExportAudit(ctx context.Context) (*audit.Export, error) {
+	// Checkpoints are fetched first so every checkpointed event is included
+	// in the chain even if events are appended during the export
+	checkpoints, err := c.AuditCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &audit.Export{Checkpoints: checkpoints}
+
+	var after int64
+	for {
+		events, err := c.AuditChain(ctx, after, auditExportPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(events) == 0 {
+			break
+		}
+
+		export.Events = append(export.Events, events...)
+		after = events[len(events)-1].Sequence
+	}
+
+	return export, nil
+}
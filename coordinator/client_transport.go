@@ -8,6 +8,7 @@ import (
 
 	"github.com/capeprivacy/cape/auth"
 	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
 // ClientTransport is an interface that describes how a coordinator client should communicate with a coordinator
@@ -22,6 +23,13 @@ type ClientTransport interface {
 	SetToken(*base64.Value)
 	Token() *base64.Value
 
+	// SetSession sets the auth token, and the refresh token used to renew
+	// it, from a session returned by the coordinator
+	SetSession(*models.Session)
+
+	// Refresh exchanges the refresh token for a new session
+	Refresh(ctx context.Context) (*models.Session, error)
+
 	EmailLogin(ctx context.Context, email models.Email, password models.Password) (*models.Session, error)
 	TokenLogin(ctx context.Context, apiToken *auth.APIToken) (*models.Session, error)
 
@@ -45,14 +53,15 @@ func tokenLogin(ctx context.Context, transport ClientTransport, apiToken *auth.A
 		return nil, err
 	}
 
-	transport.SetToken(session.Token)
+	transport.SetSession(session)
 	return session, nil
 }
 
-func emailLogin(ctx context.Context, transport ClientTransport, email models.Email, password models.Password) (*models.Session, error) {
+func emailLogin(ctx context.Context, transport ClientTransport, email models.Email, password models.Password, otp string) (*models.Session, error) {
 	req := LoginRequest{
 		Email:  &email,
 		Secret: password,
+		OTP:    otp,
 	}
 
 	body, err := transport.Post(transport.URL().String()+"/v1/login", req)
@@ -65,7 +74,31 @@ func emailLogin(ctx context.Context, transport ClientTransport, email models.Ema
 	if err != nil {
 		return nil, err
 	}
-	transport.SetToken(session.Token)
+	transport.SetSession(session)
+
+	return session, nil
+}
+
+func refresh(ctx context.Context, transport ClientTransport, refreshToken *base64.Value) (*models.Session, error) {
+	if refreshToken == nil {
+		return nil, errors.New(NoRefreshTokenCause, "The session can't be refreshed, please login again")
+	}
+
+	req := RefreshRequest{
+		RefreshToken: refreshToken,
+	}
+
+	body, err := transport.Post(transport.URL().String()+"/v1/refresh", req)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &models.Session{}
+	err = json.Unmarshal(body, session)
+	if err != nil {
+		return nil, err
+	}
+	transport.SetSession(session)
 
 	return session, nil
 }
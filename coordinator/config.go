@@ -2,10 +2,13 @@ package coordinator
 
 import (
 	"io/ioutil"
+	"time"
 
 	"github.com/manifoldco/go-base64"
 	"sigs.k8s.io/yaml"
 
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/audit"
 	"github.com/capeprivacy/cape/coordinator/db/crypto"
 	"github.com/capeprivacy/cape/models"
 	errors "github.com/capeprivacy/cape/partyerrors"
@@ -42,6 +45,24 @@ type Config struct {
 	// CORS headers
 	Cors CorsConfig `json:"cors"`
 
+	// Janitor configures the background job that purges expired sessions,
+	// recoveries, email changes, sso attempts, invitations and api tokens
+	Janitor JanitorConfig `json:"janitor"`
+
+	// Audit configures how often the audit log is checkpointed
+	Audit AuditConfig `json:"audit"`
+
+	// Sessions configures how long sessions last and for how long they can
+	// be refreshed
+	Sessions SessionConfig `json:"sessions"`
+
+	// Passwords configures the passwords users can choose and how they're
+	// hashed
+	Passwords PasswordConfig `json:"passwords"`
+
+	// SSO enables signing in through an OpenID Connect identity provider
+	SSO *SSOConfig `json:"sso,omitempty"`
+
 	// Required if no admin user has been c
 	User *UserConfig `json:"user,omitempty"`
 }
@@ -57,6 +78,245 @@ type CorsConfig struct {
 	AllowOrigin []string `json:"allow_origin,omitempty"`
 }
 
+// SSOConfig configures single sign on through an OpenID Connect identity
+// provider
+type SSOConfig struct {
+	Issuer       string `json:"issuer"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+
+	// RedirectURL is the url of this coordinator's /v1/sso/callback route as
+	// seen by the user's browser. It must be registered with the provider.
+	RedirectURL string `json:"redirect_url"`
+
+	Scopes      []string `json:"scopes,omitempty"`
+	GroupsClaim string   `json:"groups_claim,omitempty"`
+
+	// AutoProvision creates a user with the DefaultRole the first time
+	// someone unknown to Cape signs in through the identity provider
+	AutoProvision bool         `json:"auto_provision"`
+	DefaultRole   models.Label `json:"default_role,omitempty"`
+
+	// GroupRoles maps the groups a user belongs to in the identity provider
+	// to org or project roles, these are applied every time they sign in
+	GroupRoles []SSOGroupRole `json:"group_roles,omitempty"`
+}
+
+// SSOGroupRole grants Role to members of Group. If a Project is provided
+// then the Role must be a project role, otherwise it must be an org role.
+type SSOGroupRole struct {
+	Group   string        `json:"group"`
+	Role    models.Label  `json:"role"`
+	Project *models.Label `json:"project,omitempty"`
+}
+
+// GetDefaultRole returns the org role given to auto provisioned users
+func (s *SSOConfig) GetDefaultRole() models.Label {
+	if s.DefaultRole == "" {
+		return models.UserRole
+	}
+
+	return s.DefaultRole
+}
+
+// Validate returns an error if the SSOConfig is invalid
+func (s *SSOConfig) Validate() error {
+	if s.Issuer == "" || s.ClientID == "" || s.RedirectURL == "" {
+		return errors.New(InvalidConfigCause, "SSO requires an issuer, client_id and redirect_url")
+	}
+
+	if !models.ValidOrgRole(s.GetDefaultRole()) {
+		return errors.New(InvalidConfigCause, "SSO default_role must be an org role")
+	}
+
+	for _, gr := range s.GroupRoles {
+		if gr.Group == "" {
+			return errors.New(InvalidConfigCause, "SSO group_roles must specify a group")
+		}
+
+		if gr.Project == nil && !models.ValidOrgRole(gr.Role) {
+			return errors.New(InvalidConfigCause, "SSO group %s must map to an org role", gr.Group)
+		}
+
+		if gr.Project != nil && !models.ValidProjectRole(gr.Role) {
+			return errors.New(InvalidConfigCause, "SSO group %s must map to a project role", gr.Group)
+		}
+	}
+
+	return nil
+}
+
+type JanitorConfig struct {
+	Disable  bool             `json:"disable"`
+	Interval *models.Duration `json:"interval,omitempty"`
+}
+
+// GetInterval returns the configured interval or the default if one was not
+// provided
+func (j JanitorConfig) GetInterval() time.Duration {
+	if j.Interval == nil {
+		return DefaultJanitorInterval
+	}
+
+	return j.Interval.Duration
+}
+
+type AuditConfig struct {
+	// CheckpointInterval is how often the most recent audit event is signed
+	// by the coordinator's signing key
+	CheckpointInterval *models.Duration `json:"checkpoint_interval,omitempty"`
+}
+
+// GetCheckpointInterval returns the configured interval or the default if
+// one was not provided
+func (a AuditConfig) GetCheckpointInterval() time.Duration {
+	if a.CheckpointInterval == nil {
+		return audit.DefaultCheckpointInterval
+	}
+
+	return a.CheckpointInterval.Duration
+}
+
+var (
+	// DefaultSessionIdleTimeout is how long a session can go without being
+	// refreshed if no idle timeout has been configured
+	DefaultSessionIdleTimeout = 7 * 24 * time.Hour
+
+	// DefaultSessionMaxLifetime is how long after logging in a session can
+	// be refreshed until if no maximum lifetime has been configured
+	DefaultSessionMaxLifetime = 30 * 24 * time.Hour
+)
+
+// SessionConfig configures the lifetime of sessions.
+//
+// A session token lasts for Lifetime after which the client must use its
+// refresh token to get a new session. Refresh tokens expire after
+// IdleTimeout, and no session outlives MaxLifetime from when the user logged
+// in, at which point they must login again.
+type SessionConfig struct {
+	Lifetime    *models.Duration `json:"lifetime,omitempty"`
+	IdleTimeout *models.Duration `json:"idle_timeout,omitempty"`
+	MaxLifetime *models.Duration `json:"max_lifetime,omitempty"`
+}
+
+// GetLifetime returns the configured lifetime or the default if one was not
+// provided
+func (s SessionConfig) GetLifetime() time.Duration {
+	if s.Lifetime == nil {
+		return auth.TokenDuration
+	}
+
+	return s.Lifetime.Duration
+}
+
+// GetIdleTimeout returns the configured idle timeout or the default if one
+// was not provided
+func (s SessionConfig) GetIdleTimeout() time.Duration {
+	if s.IdleTimeout == nil {
+		return DefaultSessionIdleTimeout
+	}
+
+	return s.IdleTimeout.Duration
+}
+
+// GetMaxLifetime returns the configured maximum lifetime or the default if
+// one was not provided
+func (s SessionConfig) GetMaxLifetime() time.Duration {
+	if s.MaxLifetime == nil {
+		return DefaultSessionMaxLifetime
+	}
+
+	return s.MaxLifetime.Duration
+}
+
+// ExpiresAt returns when a session created now for a user who logged in at
+// authenticatedAt expires
+func (s SessionConfig) ExpiresAt(authenticatedAt time.Time) time.Time {
+	return earliest(time.Now().UTC().Add(s.GetLifetime()), authenticatedAt.Add(s.GetMaxLifetime()))
+}
+
+// RefreshExpiresAt returns when a refresh token issued now for a user who
+// logged in at authenticatedAt expires
+func (s SessionConfig) RefreshExpiresAt(authenticatedAt time.Time) time.Time {
+	return earliest(time.Now().UTC().Add(s.GetIdleTimeout()), authenticatedAt.Add(s.GetMaxLifetime()))
+}
+
+// Validate returns an error if the SessionConfig is invalid
+func (s SessionConfig) Validate() error {
+	if s.GetLifetime() <= 0 || s.GetIdleTimeout() <= 0 || s.GetMaxLifetime() <= 0 {
+		return errors.New(InvalidConfigCause, "Session lifetime, idle_timeout and max_lifetime must be greater than zero")
+	}
+
+	if s.GetLifetime() > s.GetMaxLifetime() {
+		return errors.New(InvalidConfigCause, "Session lifetime cannot be longer than max_lifetime")
+	}
+
+	return nil
+}
+
+func earliest(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+
+	return a
+}
+
+// PasswordConfig configures the password policy and the parameters used to
+// hash passwords
+type PasswordConfig struct {
+	Policy models.PasswordPolicy `json:"policy"`
+
+	// Argon2 overrides the parameters passwords are hashed with. Existing
+	// passwords are rehashed with the new parameters the next time their
+	// owner logs in.
+	Argon2 *Argon2Config `json:"argon2,omitempty"`
+}
+
+// Argon2Config are the cost parameters given to Argon2ID, memory is in KiB
+type Argon2Config struct {
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+// Producer returns the credential producer for hashing passwords with the
+// configured parameters, or the default producer if none were configured
+func (p PasswordConfig) Producer() *auth.Argon2IDProducer {
+	if p.Argon2 == nil {
+		return auth.DefaultArgon2IDProducer
+	}
+
+	return &auth.Argon2IDProducer{
+		Time:      p.Argon2.Time,
+		Memory:    p.Argon2.Memory,
+		Threads:   p.Argon2.Threads,
+		KeyLength: models.SecretLength,
+	}
+}
+
+// Validate returns an error if the PasswordConfig is invalid
+func (p PasswordConfig) Validate() error {
+	if err := p.Policy.Validate(); err != nil {
+		return err
+	}
+
+	if p.Argon2 == nil {
+		return nil
+	}
+
+	if p.Argon2.Time < 1 || p.Argon2.Threads < 1 {
+		return errors.New(InvalidConfigCause, "Argon2 time and threads must be at least 1")
+	}
+
+	// Argon2 requires at least 8KiB of memory per thread
+	if p.Argon2.Memory < 8*uint32(p.Argon2.Threads) {
+		return errors.New(InvalidConfigCause, "Argon2 memory must be at least 8KiB per thread")
+	}
+
+	return nil
+}
+
 // DBConfig represent the database configuration
 type DBConfig struct {
 	Addr *models.DBURL `json:"addr"`
@@ -117,6 +377,28 @@ func (c *Config) Validate() error {
 		return errors.New(InvalidConfigCause, "Missing root key")
 	}
 
+	if c.Janitor.Interval != nil && c.Janitor.Interval.Duration <= 0 {
+		return errors.New(InvalidConfigCause, "Janitor interval must be greater than zero")
+	}
+
+	if c.Audit.CheckpointInterval != nil && c.Audit.CheckpointInterval.Duration <= 0 {
+		return errors.New(InvalidConfigCause, "Audit checkpoint interval must be greater than zero")
+	}
+
+	if err := c.Sessions.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Passwords.Validate(); err != nil {
+		return err
+	}
+
+	if c.SSO != nil {
+		if err := c.SSO.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package coordinator
 import (
 	"github.com/capeprivacy/cape/models"
 	"testing"
+	"time"
 
 	gm "github.com/onsi/gomega"
 
@@ -77,6 +78,151 @@ func TestCoordinatorConfg(t *testing.T) {
 				},
 				cause: &InvalidConfigCause,
 			},
+			{
+				name: "invalid janitor interval",
+				fn: func() (*Config, error) {
+					d := models.NewDuration(0)
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						Janitor: JanitorConfig{Interval: &d},
+					}, nil
+				},
+				cause: &InvalidConfigCause,
+			},
+			{
+				name: "valid session config",
+				fn: func() (*Config, error) {
+					lifetime := models.NewDuration(time.Hour)
+					idle := models.NewDuration(24 * time.Hour)
+					return &Config{
+						Version:  1,
+						Port:     8080,
+						DB:       validCfg.DB,
+						RootKey:  validCfg.RootKey,
+						Sessions: SessionConfig{Lifetime: &lifetime, IdleTimeout: &idle},
+					}, nil
+				},
+			},
+			{
+				name: "session lifetime longer than max lifetime",
+				fn: func() (*Config, error) {
+					lifetime := models.NewDuration(48 * time.Hour)
+					max := models.NewDuration(24 * time.Hour)
+					return &Config{
+						Version:  1,
+						Port:     8080,
+						DB:       validCfg.DB,
+						RootKey:  validCfg.RootKey,
+						Sessions: SessionConfig{Lifetime: &lifetime, MaxLifetime: &max},
+					}, nil
+				},
+				cause: &InvalidConfigCause,
+			},
+			{
+				name: "valid sso config",
+				fn: func() (*Config, error) {
+					project := models.Label("my-project")
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						SSO: &SSOConfig{
+							Issuer:      "https://idp.cape.com",
+							ClientID:    "cape",
+							RedirectURL: "https://cape.com/v1/sso/callback",
+							GroupRoles: []SSOGroupRole{
+								{Group: "admins", Role: models.AdminRole},
+								{Group: "data", Role: models.ProjectReaderRole, Project: &project},
+							},
+						},
+					}, nil
+				},
+			},
+			{
+				name: "sso missing issuer",
+				fn: func() (*Config, error) {
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						SSO: &SSOConfig{
+							ClientID:    "cape",
+							RedirectURL: "https://cape.com/v1/sso/callback",
+						},
+					}, nil
+				},
+				cause: &InvalidConfigCause,
+			},
+			{
+				name: "sso group mapped to project role without a project",
+				fn: func() (*Config, error) {
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						SSO: &SSOConfig{
+							Issuer:      "https://idp.cape.com",
+							ClientID:    "cape",
+							RedirectURL: "https://cape.com/v1/sso/callback",
+							GroupRoles: []SSOGroupRole{
+								{Group: "data", Role: models.ProjectReaderRole},
+							},
+						},
+					}, nil
+				},
+				cause: &InvalidConfigCause,
+			},
+			{
+				name: "valid passwords",
+				fn: func() (*Config, error) {
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						Passwords: PasswordConfig{
+							Policy: models.PasswordPolicy{MinLength: 12, RequireDigit: true, History: 5},
+							Argon2: &Argon2Config{Time: 3, Memory: 64 * 1024, Threads: 2},
+						},
+					}, nil
+				},
+			},
+			{
+				name: "argon2 without enough memory",
+				fn: func() (*Config, error) {
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						Passwords: PasswordConfig{
+							Argon2: &Argon2Config{Time: 1, Memory: 8, Threads: 4},
+						},
+					}, nil
+				},
+				cause: &InvalidConfigCause,
+			},
+			{
+				name: "negative password history",
+				fn: func() (*Config, error) {
+					return &Config{
+						Version: 1,
+						Port:    8080,
+						DB:      validCfg.DB,
+						RootKey: validCfg.RootKey,
+						Passwords: PasswordConfig{
+							Policy: models.PasswordPolicy{History: -1},
+						},
+					}, nil
+				},
+				cause: &models.InvalidConfigCause,
+			},
 		}
 
 		for _, tc := range tests {
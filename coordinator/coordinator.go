@@ -2,7 +2,6 @@ package coordinator
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -18,6 +17,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/audit"
 	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/db/crypto"
 	"github.com/capeprivacy/cape/coordinator/db/encrypt"
@@ -25,6 +25,8 @@ import (
 	"github.com/capeprivacy/cape/coordinator/graph"
 	"github.com/capeprivacy/cape/coordinator/graph/generated"
 	"github.com/capeprivacy/cape/coordinator/mailer"
+	"github.com/capeprivacy/cape/coordinator/oidc"
+	"github.com/capeprivacy/cape/coordinator/throttle"
 	"github.com/capeprivacy/cape/models"
 	errors "github.com/capeprivacy/cape/partyerrors"
 )
@@ -38,18 +40,45 @@ type Coordinator struct {
 	mailer  mailer.Mailer
 	pool    *pgxpool.Pool
 	db      db.Interface
+	janitor *Janitor
+	limiter *throttle.Limiter
+	audit   *audit.Log
+
+	checkpointer *audit.Checkpointer
 
 	tokenAuth          *auth.TokenAuthority
 	credentialProducer auth.CredentialProducer
+	ssoProvider        *oidc.Provider
 }
 
 // Setup the coordinator so it's ready to be served!
 func (c *Coordinator) Setup(ctx context.Context) (http.Handler, error) {
+	c.checkpointer = audit.NewCheckpointer(c.db.Audit(), c.tokenAuth.Sign, c.cfg.Audit.GetCheckpointInterval(), c.logger)
+	c.checkpointer.Start()
+
+	if c.cfg.Janitor.Disable {
+		c.logger.Info().Msg("not starting janitor")
+		return c.handler, nil
+	}
+
+	c.janitor = NewJanitor(c.pool, c.cfg.Janitor.GetInterval(), c.logger)
+	c.janitor.Start()
+
 	return c.handler, nil
 }
 
 // Teardown the coordinator taking it back to it's start state!
 func (c *Coordinator) Teardown(ctx context.Context) error {
+	if c.janitor != nil {
+		c.janitor.Stop()
+		c.janitor = nil
+	}
+
+	if c.checkpointer != nil {
+		c.checkpointer.Stop()
+		c.checkpointer = nil
+	}
+
 	c.pool.Close()
 	return nil
 }
@@ -81,7 +110,7 @@ func New(cfg *Config, logger *zerolog.Logger, mailer mailer.Mailer) (*Coordinato
 	case models.SHA256:
 		cp = auth.DefaultSHA256Producer
 	case models.Argon2ID:
-		cp = auth.DefaultArgon2IDProducer
+		cp = cfg.Passwords.Producer()
 	default:
 		return nil, errors.New(InvalidConfigCause, "Unknown credential producer algorithm supplied")
 	}
@@ -102,28 +131,67 @@ func New(cfg *Config, logger *zerolog.Logger, mailer mailer.Mailer) (*Coordinato
 		return nil, err
 	}
 
+	coor.limiter = throttle.New(coor.db)
+	coor.audit = audit.New(coor.db.Audit())
+
+	if cfg.SSO != nil {
+		coor.ssoProvider, err = oidc.NewProvider(oidc.Config{
+			Issuer:       cfg.SSO.Issuer,
+			ClientID:     cfg.SSO.ClientID,
+			ClientSecret: cfg.SSO.ClientSecret,
+			RedirectURL:  cfg.SSO.RedirectURL,
+			Scopes:       cfg.SSO.Scopes,
+			GroupsClaim:  cfg.SSO.GroupsClaim,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	config := generated.Config{
 		Resolvers: &graph.Resolver{
 			Database:           coor.db,
 			CredentialProducer: cp,
 			Mailer:             mailer,
-		}}
+			Limiter:            coor.limiter,
+			PasswordPolicy:     cfg.Passwords.Policy,
+		},
+		Directives: generated.DirectiveRoot{
+			Public:        PublicDirective,
+			Authenticated: AuthenticatedDirective,
+			HasPermission: HasPermissionDirective(coor.db),
+		},
+	}
 
 	gqlHandler := handler.NewDefaultServer(generated.NewExecutableSchema(config))
 	gqlHandler.SetErrorPresenter(errorPresenter)
+	gqlHandler.AroundFields(PublicFieldMiddleware)
+	gqlHandler.AroundFields(coor.audit.FieldMiddleware)
 
 	authenticated := IsAuthenticatedMiddleware(coor)
+	maybeAuthenticated := MaybeAuthenticatedMiddleware(coor)
 
 	root := http.NewServeMux()
 	root.Handle("/v1", playground.Handler("GraphQL playground", "/query"))
-	root.Handle("/v1/query", AuthTokenMiddleware(authenticated(gqlHandler)))
+	root.Handle("/v1/query", AuthTokenMiddleware(maybeAuthenticated(gqlHandler)))
 	root.Handle("/v1/version", VersionHandler(cfg.InstanceID.String()))
+	root.Handle("/v1/jwks", JWKSHandler(coor))
 	root.Handle("/v1/login", LoginHandler(coor))
+	root.Handle("/v1/refresh", RefreshHandler(coor))
 	root.Handle("/v1/logout", AuthTokenMiddleware(authenticated(LogoutHandler(coor))))
 
+	if coor.ssoProvider != nil {
+		logger.Info().Str("issuer", cfg.SSO.Issuer).Msg("enabling SSO")
+		root.Handle("/v1/sso/start", SSOStartHandler(coor))
+		root.Handle("/v1/sso/callback", SSOCallbackHandler(coor))
+		root.Handle("/v1/sso/confirm", SSOConfirmHandler(coor))
+		root.Handle("/v1/sso/poll", SSOPollHandler(coor))
+	}
+
 	health := healthz.NewHandler(root)
 	chain := alice.New(
 		RequestIDMiddleware,
+		RemoteIPMiddleware,
 		LogMiddleware(logger),
 		RoundtripLoggerMiddleware,
 		RecoveryMiddleware,
@@ -160,13 +228,12 @@ func New(cfg *Config, logger *zerolog.Logger, mailer mailer.Mailer) (*Coordinato
 }
 
 func (c *Coordinator) doSetup(ctx context.Context, capedb db.Interface) error {
-	_, codec, kp, err := getDatabaseConfig(ctx, capedb, c.cfg.RootKey)
+	_, codec, err := getDatabaseConfig(ctx, capedb, c.cfg.RootKey)
 	if err == nil {
-		ta, err := auth.NewTokenAuthority(kp, c.cfg.InstanceID.String())
+		err = c.setupTokenAuthority(capedb)
 		if err != nil {
 			return err
 		}
-		c.tokenAuth = ta
 
 		capedb := encrypt.New(capedb, codec)
 		c.db = capedb
@@ -182,9 +249,14 @@ func (c *Coordinator) doSetup(ctx context.Context, capedb db.Interface) error {
 		return fmt.Errorf("user must be specified when starting coordinator for first time")
 	}
 
+	err = c.cfg.Passwords.Policy.Check(models.Password(c.cfg.User.Password))
+	if err != nil {
+		return err
+	}
+
 	// We must create the config and load up the state before we can make
 	// requests against the backend that requires the encryptionKey.
-	config, encryptionKey, kp, err := createDatabaseConfig(c.cfg.RootKey)
+	config, encryptionKey, err := createDatabaseConfig(c.cfg.RootKey)
 	if err != nil {
 		c.logger.Error().Err(err).Msg("Could not generate config")
 		return err
@@ -197,12 +269,12 @@ func (c *Coordinator) doSetup(ctx context.Context, capedb db.Interface) error {
 		return err
 	}
 
-	codec = crypto.NewSecretBoxCodec(kms)
-	ta, err := auth.NewTokenAuthority(kp, c.cfg.InstanceID.String())
+	codec, err = crypto.NewKeyring(config.EncryptionKeyID, map[string]crypto.EncryptionCodec{
+		config.EncryptionKeyID: crypto.NewSecretBoxCodec(kms),
+	})
 	if err != nil {
 		return err
 	}
-	c.tokenAuth = ta
 
 	enc := encrypt.New(capedb, codec)
 	c.db = enc
@@ -225,6 +297,11 @@ func (c *Coordinator) doSetup(ctx context.Context, capedb db.Interface) error {
 		return err
 	}
 
+	err = c.setupTokenAuthority(capedb)
+	if err != nil {
+		return err
+	}
+
 	err = enc.Roles().CreateSystemRoles(ctx)
 	if err != nil {
 		return err
@@ -238,101 +315,85 @@ func (c *Coordinator) doSetup(ctx context.Context, capedb db.Interface) error {
 	return nil
 }
 
-func getDatabaseConfig(ctx context.Context, db db.Interface, rootKey string) (*models.Config, crypto.EncryptionCodec, *auth.Keypair, error) {
-	cfg, err := db.Config().Get(ctx)
+// setupTokenAuthority creates the token authority, it reloads the signing
+// keys from the database config so rotated keys are picked up
+func (c *Coordinator) setupTokenAuthority(capedb db.Interface) error {
+	source, err := signingKeySource(capedb, c.cfg.RootKey)
 	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	u, err := crypto.NewKeyURL(rootKey)
-	if err != nil {
-		return nil, nil, nil, err
+		return err
 	}
 
-	tmpKms, err := crypto.LoadKMS(u)
+	ta, err := auth.NewRotatingTokenAuthority(source, c.cfg.InstanceID.String())
 	if err != nil {
-		return nil, nil, nil, err
+		return err
 	}
 
-	unencrypted, err := tmpKms.Decrypt(context.TODO(), *cfg.AuthKeypair)
-	if err != nil {
-		return nil, nil, nil, err
-	}
+	c.tokenAuth = ta
+	return nil
+}
 
-	kp := &auth.Keypair{}
-	err = json.Unmarshal(unencrypted, kp)
+func getDatabaseConfig(ctx context.Context, db db.Interface, rootKey string) (*models.Config, crypto.EncryptionCodec, error) {
+	cfg, err := db.Config().Get(ctx)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	// if setup has been run we create and add the codec here
-	encryptionKey, err := tmpKms.Decrypt(context.TODO(), *cfg.EncryptionKey)
+	u, err := crypto.NewKeyURL(rootKey)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	keyURL, err := crypto.NewKeyURL(string(encryptionKey))
+	rootKMS, err := crypto.LoadKMS(u)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	kms, err := crypto.LoadKMS(keyURL)
+	// if setup has been run we create and add the codec here
+	codec, err := dataKeyring(ctx, rootKMS, cfg)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	codec := crypto.NewSecretBoxCodec(kms)
-
-	return cfg, codec, kp, err
+	return cfg, codec, nil
 }
 
-func createDatabaseConfig(rootKey string) (*models.Config, *crypto.KeyURL, *auth.Keypair, error) {
+func createDatabaseConfig(rootKey string) (*models.Config, *crypto.KeyURL, error) {
 	u, err := crypto.NewKeyURL(rootKey)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	encryptionKey := u
-	if u.Type() == crypto.Base64Key {
-		key, err := crypto.NewBase64KeyURL(nil)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		encryptionKey = key
+	encryptionKey, err := newDataKey()
+	if err != nil {
+		return nil, nil, err
 	}
 
 	kms, err := crypto.LoadKMS(u)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	encryptedKey, err := kms.Encrypt(context.TODO(), []byte(encryptionKey.String()))
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	keypair, err := auth.NewKeypair()
 	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	by, err := json.Marshal(keypair)
-	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	encryptedAuth, err := kms.Encrypt(context.TODO(), by)
+	encryptedAuth, err := encryptKeypair(context.TODO(), kms, keypair)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	config, err := models.NewConfig(base64.New(encryptedKey), base64.New(encryptedAuth))
+	config, err := models.NewConfig(base64.New(encryptedKey), encryptedAuth)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	return config, encryptionKey, keypair, nil
+	return config, encryptionKey, nil
 }
 
 func mustPgxPool(url, name string) *pgxpool.Pool {
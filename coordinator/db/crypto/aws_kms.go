@@ -0,0 +1,74 @@
+package crypto
+
+import (
+	"context"
+	"strings"
+
+	"gocloud.dev/secrets"
+
+	// for aws kms
+	_ "gocloud.dev/secrets/awskms"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// The "awskms" URL identifies a key by its id or alias, for example
+// awskms://1234abcd-12ab-34cd-56ef-1234567890ab?region=us-east-1 or
+// awskms://alias/cape?region=us-east-1. An ARN has no host,
+// awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd-....
+//
+// Credentials are found the same way as the AWS CLI, from the environment,
+// the shared credentials file or the instance's role. The region defaults
+// to AWS_REGION if it's not given, an endpoint query parameter can point
+// at a KMS compatible service such as LocalStack.
+
+type AWSKMS struct {
+	url    *KeyURL
+	keeper *secrets.Keeper
+}
+
+func NewAWSKMS(url *KeyURL) (*AWSKMS, error) {
+	if strings.Trim(url.Host+url.Path, "/") == "" {
+		return nil, errors.New(InvalidKeyURLCause, "AWS KMS key url must include a key id, alias or ARN")
+	}
+
+	return &AWSKMS{
+		url: url,
+	}, nil
+}
+
+func (a *AWSKMS) Open(ctx context.Context) error {
+	keeper, err := secrets.OpenKeeper(ctx, a.url.String())
+	if err != nil {
+		return err
+	}
+	a.keeper = keeper
+
+	return nil
+}
+
+func (a *AWSKMS) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	ciphertext, err := a.keeper.Encrypt(ctx, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return padCiphertext(ciphertext, cloudKeyLength), nil
+}
+
+func (a *AWSKMS) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	ciphertext, err := unpadCiphertext(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.keeper.Decrypt(ctx, ciphertext)
+}
+
+func (a *AWSKMS) Close() error {
+	return a.keeper.Close()
+}
+
+func (a *AWSKMS) EncryptedKeyLength() int {
+	return cloudKeyLength
+}
@@ -0,0 +1,195 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+// awsKMS is a stand-in for the AWS KMS JSON API. Ciphertexts embed the key
+// id like real ones do so their length depends on the key.
+type awsKMS struct {
+	key         cipher.AEAD
+	credentials []string
+}
+
+func newAWSKMS(t *testing.T) *httptest.Server {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	gm.Expect(err).To(gm.BeNil())
+
+	block, err := aes.NewCipher(key)
+	gm.Expect(err).To(gm.BeNil())
+
+	gcm, err := cipher.NewGCM(block)
+	gm.Expect(err).To(gm.BeNil())
+
+	srv := httptest.NewServer(&awsKMS{key: gcm})
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (a *awsKMS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// requests must be signed with the credentials from the environment
+	if !strings.Contains(r.Header.Get("Authorization"), "Credential=AKIDTEST/") {
+		awsError(w, http.StatusBadRequest, "UnrecognizedClientException", "The security token included in the request is invalid.")
+		return
+	}
+
+	var req struct {
+		KeyID          string `json:"KeyId"`
+		Plaintext      []byte `json:"Plaintext"`
+		CiphertextBlob []byte `json:"CiphertextBlob"`
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		awsError(w, http.StatusBadRequest, "SerializationException", err.Error())
+		return
+	}
+
+	switch r.Header.Get("X-Amz-Target") {
+	case "TrentService.Encrypt":
+		nonce := make([]byte, a.key.NonceSize())
+		rand.Read(nonce) // nolint: errcheck
+
+		blob := append([]byte{byte(len(req.KeyID))}, req.KeyID...)
+		blob = append(blob, a.key.Seal(nonce, nonce, req.Plaintext, nil)...)
+		writeAWS(w, map[string]interface{}{"KeyId": req.KeyID, "CiphertextBlob": blob})
+	case "TrentService.Decrypt":
+		blob := req.CiphertextBlob
+		if len(blob) == 0 || len(blob) < 1+int(blob[0])+a.key.NonceSize() {
+			awsError(w, http.StatusBadRequest, "InvalidCiphertextException", "")
+			return
+		}
+
+		keyID, sealed := string(blob[1:1+blob[0]]), blob[1+blob[0]:]
+		plaintext, err := a.key.Open(nil, sealed[:a.key.NonceSize()], sealed[a.key.NonceSize():], nil)
+		if err != nil {
+			awsError(w, http.StatusBadRequest, "InvalidCiphertextException", "")
+			return
+		}
+
+		writeAWS(w, map[string]interface{}{"KeyId": keyID, "Plaintext": plaintext})
+	default:
+		awsError(w, http.StatusBadRequest, "UnknownOperationException", "")
+	}
+}
+
+func awsError(w http.ResponseWriter, status int, typ string, msg string) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"__type": typ, "message": msg}) // nolint: errcheck
+}
+
+func writeAWS(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	json.NewEncoder(w).Encode(v) // nolint: errcheck
+}
+
+func TestAWSKMS(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	for k, v := range map[string]string{
+		"AWS_ACCESS_KEY_ID":           "AKIDTEST",
+		"AWS_SECRET_ACCESS_KEY":       "secret",
+		"AWS_EC2_METADATA_DISABLED":   "true",
+		"AWS_SDK_LOAD_CONFIG":         "false",
+		"AWS_SHARED_CREDENTIALS_FILE": os.DevNull,
+	} {
+		prev, ok := os.LookupEnv(k)
+		os.Setenv(k, v)
+
+		if ok {
+			defer os.Setenv(k, prev)
+		} else {
+			defer os.Unsetenv(k)
+		}
+	}
+
+	srv := newAWSKMS(t)
+	query := "?region=us-east-1&endpoint=" + url.QueryEscape(srv.URL)
+
+	dek := make([]byte, KeyLength)
+	_, err := rand.Read(dek)
+	gm.Expect(err).To(gm.BeNil())
+
+	for _, keyID := range []string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"alias/cape",
+		"/arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+	} {
+		t.Run("wraps keys with "+keyID, func(t *testing.T) {
+			u, err := NewKeyURL("awskms://" + keyID + query)
+			gm.Expect(err).To(gm.BeNil())
+
+			kms, err := LoadKMS(u)
+			gm.Expect(err).To(gm.BeNil())
+			defer kms.Close()
+
+			wrapped, err := kms.Encrypt(ctx, dek)
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(len(wrapped)).To(gm.Equal(kms.EncryptedKeyLength()))
+
+			unwrapped, err := kms.Decrypt(ctx, wrapped)
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(unwrapped).To(gm.Equal(dek))
+		})
+	}
+
+	t.Run("secret box codec", func(t *testing.T) {
+		u, err := NewKeyURL("awskms://alias/cape" + query)
+		gm.Expect(err).To(gm.BeNil())
+
+		kms, err := LoadKMS(u)
+		gm.Expect(err).To(gm.BeNil())
+		defer kms.Close()
+
+		codec := NewSecretBoxCodec(kms)
+
+		data := base64.New([]byte("my secret data"))
+		encrypted, err := codec.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+
+		decrypted, err := codec.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted.String()).To(gm.Equal(data.String()))
+	})
+
+	t.Run("wraps values longer than a key", func(t *testing.T) {
+		u, err := NewKeyURL("awskms://alias/cape" + query)
+		gm.Expect(err).To(gm.BeNil())
+
+		kms, err := LoadKMS(u)
+		gm.Expect(err).To(gm.BeNil())
+		defer kms.Close()
+
+		keypair := make([]byte, 512)
+		wrapped, err := kms.Encrypt(ctx, keypair)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(wrapped)).To(gm.BeNumerically(">", kms.EncryptedKeyLength()))
+
+		unwrapped, err := kms.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(keypair))
+	})
+
+	t.Run("requires a key", func(t *testing.T) {
+		_, err := NewKeyURL("awskms://?region=us-east-1")
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
@@ -0,0 +1,58 @@
+package crypto
+
+import (
+	"context"
+	"os"
+
+	"golang.org/x/crypto/nacl/secretbox"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// An "env" key url names the environment variable holding the base64
+// encoded root key:
+//
+//   env://CAPE_ROOT_KEY
+
+// EnvKMS is a local KMS whose key is read from an environment variable
+type EnvKMS struct {
+	url  *KeyURL
+	name string
+	key  [KeyLength]byte
+}
+
+func NewEnvKMS(url *KeyURL) (*EnvKMS, error) {
+	return &EnvKMS{url: url, name: url.Host}, nil
+}
+
+// Open reads the key from the environment
+func (e *EnvKMS) Open(ctx context.Context) error {
+	value, ok := os.LookupEnv(e.name)
+	if !ok || value == "" {
+		return errors.New(InvalidKeyURLCause, "Environment variable %s holding the key is not set", e.name)
+	}
+
+	key, err := parseKey([]byte(value))
+	if err != nil {
+		return errors.New(InvalidKeyURLCause, "Environment variable %s does not hold a valid key: %s", e.name, err)
+	}
+
+	e.key = key
+	return nil
+}
+
+func (e *EnvKMS) Encrypt(ctx context.Context, dek []byte) ([]byte, error) {
+	return Encrypt(e.key, dek)
+}
+
+func (e *EnvKMS) Decrypt(ctx context.Context, wrappedDEK []byte) ([]byte, error) {
+	return Decrypt(e.key, wrappedDEK)
+}
+
+func (e *EnvKMS) Close() error {
+	return nil
+}
+
+func (e *EnvKMS) EncryptedKeyLength() int {
+	return NonceLength + KeyLength + secretbox.Overhead
+}
@@ -9,4 +9,12 @@ var (
 	SecretBoxDecryptCause = errors.NewCause(errors.BadRequestCategory, "secret_box_decrypt")
 
 	InvalidKeyURLCause = errors.NewCause(errors.BadRequestCategory, "invalid_key_url")
+
+	UnknownKeyVersionCause = errors.NewCause(errors.BadRequestCategory, "unknown_key_version")
+
+	VaultRequestCause = errors.NewCause(errors.BadRequestCategory, "vault_request")
+
+	// KeyFileCause occurs when a key file can't be read, holds an invalid
+	// key or can be accessed by users other than its owner
+	KeyFileCause = errors.NewCause(errors.BadRequestCategory, "key_file")
 )
@@ -0,0 +1,209 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	stdbase64 "encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+	"golang.org/x/crypto/nacl/secretbox"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// A "file" key url names a file holding the root key, such as a Kubernetes
+// secret mounted into the coordinator's container:
+//
+//   file:///etc/cape/root.key
+//
+// The file holds the key either base64 encoded or as raw bytes, cape
+// coordinator generate-key creates one. It must not be accessible by
+// anyone other than its owner.
+
+// keyFileMode is the mode key files are created with
+const keyFileMode = 0600
+
+// FileKMS is a local KMS whose key is read from a file. The file is read
+// again whenever it changes so a replaced key is picked up without a
+// restart. Keys read earlier are kept to unwrap keys wrapped before the
+// file was replaced.
+type FileKMS struct {
+	url  *KeyURL
+	path string
+
+	mu      sync.Mutex
+	keys    [][KeyLength]byte
+	modTime time.Time
+	size    int64
+}
+
+func NewFileKMS(url *KeyURL) (*FileKMS, error) {
+	if !filepath.IsAbs(url.Path) {
+		return nil, errors.New(InvalidKeyURLCause, "Key file %s must be an absolute path", url.Path)
+	}
+
+	return &FileKMS{url: url, path: url.Path}, nil
+}
+
+// Open reads the key file
+func (f *FileKMS) Open(ctx context.Context) error {
+	return f.Reload(ctx)
+}
+
+// Reload reads the key file again if it has changed since it was last read
+func (f *FileKMS) Reload(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return errors.New(KeyFileCause, "Unable to read key file: %s", err)
+	}
+
+	err = checkKeyFileMode(f.path, info)
+	if err != nil {
+		return err
+	}
+
+	if len(f.keys) > 0 && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return errors.New(KeyFileCause, "Unable to read key file: %s", err)
+	}
+
+	key, err := parseKey(b)
+	if err != nil {
+		return errors.New(KeyFileCause, "Key file %s does not hold a valid key: %s", f.path, err)
+	}
+
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+
+	if len(f.keys) > 0 && f.keys[0] == key {
+		return nil
+	}
+
+	f.keys = append([][KeyLength]byte{key}, f.keys...)
+	return nil
+}
+
+// Encrypt wraps the dek with the key currently in the file
+func (f *FileKMS) Encrypt(ctx context.Context, dek []byte) ([]byte, error) {
+	err := f.Reload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	key := f.keys[0]
+	f.mu.Unlock()
+
+	return Encrypt(key, dek)
+}
+
+// Decrypt unwraps the dek with the key currently in the file or, failing
+// that, any key the file held previously
+func (f *FileKMS) Decrypt(ctx context.Context, wrappedDEK []byte) ([]byte, error) {
+	err := f.Reload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	keys := f.keys
+	f.mu.Unlock()
+
+	for _, key := range keys {
+		dek, err := Decrypt(key, wrappedDEK)
+		if err == nil {
+			return dek, nil
+		}
+	}
+
+	return nil, errors.New(KMSDecryptCause, "Unable to decrypt data")
+}
+
+func (f *FileKMS) Close() error {
+	return nil
+}
+
+func (f *FileKMS) EncryptedKeyLength() int {
+	return NonceLength + KeyLength + secretbox.Overhead
+}
+
+// NewFileKeyURL returns the key url of the key file at path
+func NewFileKeyURL(path string) (*KeyURL, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyURL("file://" + filepath.ToSlash(abs))
+}
+
+// WriteKeyFile generates a new key and writes it to a file that only its
+// owner can access. An existing file is never overwritten.
+func WriteKeyFile(path string) error {
+	key, err := GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, keyFileMode)
+	if err != nil {
+		return errors.New(KeyFileCause, "Unable to create key file: %s", err)
+	}
+
+	_, err = f.WriteString(base64.New(key[:]).String() + "\n")
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// checkKeyFileMode returns an error if anyone other than the key file's
+// owner can access it
+func checkKeyFileMode(path string, info os.FileInfo) error {
+	if perm := info.Mode().Perm(); perm&0077 != 0 {
+		return errors.New(KeyFileCause, "Key file %s can be accessed by other users, its mode must be 0600 or 0400 rather than %04o", path, perm)
+	}
+
+	return nil
+}
+
+// parseKey reads a key held outside of a key url, it may be raw bytes or
+// base64 encoded using either the url or standard alphabet
+func parseKey(b []byte) ([KeyLength]byte, error) {
+	var key [KeyLength]byte
+	if len(b) == KeyLength {
+		copy(key[:], b)
+		return key, nil
+	}
+
+	encoded := string(bytes.TrimSpace(b))
+
+	decoded, err := stdbase64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		decoded, err = stdbase64.StdEncoding.DecodeString(encoded)
+	}
+	if err != nil {
+		return key, errors.New(InvalidKeyURLCause, "Key must be base64 encoded")
+	}
+
+	if len(decoded) != KeyLength {
+		return key, errors.New(InvalidKeyURLCause, "Key must be %d bytes long", KeyLength)
+	}
+
+	copy(key[:], decoded)
+	return key, nil
+}
@@ -0,0 +1,191 @@
+package crypto
+
+import (
+	"context"
+	stdbase64 "encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+func TestFileKMS(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "cape-keys")
+	gm.Expect(err).To(gm.BeNil())
+	defer os.RemoveAll(dir)
+
+	load := func(path string) (KMS, error) {
+		u, err := NewFileKeyURL(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		return LoadKMS(u)
+	}
+
+	t.Run("Generated keys can be loaded", func(t *testing.T) {
+		path := filepath.Join(dir, "generated.key")
+		err := WriteKeyFile(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		info, err := os.Stat(path)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(info.Mode().Perm()).To(gm.Equal(os.FileMode(0600)))
+
+		kms, err := load(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		dek := []byte("my-data-encryption-key")
+		wrapped, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(wrapped)).To(gm.Equal(kms.EncryptedKeyLength() - KeyLength + len(dek)))
+
+		unwrapped, err := kms.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(dek))
+	})
+
+	t.Run("Existing files aren't overwritten", func(t *testing.T) {
+		path := filepath.Join(dir, "existing.key")
+		err := ioutil.WriteFile(path, []byte("keep me"), 0600)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = WriteKeyFile(path)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		b, err := ioutil.ReadFile(path)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(string(b)).To(gm.Equal("keep me"))
+	})
+
+	t.Run("Files other users can access are rejected", func(t *testing.T) {
+		path := filepath.Join(dir, "readable.key")
+		err := WriteKeyFile(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = os.Chmod(path, 0644)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = load(path)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("other users"))
+	})
+
+	t.Run("Raw and standard base64 keys are accepted", func(t *testing.T) {
+		key, err := GenerateKey()
+		gm.Expect(err).To(gm.BeNil())
+
+		raw := filepath.Join(dir, "raw.key")
+		err = ioutil.WriteFile(raw, key[:], 0400)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = load(raw)
+		gm.Expect(err).To(gm.BeNil())
+
+		std := filepath.Join(dir, "std.key")
+		err = ioutil.WriteFile(std, []byte(stdbase64.StdEncoding.EncodeToString(key[:])+"\n"), 0400)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = load(std)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("Invalid keys are rejected", func(t *testing.T) {
+		path := filepath.Join(dir, "short.key")
+		err := ioutil.WriteFile(path, []byte("c2hvcnQ="), 0600)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = load(path)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Replaced keys are reloaded", func(t *testing.T) {
+		path := filepath.Join(dir, "replaced.key")
+		err := WriteKeyFile(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		kms, err := load(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		old, err := kms.Encrypt(ctx, []byte("old"))
+		gm.Expect(err).To(gm.BeNil())
+
+		err = os.Remove(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = WriteKeyFile(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		// make sure the change is seen on file systems with coarse mtimes
+		later := time.Now().Add(time.Minute)
+		err = os.Chtimes(path, later, later)
+		gm.Expect(err).To(gm.BeNil())
+
+		wrapped, err := kms.Encrypt(ctx, []byte("new"))
+		gm.Expect(err).To(gm.BeNil())
+
+		// the new key wraps new values, values wrapped with the old key can
+		// still be unwrapped
+		fresh, err := load(path)
+		gm.Expect(err).To(gm.BeNil())
+
+		unwrapped, err := fresh.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal([]byte("new")))
+
+		_, err = fresh.Decrypt(ctx, old)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		unwrapped, err = kms.Decrypt(ctx, old)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal([]byte("old")))
+	})
+}
+
+func TestEnvKMS(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	u, err := NewKeyURL("env://CAPE_TEST_ROOT_KEY")
+	gm.Expect(err).To(gm.BeNil())
+
+	defer os.Unsetenv("CAPE_TEST_ROOT_KEY")
+
+	t.Run("Missing variables are rejected", func(t *testing.T) {
+		os.Unsetenv("CAPE_TEST_ROOT_KEY")
+
+		_, err := LoadKMS(u)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Keys are read from the environment", func(t *testing.T) {
+		key, err := GenerateKey()
+		gm.Expect(err).To(gm.BeNil())
+
+		os.Setenv("CAPE_TEST_ROOT_KEY", base64.New(key[:]).String())
+
+		kms, err := LoadKMS(u)
+		gm.Expect(err).To(gm.BeNil())
+
+		wrapped, err := kms.Encrypt(ctx, []byte("dek"))
+		gm.Expect(err).To(gm.BeNil())
+
+		// the key is the same as the base64 key holding it
+		local, err := NewBase64KeyURL(key[:])
+		gm.Expect(err).To(gm.BeNil())
+
+		localKMS, err := LoadKMS(local)
+		gm.Expect(err).To(gm.BeNil())
+
+		unwrapped, err := localKMS.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal([]byte("dek")))
+	})
+}
@@ -0,0 +1,119 @@
+package crypto
+
+import (
+	"context"
+	"strings"
+
+	cloudkms "cloud.google.com/go/kms/apiv1"
+	"gocloud.dev/gcp"
+	"gocloud.dev/secrets"
+	"gocloud.dev/secrets/gcpkms"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// The "gcpkms" URL is the resource id of a key, which includes the project,
+// location and key ring it belongs to:
+//
+//   gcpkms://projects/my-project/locations/us-east1/keyRings/cape/cryptoKeys/root
+//
+// Application default credentials are used, such as the service account of
+// the instance or GOOGLE_APPLICATION_CREDENTIALS. An endpoint query
+// parameter connects to an emulator without credentials instead.
+
+type GCPKMS struct {
+	url        *KeyURL
+	resourceID string
+	endpoint   string
+	client     *cloudkms.KeyManagementClient
+	keeper     *secrets.Keeper
+}
+
+func NewGCPKMS(url *KeyURL) (*GCPKMS, error) {
+	resourceID := strings.Trim(url.Host+url.Path, "/")
+
+	parts := strings.Split(resourceID, "/")
+	if len(parts) != 8 || parts[0] != "projects" || parts[2] != "locations" ||
+		parts[4] != "keyRings" || parts[6] != "cryptoKeys" {
+		return nil, errors.New(InvalidKeyURLCause,
+			"GCP KMS key url must be in the form gcpkms://projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>")
+	}
+
+	q := url.Query()
+	for param := range q {
+		if param != "endpoint" {
+			return nil, errors.New(InvalidKeyURLCause, "Unknown GCP KMS key url parameter %s", param)
+		}
+	}
+
+	return &GCPKMS{
+		url:        url,
+		resourceID: resourceID,
+		endpoint:   q.Get("endpoint"),
+	}, nil
+}
+
+func (g *GCPKMS) Open(ctx context.Context) error {
+	var client *cloudkms.KeyManagementClient
+	if g.endpoint != "" {
+		c, err := cloudkms.NewKeyManagementClient(ctx,
+			option.WithEndpoint(g.endpoint),
+			option.WithoutAuthentication(),
+			option.WithGRPCDialOption(grpc.WithInsecure()))
+		if err != nil {
+			return err
+		}
+
+		client = c
+	} else {
+		creds, err := gcp.DefaultCredentials(ctx)
+		if err != nil {
+			return err
+		}
+
+		c, _, err := gcpkms.Dial(ctx, creds.TokenSource)
+		if err != nil {
+			return err
+		}
+
+		client = c
+	}
+
+	g.client = client
+	g.keeper = gcpkms.OpenKeeper(client, g.resourceID, nil)
+
+	return nil
+}
+
+func (g *GCPKMS) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	ciphertext, err := g.keeper.Encrypt(ctx, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return padCiphertext(ciphertext, cloudKeyLength), nil
+}
+
+func (g *GCPKMS) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	ciphertext, err := unpadCiphertext(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.keeper.Decrypt(ctx, ciphertext)
+}
+
+func (g *GCPKMS) Close() error {
+	err := g.keeper.Close()
+	if err != nil {
+		return err
+	}
+
+	return g.client.Close()
+}
+
+func (g *GCPKMS) EncryptedKeyLength() int {
+	return cloudKeyLength
+}
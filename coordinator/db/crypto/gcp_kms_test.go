@@ -0,0 +1,148 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+	kmspb "google.golang.org/genproto/googleapis/cloud/kms/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testGCPKey = "projects/cape/locations/us-east1/keyRings/cape/cryptoKeys/root"
+
+// gcpKMS is a stand-in for the Cloud KMS gRPC API with a single key
+type gcpKMS struct {
+	kmspb.UnimplementedKeyManagementServiceServer
+	key cipher.AEAD
+}
+
+func newGCPKMS(t *testing.T) string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	gm.Expect(err).To(gm.BeNil())
+
+	block, err := aes.NewCipher(key)
+	gm.Expect(err).To(gm.BeNil())
+
+	gcm, err := cipher.NewGCM(block)
+	gm.Expect(err).To(gm.BeNil())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	gm.Expect(err).To(gm.BeNil())
+
+	srv := grpc.NewServer()
+	kmspb.RegisterKeyManagementServiceServer(srv, &gcpKMS{key: gcm})
+	go srv.Serve(lis) // nolint: errcheck
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+func (g *gcpKMS) Encrypt(ctx context.Context, req *kmspb.EncryptRequest) (*kmspb.EncryptResponse, error) {
+	if req.Name != testGCPKey {
+		return nil, status.Errorf(codes.NotFound, "%s not found", req.Name)
+	}
+
+	nonce := make([]byte, g.key.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kmspb.EncryptResponse{
+		Name:       req.Name,
+		Ciphertext: g.key.Seal(nonce, nonce, req.Plaintext, nil),
+	}, nil
+}
+
+func (g *gcpKMS) Decrypt(ctx context.Context, req *kmspb.DecryptRequest) (*kmspb.DecryptResponse, error) {
+	if req.Name != testGCPKey {
+		return nil, status.Errorf(codes.NotFound, "%s not found", req.Name)
+	}
+
+	n := g.key.NonceSize()
+	if len(req.Ciphertext) < n {
+		return nil, status.Error(codes.InvalidArgument, "invalid ciphertext")
+	}
+
+	plaintext, err := g.key.Open(nil, req.Ciphertext[:n], req.Ciphertext[n:], nil)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ciphertext")
+	}
+
+	return &kmspb.DecryptResponse{Plaintext: plaintext}, nil
+}
+
+func TestGCPKMS(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	endpoint := newGCPKMS(t)
+
+	dek := make([]byte, KeyLength)
+	_, err := rand.Read(dek)
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("wraps keys", func(t *testing.T) {
+		u, err := NewKeyURL("gcpkms://" + testGCPKey + "?endpoint=" + endpoint)
+		gm.Expect(err).To(gm.BeNil())
+
+		kms, err := LoadKMS(u)
+		gm.Expect(err).To(gm.BeNil())
+		defer kms.Close()
+
+		wrapped, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(wrapped)).To(gm.Equal(kms.EncryptedKeyLength()))
+
+		unwrapped, err := kms.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(dek))
+
+		codec := NewSecretBoxCodec(kms)
+
+		data := base64.New([]byte("my secret data"))
+		encrypted, err := codec.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+
+		decrypted, err := codec.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted.String()).To(gm.Equal(data.String()))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		u, err := NewKeyURL("gcpkms://projects/cape/locations/us-east1/keyRings/cape/cryptoKeys/nope?endpoint=" + endpoint)
+		gm.Expect(err).To(gm.BeNil())
+
+		kms, err := LoadKMS(u)
+		gm.Expect(err).To(gm.BeNil())
+		defer kms.Close()
+
+		_, err = kms.Encrypt(ctx, dek)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("requires a key resource id", func(t *testing.T) {
+		u, err := NewKeyURL("gcpkms://projects/cape/locations/us-east1/keyRings/cape")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = NewGCPKMS(u)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("unknown parameter", func(t *testing.T) {
+		u, err := NewKeyURL("gcpkms://" + testGCPKey + "?region=us-east1")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = NewGCPKMS(u)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
@@ -16,6 +16,11 @@ type KeyURLType string
 const (
 	Base64Key KeyURLType = "base64key"
 	AzureKey  KeyURLType = "azurekeyvault"
+	VaultKey  KeyURLType = "vault"
+	AWSKey    KeyURLType = "awskms"
+	GCPKey    KeyURLType = "gcpkms"
+	FileKey   KeyURLType = "file"
+	EnvKey    KeyURLType = "env"
 )
 
 func (k KeyURLType) Validate() error {
@@ -24,6 +29,16 @@ func (k KeyURLType) Validate() error {
 		return nil
 	case AzureKey:
 		return nil
+	case VaultKey:
+		return nil
+	case AWSKey:
+		return nil
+	case GCPKey:
+		return nil
+	case FileKey:
+		return nil
+	case EnvKey:
+		return nil
 	default:
 		return errors.New(InvalidKeyURLCause, "Invalid scheme got %s", k)
 	}
@@ -60,11 +75,21 @@ func (d *KeyURL) Validate() error {
 		return errors.New(InvalidKeyURLCause, "Missing db url")
 	}
 
-	if d.URL.Host == "" {
-		return errors.New(InvalidKeyURLCause, "A host must be provided")
+	typ := KeyURLType(d.Scheme)
+
+	// Key files are local so they're identified by their path alone
+	if typ == FileKey {
+		if d.URL.Host != "" || d.URL.Path == "" {
+			return errors.New(InvalidKeyURLCause, "A key file must be given as an absolute path, e.g. file:///etc/cape/root.key")
+		}
+
+		return nil
 	}
 
-	typ := KeyURLType(d.Scheme)
+	// AWS key ARNs can't be parsed as a host so they're given as the path
+	if d.URL.Host == "" && (typ != AWSKey || d.URL.Path == "") {
+		return errors.New(InvalidKeyURLCause, "A host must be provided")
+	}
 
 	return typ.Validate()
 }
@@ -47,6 +47,46 @@ func TestKeyURL(t *testing.T) {
 		g.Expect(key).ToNot(gm.BeNil())
 	})
 
+	t.Run("new vault key url", func(t *testing.T) {
+		key, err := NewKeyURL("vault://vault.example.com:8200/transit/mykey")
+		g.Expect(err).To(gm.BeNil())
+		g.Expect(key.Type()).To(gm.Equal(VaultKey))
+	})
+
+	t.Run("new aws key url", func(t *testing.T) {
+		key, err := NewKeyURL("awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd?region=us-east-1")
+		g.Expect(err).To(gm.BeNil())
+		g.Expect(key.Type()).To(gm.Equal(AWSKey))
+	})
+
+	t.Run("new gcp key url", func(t *testing.T) {
+		key, err := NewKeyURL("gcpkms://projects/cape/locations/us-east1/keyRings/cape/cryptoKeys/root")
+		g.Expect(err).To(gm.BeNil())
+		g.Expect(key.Type()).To(gm.Equal(GCPKey))
+	})
+
+	t.Run("new file key url", func(t *testing.T) {
+		key, err := NewKeyURL("file:///etc/cape/root.key")
+		g.Expect(err).To(gm.BeNil())
+		g.Expect(key.Type()).To(gm.Equal(FileKey))
+		g.Expect(key.Path).To(gm.Equal("/etc/cape/root.key"))
+	})
+
+	t.Run("file key urls need an absolute path", func(t *testing.T) {
+		_, err := NewKeyURL("file://root.key")
+		g.Expect(err).ToNot(gm.BeNil())
+
+		_, err = NewKeyURL("file://")
+		g.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("new env key url", func(t *testing.T) {
+		key, err := NewKeyURL("env://CAPE_ROOT_KEY")
+		g.Expect(err).To(gm.BeNil())
+		g.Expect(key.Type()).To(gm.Equal(EnvKey))
+		g.Expect(key.Host).To(gm.Equal("CAPE_ROOT_KEY"))
+	})
+
 	t.Run("invalid scheme", func(t *testing.T) {
 		_, err := NewKeyURL("http://haha.com")
 		g.Expect(err).ToNot(gm.BeNil())
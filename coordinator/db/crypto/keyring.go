@@ -0,0 +1,115 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/manifoldco/go-base64"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// versionMagic prefixes ciphertexts that record the id of the data key they
+// were encrypted with. Ciphertexts from before keys were versioned start
+// with a random nonce or wrapped key so they can't be mistaken for one.
+var versionMagic = []byte("cape:kv1")
+
+// Keyring is an EncryptionCodec that encrypts with the current data key and
+// decrypts with whichever key a value was encrypted with. It allows the data
+// key to be rotated while rows encrypted with the previous keys are
+// re-encrypted.
+//
+// Each ciphertext is prefixed with the id of its key. Values encrypted
+// before keys were versioned have no id and are decrypted with the key
+// whose id is empty.
+type Keyring struct {
+	currentID string
+	codecs    map[string]EncryptionCodec
+}
+
+// NewKeyring returns a Keyring that encrypts with the codec identified by
+// currentID. Codecs maps the id of every data key still in use to its codec.
+func NewKeyring(currentID string, codecs map[string]EncryptionCodec) (*Keyring, error) {
+	if _, ok := codecs[currentID]; !ok {
+		return nil, errors.New(UnknownKeyVersionCause, "No codec for the current data key %s", currentID)
+	}
+
+	if len(currentID) > 255 {
+		return nil, errors.New(UnknownKeyVersionCause, "Data key id %s is too long", currentID)
+	}
+
+	return &Keyring{currentID: currentID, codecs: codecs}, nil
+}
+
+// CurrentID returns the id of the key new values are encrypted with
+func (k *Keyring) CurrentID() string {
+	return k.currentID
+}
+
+func (k *Keyring) Encrypt(ctx context.Context, data *base64.Value) (*base64.Value, error) {
+	encrypted, err := k.codecs[k.currentID].Encrypt(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	versioned := make([]byte, 0, len(versionMagic)+1+len(k.currentID)+len(*encrypted))
+	versioned = append(versioned, versionMagic...)
+	versioned = append(versioned, byte(len(k.currentID)))
+	versioned = append(versioned, k.currentID...)
+	versioned = append(versioned, *encrypted...)
+
+	return base64.New(versioned), nil
+}
+
+func (k *Keyring) Decrypt(ctx context.Context, data *base64.Value) (*base64.Value, error) {
+	id, encrypted := splitKeyVersion(*data)
+
+	codec, ok := k.codecs[id]
+	if !ok {
+		return nil, errors.New(UnknownKeyVersionCause, "Value was encrypted with unknown data key %s", id)
+	}
+
+	return codec.Decrypt(ctx, base64.New(encrypted))
+}
+
+// Reencrypt decrypts the value and encrypts it again with the current key.
+// Values already encrypted with the current key are returned unchanged
+// along with false.
+func (k *Keyring) Reencrypt(ctx context.Context, data *base64.Value) (*base64.Value, bool, error) {
+	if bytes.HasPrefix(*data, versionMagic) && KeyVersion(data) == k.currentID {
+		return data, false, nil
+	}
+
+	decrypted, err := k.Decrypt(ctx, data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	encrypted, err := k.Encrypt(ctx, decrypted)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return encrypted, true, nil
+}
+
+// KeyVersion returns the id of the data key the value was encrypted with,
+// it's empty for values encrypted before keys were versioned
+func KeyVersion(data *base64.Value) string {
+	id, _ := splitKeyVersion(*data)
+	return id
+}
+
+func splitKeyVersion(data []byte) (string, []byte) {
+	if !bytes.HasPrefix(data, versionMagic) || len(data) <= len(versionMagic) {
+		return "", data
+	}
+
+	rest := data[len(versionMagic):]
+	n := int(rest[0])
+	if len(rest) < n+1 {
+		return "", data
+	}
+
+	return string(rest[1 : n+1]), rest[n+1:]
+}
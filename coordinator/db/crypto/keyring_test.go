@@ -0,0 +1,98 @@
+package crypto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+func testCodec(t *testing.T) EncryptionCodec {
+	u, err := NewBase64KeyURL(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kms, err := LoadKMS(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSecretBoxCodec(kms)
+}
+
+func TestKeyring(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	legacy := testCodec(t)
+	previous := testCodec(t)
+	current := testCodec(t)
+
+	keyring, err := NewKeyring("current", map[string]EncryptionCodec{
+		"":         legacy,
+		"previous": previous,
+		"current":  current,
+	})
+	gm.Expect(err).To(gm.BeNil())
+
+	data := base64.New([]byte("super secret data"))
+
+	t.Run("Encrypts with the current key", func(t *testing.T) {
+		encrypted, err := keyring.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(KeyVersion(encrypted)).To(gm.Equal("current"))
+
+		decrypted, err := keyring.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted.String()).To(gm.Equal(data.String()))
+	})
+
+	t.Run("Decrypts values from before keys were versioned", func(t *testing.T) {
+		encrypted, err := legacy.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(KeyVersion(encrypted)).To(gm.Equal(""))
+
+		decrypted, err := keyring.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted.String()).To(gm.Equal(data.String()))
+	})
+
+	t.Run("Re-encrypts values with the current key", func(t *testing.T) {
+		old, err := NewKeyring("previous", map[string]EncryptionCodec{"previous": previous})
+		gm.Expect(err).To(gm.BeNil())
+
+		encrypted, err := old.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+
+		reencrypted, changed, err := keyring.Reencrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeTrue())
+		gm.Expect(KeyVersion(reencrypted)).To(gm.Equal("current"))
+
+		_, changed, err = keyring.Reencrypt(ctx, reencrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeFalse())
+
+		decrypted, err := keyring.Decrypt(ctx, reencrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted.String()).To(gm.Equal(data.String()))
+	})
+
+	t.Run("Rejects values encrypted with an unknown key", func(t *testing.T) {
+		other, err := NewKeyring("other", map[string]EncryptionCodec{"other": testCodec(t)})
+		gm.Expect(err).To(gm.BeNil())
+
+		encrypted, err := other.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = keyring.Decrypt(ctx, encrypted)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Requires a codec for the current key", func(t *testing.T) {
+		_, err := NewKeyring("missing", map[string]EncryptionCodec{"": legacy})
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
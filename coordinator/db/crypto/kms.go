@@ -21,6 +21,12 @@ type KMS interface {
 	Close() error
 }
 
+// Rewrapper is implemented by a KMS that versions its keys, it re-encrypts
+// a ciphertext with the latest version of the key without revealing it
+type Rewrapper interface {
+	Rewrap(context.Context, []byte) ([]byte, error)
+}
+
 func NewLocalKMS(url *KeyURL) (*LocalKMS, error) {
 	k, err := base64.NewFromString(url.Host)
 	if err != nil {
@@ -86,6 +92,36 @@ func LoadKMS(url *KeyURL) (KMS, error) {
 			return nil, err
 		}
 		k = kms
+	case VaultKey:
+		kms, err := NewVaultKMS(url)
+		if err != nil {
+			return nil, err
+		}
+		k = kms
+	case AWSKey:
+		kms, err := NewAWSKMS(url)
+		if err != nil {
+			return nil, err
+		}
+		k = kms
+	case GCPKey:
+		kms, err := NewGCPKMS(url)
+		if err != nil {
+			return nil, err
+		}
+		k = kms
+	case FileKey:
+		kms, err := NewFileKMS(url)
+		if err != nil {
+			return nil, err
+		}
+		k = kms
+	case EnvKey:
+		kms, err := NewEnvKMS(url)
+		if err != nil {
+			return nil, err
+		}
+		k = kms
 	default:
 		return nil, errors.New(InvalidKeyURLCause, "Could not find url type %s for loading KMS", url.Type())
 	}
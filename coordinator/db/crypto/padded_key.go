@@ -0,0 +1,42 @@
+package crypto
+
+import (
+	"encoding/binary"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// cloudKeyLength is the length of a data encryption key wrapped by a cloud
+// KMS. The length of their ciphertexts isn't documented, a wrapped 32 byte
+// key is around 180 bytes with AWS and 110 bytes with GCP, so ciphertexts
+// are prefixed with their length and padded to leave room to spare.
+const cloudKeyLength = 256
+
+// padCiphertext prefixes the ciphertext with its length and pads it with
+// zeros to the given length. Ciphertexts that don't fit, such as keys
+// wrapped by a root key, are only prefixed.
+func padCiphertext(ciphertext []byte, length int) []byte {
+	size := 2 + len(ciphertext)
+	if size < length {
+		size = length
+	}
+
+	padded := make([]byte, size)
+	binary.BigEndian.PutUint16(padded, uint16(len(ciphertext)))
+	copy(padded[2:], ciphertext)
+
+	return padded
+}
+
+func unpadCiphertext(padded []byte) ([]byte, error) {
+	if len(padded) < 2 {
+		return nil, errors.New(KMSDecryptCause, "Wrapped key is too short")
+	}
+
+	n := int(binary.BigEndian.Uint16(padded))
+	if len(padded) < 2+n {
+		return nil, errors.New(KMSDecryptCause, "Wrapped key is too short")
+	}
+
+	return padded[2 : 2+n], nil
+}
@@ -0,0 +1,349 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	stdbase64 "encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// A "vault" key url identifies a key in a HashiCorp Vault transit engine,
+// the last segment of the path is the name of the key and the rest is
+// where the engine is mounted:
+//
+//   vault://vault.example.com:8200/transit/my-key
+//
+// The following query parameters are supported:
+//
+//   tls=false       connect over http rather than https
+//   namespace=ns    the Vault Enterprise namespace of the engine
+//   key_version=n   encrypt with version n rather than the latest version
+//   role_id=...     authenticate with AppRole, VAULT_ROLE_ID is used if not
+//   secret_id=...   given alongside VAULT_SECRET_ID
+//   approle=path    where the AppRole auth method is mounted, approle by default
+//   token=...       authenticate with a token, VAULT_TOKEN is used if not given
+//
+// Only AEAD key types, such as the default aes256-gcm96, are supported as the
+// size of a wrapped key must be known ahead of time.
+
+const (
+	// vaultVersionLength is the number of bytes used to record the key
+	// version in a ciphertext
+	vaultVersionLength = 4
+
+	// vaultOverhead is the nonce and tag added by transit's AEAD key types
+	vaultOverhead = 12 + 16
+)
+
+// VaultKMS wraps keys using the transit secrets engine of a HashiCorp
+// Vault. Ciphertexts record the version of the key they were encrypted with
+// so the key can be rotated in Vault, Rewrap moves a ciphertext to the
+// latest version without exposing the key it wraps.
+type VaultKMS struct {
+	url        *KeyURL
+	addr       string
+	mount      string
+	name       string
+	namespace  string
+	keyVersion int
+
+	roleID       string
+	secretID     string
+	approleMount string
+
+	client *http.Client
+
+	lock  sync.RWMutex
+	token string
+}
+
+func NewVaultKMS(url *KeyURL) (*VaultKMS, error) {
+	path := strings.Trim(url.Path, "/")
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return nil, errors.New(InvalidKeyURLCause, "Vault key url must include the transit mount and key name")
+	}
+
+	q := url.Query()
+	scheme := "https"
+	if q.Get("tls") == "false" {
+		scheme = "http"
+	}
+
+	v := &VaultKMS{
+		url:          url,
+		addr:         scheme + "://" + url.Host,
+		mount:        path[:i],
+		name:         path[i+1:],
+		namespace:    q.Get("namespace"),
+		roleID:       valueOrEnv(q.Get("role_id"), "VAULT_ROLE_ID"),
+		secretID:     valueOrEnv(q.Get("secret_id"), "VAULT_SECRET_ID"),
+		approleMount: q.Get("approle"),
+		token:        valueOrEnv(q.Get("token"), "VAULT_TOKEN"),
+		client:       &http.Client{Timeout: 10 * time.Second},
+	}
+
+	if v.approleMount == "" {
+		v.approleMount = "approle"
+	}
+
+	if kv := q.Get("key_version"); kv != "" {
+		n, err := strconv.Atoi(kv)
+		if err != nil || n < 1 {
+			return nil, errors.New(InvalidKeyURLCause, "Vault key version must be a positive integer")
+		}
+
+		v.keyVersion = n
+	}
+
+	if v.roleID == "" && v.token == "" {
+		return nil, errors.New(InvalidKeyURLCause, "Vault requires either a token or an AppRole role id")
+	}
+
+	return v, nil
+}
+
+// Open logs in with AppRole if it's configured, otherwise the token is used
+// as is
+func (v *VaultKMS) Open(ctx context.Context) error {
+	if v.roleID == "" {
+		return nil
+	}
+
+	return v.login(ctx)
+}
+
+func (v *VaultKMS) Close() error {
+	return nil
+}
+
+// EncryptedKeyLength is the length of a wrapped data encryption key, the
+// key version followed by the AEAD ciphertext
+func (v *VaultKMS) EncryptedKeyLength() int {
+	return vaultVersionLength + KeyLength + vaultOverhead
+}
+
+func (v *VaultKMS) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	req := map[string]interface{}{
+		"plaintext": stdbase64.StdEncoding.EncodeToString(plaintext),
+	}
+
+	if v.keyVersion > 0 {
+		req["key_version"] = v.keyVersion
+	}
+
+	var resp struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+
+	err := v.do(ctx, "encrypt", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return packVaultCiphertext(resp.Ciphertext)
+}
+
+func (v *VaultKMS) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext string `json:"plaintext"`
+	}
+
+	err := v.do(ctx, "decrypt", map[string]interface{}{
+		"ciphertext": unpackVaultCiphertext(ciphertext),
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := stdbase64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, errors.New(KMSDecryptCause, "Vault returned an invalid plaintext")
+	}
+
+	return plaintext, nil
+}
+
+// Rewrap encrypts the ciphertext with the latest version of the key, or the
+// pinned version, without revealing what it wraps
+func (v *VaultKMS) Rewrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	req := map[string]interface{}{
+		"ciphertext": unpackVaultCiphertext(ciphertext),
+	}
+
+	if v.keyVersion > 0 {
+		req["key_version"] = v.keyVersion
+	}
+
+	var resp struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+
+	err := v.do(ctx, "rewrap", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return packVaultCiphertext(resp.Ciphertext)
+}
+
+// do calls the given transit operation on the key. AppRole tokens expire so
+// a rejected token is replaced by logging in again.
+func (v *VaultKMS) do(ctx context.Context, op string, req interface{}, out interface{}) error {
+	path := fmt.Sprintf("/v1/%s/%s/%s", v.mount, op, v.name)
+
+	var resp struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	status, err := v.request(ctx, path, v.getToken(), req, &resp)
+	if status == http.StatusForbidden && v.roleID != "" {
+		err = v.login(ctx)
+		if err != nil {
+			return err
+		}
+
+		status, err = v.request(ctx, path, v.getToken(), req, &resp)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resp.Data, out)
+}
+
+func (v *VaultKMS) login(ctx context.Context) error {
+	var resp struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+
+	path := fmt.Sprintf("/v1/auth/%s/login", v.approleMount)
+	_, err := v.request(ctx, path, "", map[string]string{
+		"role_id":   v.roleID,
+		"secret_id": v.secretID,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.Auth.ClientToken == "" {
+		return errors.New(VaultRequestCause, "Vault did not return a token for AppRole %s", v.roleID)
+	}
+
+	v.lock.Lock()
+	v.token = resp.Auth.ClientToken
+	v.lock.Unlock()
+
+	return nil
+}
+
+func (v *VaultKMS) getToken() string {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	return v.token
+}
+
+func (v *VaultKMS) request(ctx context.Context, path string, token string, in interface{}, out interface{}) (int, error) {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, v.addr+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	res, err := v.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, errors.New(VaultRequestCause, "Could not contact Vault: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		var e struct {
+			Errors []string `json:"errors"`
+		}
+
+		json.NewDecoder(res.Body).Decode(&e) // nolint: errcheck
+		return res.StatusCode, errors.New(VaultRequestCause, "Vault returned %d for %s: %s",
+			res.StatusCode, path, strings.Join(e.Errors, ", "))
+	}
+
+	return res.StatusCode, json.NewDecoder(res.Body).Decode(out)
+}
+
+// packVaultCiphertext converts a transit ciphertext, vault:v<version>:<base64>,
+// into the key version followed by the raw ciphertext so wrapped keys have a
+// fixed length
+func packVaultCiphertext(ciphertext string) ([]byte, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" || !strings.HasPrefix(parts[1], "v") {
+		return nil, errors.New(VaultRequestCause, "Vault returned an invalid ciphertext")
+	}
+
+	version, err := strconv.ParseUint(parts[1][1:], 10, 32)
+	if err != nil {
+		return nil, errors.New(VaultRequestCause, "Vault returned an invalid key version")
+	}
+
+	raw, err := stdbase64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New(VaultRequestCause, "Vault returned an invalid ciphertext")
+	}
+
+	packed := make([]byte, vaultVersionLength, vaultVersionLength+len(raw))
+	binary.BigEndian.PutUint32(packed, uint32(version))
+	return append(packed, raw...), nil
+}
+
+func unpackVaultCiphertext(packed []byte) string {
+	if len(packed) < vaultVersionLength {
+		return ""
+	}
+
+	version := binary.BigEndian.Uint32(packed[:vaultVersionLength])
+	raw := stdbase64.StdEncoding.EncodeToString(packed[vaultVersionLength:])
+	return fmt.Sprintf("vault:v%d:%s", version, raw)
+}
+
+// VaultKeyVersion returns the version of the Vault key the ciphertext was
+// encrypted with
+func VaultKeyVersion(packed []byte) int {
+	if len(packed) < vaultVersionLength {
+		return 0
+	}
+
+	return int(binary.BigEndian.Uint32(packed[:vaultVersionLength]))
+}
+
+func valueOrEnv(value string, env string) string {
+	if value != "" {
+		return value
+	}
+
+	return os.Getenv(env)
+}
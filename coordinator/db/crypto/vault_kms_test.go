@@ -0,0 +1,333 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	stdbase64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+// transit is a stand-in for the transit secrets engine of a Vault server
+// with a single AppRole, it supports just enough of the API for VaultKMS
+type transit struct {
+	lock     sync.Mutex
+	versions [][]byte
+	tokens   map[string]bool
+	logins   int
+	roleID   string
+	secretID string
+}
+
+func newTransit(t *testing.T) (*transit, *httptest.Server) {
+	tr := &transit{
+		tokens:   map[string]bool{"root-token": true},
+		roleID:   "my-role",
+		secretID: "my-secret",
+	}
+	tr.rotate()
+
+	srv := httptest.NewServer(tr)
+	t.Cleanup(srv.Close)
+
+	return tr, srv
+}
+
+func (tr *transit) rotate() {
+	tr.lock.Lock()
+	defer tr.lock.Unlock()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	if err != nil {
+		panic(err)
+	}
+
+	tr.versions = append(tr.versions, key)
+}
+
+func (tr *transit) revokeAll() {
+	tr.lock.Lock()
+	defer tr.lock.Unlock()
+
+	tr.tokens = map[string]bool{}
+}
+
+func (tr *transit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tr.lock.Lock()
+	defer tr.lock.Unlock()
+
+	var req map[string]interface{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		vaultError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if r.URL.Path == "/v1/auth/approle/login" {
+		if req["role_id"] != tr.roleID || req["secret_id"] != tr.secretID {
+			vaultError(w, http.StatusBadRequest, "invalid role or secret ID")
+			return
+		}
+
+		tr.logins++
+		token := fmt.Sprintf("approle-token-%d", tr.logins)
+		tr.tokens[token] = true
+		writeJSON(w, map[string]interface{}{"auth": map[string]string{"client_token": token}})
+		return
+	}
+
+	if !tr.tokens[r.Header.Get("X-Vault-Token")] {
+		vaultError(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/transit/"), "/")
+	if len(parts) != 2 || parts[1] != "my-key" {
+		vaultError(w, http.StatusNotFound, "no handler for route")
+		return
+	}
+
+	version := len(tr.versions)
+	if kv, ok := req["key_version"].(float64); ok {
+		version = int(kv)
+	}
+
+	var data map[string]string
+	switch parts[0] {
+	case "encrypt":
+		plaintext, _ := stdbase64.StdEncoding.DecodeString(req["plaintext"].(string))
+		data = map[string]string{"ciphertext": tr.seal(version, plaintext)}
+	case "decrypt":
+		plaintext, err := tr.open(req["ciphertext"].(string))
+		if err != nil {
+			vaultError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		data = map[string]string{"plaintext": stdbase64.StdEncoding.EncodeToString(plaintext)}
+	case "rewrap":
+		plaintext, err := tr.open(req["ciphertext"].(string))
+		if err != nil {
+			vaultError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		data = map[string]string{"ciphertext": tr.seal(version, plaintext)}
+	default:
+		vaultError(w, http.StatusNotFound, "no handler for route")
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{"data": data})
+}
+
+func (tr *transit) seal(version int, plaintext []byte) string {
+	gcm := tr.gcm(version)
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		panic(err)
+	}
+
+	sealed := gcm.Seal(nonce, nonce, plaintext, nil)
+	return fmt.Sprintf("vault:v%d:%s", version, stdbase64.StdEncoding.EncodeToString(sealed))
+}
+
+func (tr *transit) open(ciphertext string) ([]byte, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil || version < 1 || version > len(tr.versions) {
+		return nil, fmt.Errorf("invalid key version")
+	}
+
+	sealed, err := stdbase64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	gcm := tr.gcm(version)
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+}
+
+func (tr *transit) gcm(version int) cipher.AEAD {
+	block, err := aes.NewCipher(tr.versions[version-1])
+	if err != nil {
+		panic(err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+
+	return gcm
+}
+
+func vaultError(w http.ResponseWriter, status int, msg string) {
+	w.WriteHeader(status)
+	writeJSON(w, map[string]interface{}{"errors": []string{msg}})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v) // nolint: errcheck
+}
+
+func vaultKMS(t *testing.T, srv *httptest.Server, query string) *VaultKMS {
+	u, err := NewKeyURL("vault://" + strings.TrimPrefix(srv.URL, "http://") + "/transit/my-key?tls=false&" + query)
+	gm.Expect(err).To(gm.BeNil())
+
+	k, err := LoadKMS(u)
+	gm.Expect(err).To(gm.BeNil())
+
+	return k.(*VaultKMS)
+}
+
+func TestVaultKMS(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	dek := make([]byte, KeyLength)
+	_, err := rand.Read(dek)
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("encrypt and decrypt with a token", func(t *testing.T) {
+		_, srv := newTransit(t)
+		kms := vaultKMS(t, srv, "token=root-token")
+
+		wrapped, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(wrapped)).To(gm.Equal(kms.EncryptedKeyLength()))
+		gm.Expect(VaultKeyVersion(wrapped)).To(gm.Equal(1))
+
+		unwrapped, err := kms.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(dek))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, srv := newTransit(t)
+		kms := vaultKMS(t, srv, "token=nope")
+
+		_, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("permission denied"))
+	})
+
+	t.Run("authenticates with approle", func(t *testing.T) {
+		tr, srv := newTransit(t)
+		kms := vaultKMS(t, srv, "role_id=my-role&secret_id=my-secret")
+		gm.Expect(tr.logins).To(gm.Equal(1))
+
+		wrapped, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).To(gm.BeNil())
+
+		// an expired token is replaced by logging in again
+		tr.revokeAll()
+
+		unwrapped, err := kms.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(dek))
+		gm.Expect(tr.logins).To(gm.Equal(2))
+	})
+
+	t.Run("invalid approle secret", func(t *testing.T) {
+		_, srv := newTransit(t)
+
+		u, err := NewKeyURL("vault://" + strings.TrimPrefix(srv.URL, "http://") +
+			"/transit/my-key?tls=false&role_id=my-role&secret_id=wrong")
+		gm.Expect(err).To(gm.BeNil())
+
+		kms, err := NewVaultKMS(u)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(kms.Open(ctx)).ToNot(gm.BeNil())
+	})
+
+	t.Run("rewraps with the latest key version", func(t *testing.T) {
+		tr, srv := newTransit(t)
+		kms := vaultKMS(t, srv, "token=root-token")
+
+		wrapped, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).To(gm.BeNil())
+
+		tr.rotate()
+
+		// older versions can still be decrypted after a rotation
+		unwrapped, err := kms.Decrypt(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(dek))
+
+		var rewrapper Rewrapper = kms
+		rewrapped, err := rewrapper.Rewrap(ctx, wrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(VaultKeyVersion(rewrapped)).To(gm.Equal(2))
+		gm.Expect(len(rewrapped)).To(gm.Equal(kms.EncryptedKeyLength()))
+
+		unwrapped, err = kms.Decrypt(ctx, rewrapped)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(unwrapped).To(gm.Equal(dek))
+	})
+
+	t.Run("pins the key version", func(t *testing.T) {
+		tr, srv := newTransit(t)
+		tr.rotate()
+
+		kms := vaultKMS(t, srv, "token=root-token&key_version=1")
+
+		wrapped, err := kms.Encrypt(ctx, dek)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(VaultKeyVersion(wrapped)).To(gm.Equal(1))
+	})
+
+	t.Run("requires credentials", func(t *testing.T) {
+		u, err := NewKeyURL("vault://localhost:8200/transit/my-key")
+		gm.Expect(err).To(gm.BeNil())
+
+		token := os.Getenv("VAULT_TOKEN")
+		os.Unsetenv("VAULT_TOKEN")
+		defer os.Setenv("VAULT_TOKEN", token)
+
+		_, err = NewVaultKMS(u)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("requires a key name", func(t *testing.T) {
+		u, err := NewKeyURL("vault://localhost:8200/my-key?token=root-token")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = NewVaultKMS(u)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("secret box codec", func(t *testing.T) {
+		_, srv := newTransit(t)
+		codec := NewSecretBoxCodec(vaultKMS(t, srv, "token=root-token"))
+
+		data := base64.New([]byte("my secret data"))
+		encrypted, err := codec.Encrypt(ctx, data)
+		gm.Expect(err).To(gm.BeNil())
+
+		decrypted, err := codec.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted.String()).To(gm.Equal(data.String()))
+	})
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/capeprivacy/cape/models"
 )
@@ -17,6 +18,22 @@ type Interface interface {
 	Tokens() TokensDB
 	Session() SessionDB
 	Recoveries() RecoveryDB
+	EmailChanges() EmailChangeDB
+	Invitations() InvitationDB
+	SSOAttempts() SSOAttemptDB
+	SSOIdentities() SSOIdentityDB
+	MFA() MFADB
+	RefreshTokens() RefreshTokenDB
+	Throttles() ThrottleDB
+	Teams() TeamDB
+	Audit() AuditDB
+	ProjectKeys() ProjectKeyDB
+
+	// Tx calls fn with a database bound to a transaction. The transaction
+	// is committed if fn returns nil and rolled back otherwise, in which
+	// case fn's error is returned. Calling Tx on the database given to fn
+	// runs within the same transaction.
+	Tx(ctx context.Context, fn func(Interface) error) error
 }
 
 // Interfaces
@@ -35,6 +52,12 @@ type RoleDB interface {
 	GetByID(context.Context, string) (*models.Role, error)
 	List(context.Context, *ListRoleOptions) ([]*models.Role, error)
 
+	// Create, Update and Delete manage custom roles, Delete returns
+	// ErrRoleInUse while the role is still assigned to anyone
+	Create(context.Context, *models.Role) error
+	Update(context.Context, *models.Role) error
+	Delete(context.Context, models.Label) error
+
 	GetAll(context.Context, string) (*models.UserRoles, error)
 
 	SetOrgRole(context.Context, models.Email, models.Label) (*models.Assignment, error)
@@ -42,12 +65,22 @@ type RoleDB interface {
 	SetProjectRole(context.Context, models.Email, models.Label, models.Label) (*models.Assignment, error)
 	GetProjectRole(context.Context, models.Email, string) (*models.Role, error)
 
+	// DeleteProjectRole removes the user's role in the project, roles
+	// granted through their teams aren't affected
+	DeleteProjectRole(context.Context, models.Email, models.Label) error
+
 	CreateSystemRoles(context.Context) error
 }
 
 type ConfigDB interface {
 	Create(context.Context, models.Config) error
 	Get(context.Context) (*models.Config, error)
+
+	// GetForUpdate is Get, locking the config until the transaction it's
+	// called in ends so it can be changed without losing concurrent updates
+	GetForUpdate(context.Context) (*models.Config, error)
+
+	Update(context.Context, models.Config) error
 }
 
 type ContributorDB interface {
@@ -66,6 +99,9 @@ type ProjectsDB interface {
 	List(context.Context) ([]models.Project, error)
 	ListByStatus(context.Context, models.ProjectStatus) ([]models.Project, error)
 
+	// CreateProjectSpec stores the spec and creates the secrets it uses in
+	// the given SecretDB, which should belong to the same transaction so a
+	// spec is never stored without its secrets
 	CreateProjectSpec(context.Context, models.Policy, SecretDB) error
 	GetProjectSpec(context.Context, string, SecretDB) (*models.Policy, error)
 
@@ -75,10 +111,35 @@ type ProjectsDB interface {
 	UpdateSuggestion(context.Context, models.Suggestion) error
 }
 
+// SecretDB stores the secret arguments of each project's transformations,
+// secrets are identified by their name and version within the project
 type SecretDB interface {
-	Create(context.Context, models.SecretArg) error
-	Delete(context.Context, string) (DeleteStatus, error)
-	Get(context.Context, string) (*models.SecretArg, error)
+	Create(ctx context.Context, projectID string, secret models.SecretArg) error
+
+	// Delete removes every version of the named secret
+	Delete(ctx context.Context, projectID string, name string) (DeleteStatus, error)
+	Get(ctx context.Context, projectID string, name string, version int) (*models.SecretArg, error)
+
+	// List returns every version of the project's secrets without their
+	// values, ordered by name and then version
+	List(ctx context.Context, projectID string) ([]models.SecretArg, error)
+
+	CreateReference(context.Context, models.SecretReference) error
+
+	// ListReferences returns the references to the named secret from the
+	// project's specs, oldest first
+	ListReferences(ctx context.Context, projectID string, name string) ([]models.SecretReference, error)
+}
+
+// ProjectKeyDB stores the data key of each project. Deleting a project's key
+// crypto-shreds its secrets, they can no longer be decrypted.
+type ProjectKeyDB interface {
+	Get(ctx context.Context, projectID string) (*models.ProjectKey, error)
+
+	// Create stores the key unless the project already has one, Get must be
+	// used to find out which key was kept
+	Create(context.Context, models.ProjectKey) error
+	Delete(ctx context.Context, projectID string) (DeleteStatus, error)
 }
 
 type TokensDB interface {
@@ -86,18 +147,174 @@ type TokensDB interface {
 	Create(context.Context, models.Token) error
 	Delete(context.Context, string) error
 	ListByUserID(context.Context, string) ([]models.Token, error)
+
+	// UpdateLastUsed records when and from which ip address the token was
+	// last used to login
+	UpdateLastUsed(context.Context, string, time.Time, string) error
+
+	// DeleteExpired removes all tokens that have expired, returning the
+	// number of tokens that were removed. Tokens without an expiry are kept.
+	DeleteExpired(context.Context) (int64, error)
 }
 
 type SessionDB interface {
 	Get(context.Context, string) (*models.Session, error)
 	Create(context.Context, models.Session) error
 	Delete(context.Context, string) error
+
+	// DeleteByUserID removes all sessions belonging to the given user except
+	// for the session with the provided id, if one is given.
+	DeleteByUserID(context.Context, string, string) error
+
+	// DeleteByFamilyID removes every session created from the same login
+	DeleteByFamilyID(context.Context, string) error
+
+	// ListByUserID returns all of the unexpired sessions belonging to the
+	// given user
+	ListByUserID(context.Context, string) ([]models.Session, error)
+
+	// DeleteExpired removes all sessions that have expired, returning the
+	// number of sessions that were removed.
+	DeleteExpired(context.Context) (int64, error)
 }
 
 type RecoveryDB interface {
 	Get(context.Context, string) (*models.Recovery, error)
 	Create(context.Context, models.Recovery) error
 	Delete(context.Context, string) error
+	List(context.Context) ([]models.Recovery, error)
+
+	// DeleteExpired removes all recoveries that have expired, returning the
+	// number of recoveries that were removed.
+	DeleteExpired(context.Context) (int64, error)
+}
+
+type EmailChangeDB interface {
+	Get(context.Context, string) (*models.EmailChange, error)
+	Create(context.Context, models.EmailChange) error
+	Delete(context.Context, string) error
+
+	// DeleteExpired removes all email changes that have expired, returning
+	// the number of email changes that were removed.
+	DeleteExpired(context.Context) (int64, error)
+}
+
+type SSOAttemptDB interface {
+	Get(context.Context, string) (*models.SSOAttempt, error)
+	GetByState(context.Context, string) (*models.SSOAttempt, error)
+	Create(context.Context, models.SSOAttempt) error
+	Update(context.Context, models.SSOAttempt) error
+	Delete(context.Context, string) error
+
+	// DeleteExpired removes all sso attempts that have expired, returning
+	// the number of attempts that were removed.
+	DeleteExpired(context.Context) (int64, error)
+}
+
+// SSOIdentityDB stores the identities at the identity provider that users
+// sign in with, each identity belongs to a single user and a user can only
+// have one identity from each issuer
+type SSOIdentityDB interface {
+	Get(ctx context.Context, issuer string, subject string) (*models.SSOIdentity, error)
+	GetByUser(ctx context.Context, userID string, issuer string) (*models.SSOIdentity, error)
+	Create(context.Context, models.SSOIdentity) error
+	Update(context.Context, models.SSOIdentity) error
+}
+
+// MFADB stores each user's multi-factor authentication enrollment, all
+// lookups are by user id as a user can only have a single enrollment
+type MFADB interface {
+	Get(context.Context, string) (*models.MFAEnrollment, error)
+	Create(context.Context, models.MFAEnrollment) error
+	Update(context.Context, models.MFAEnrollment) error
+	Delete(context.Context, string) error
+}
+
+// RefreshTokenDB stores the hashes of issued refresh tokens, tokens are
+// looked up by their hash
+type RefreshTokenDB interface {
+	GetByHash(context.Context, string) (*models.RefreshToken, error)
+	Create(context.Context, models.RefreshToken) error
+
+	// MarkUsed records that the token with the given id has been exchanged
+	// for a new session. It returns false if the token had already been
+	// used, so only one of any concurrent attempts to use a token succeeds.
+	MarkUsed(context.Context, string, time.Time) (bool, error)
+
+	// DeleteByFamilyID removes the refresh tokens of every session created
+	// from the same login
+	DeleteByFamilyID(context.Context, string) error
+
+	// DeleteExpired removes all refresh tokens that have expired, returning
+	// the number of tokens that were removed.
+	DeleteExpired(context.Context) (int64, error)
+}
+
+// ThrottleDB counts failed attempts against keys. It's shared by every
+// coordinator so failures are counted no matter which replica handles a
+// request.
+type ThrottleDB interface {
+	Get(context.Context, string) (*models.Throttle, error)
+
+	// RecordFailure atomically increments the failures counted against the
+	// key at the given time, returning the updated throttle. Failures are
+	// remembered for the given window after the most recent one, if the
+	// window has passed the count starts again from one.
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (*models.Throttle, error)
+
+	// Block prevents another attempt being made against the key until the
+	// given time
+	Block(ctx context.Context, key string, until time.Time) error
+
+	// Refund takes back one of the failures counted against the key, it's
+	// used when an attempt counted before it was made turns out to succeed
+	Refund(ctx context.Context, key string) error
+
+	// Lock locks the key out until the given time and clears its failures
+	// so attempts start being counted again once the lockout ends
+	Lock(ctx context.Context, key string, until time.Time) error
+
+	// Delete clears the failures, block and lockout of the key
+	Delete(context.Context, string) error
+
+	// DeleteExpired removes all throttles whose failures have been
+	// forgotten and aren't blocked or locked, returning the number removed
+	DeleteExpired(context.Context) (int64, error)
+}
+
+type InvitationDB interface {
+	Get(context.Context, string) (*models.Invitation, error)
+	Create(context.Context, models.Invitation) error
+	Update(context.Context, models.Invitation) error
+	Delete(context.Context, string) (DeleteStatus, error)
+	List(context.Context) ([]models.Invitation, error)
+
+	// DeleteExpired removes all invitations that have expired, returning
+	// the number of invitations that were removed.
+	DeleteExpired(context.Context) (int64, error)
+}
+
+// AuditDB stores the audit log, events can only be appended to it
+type AuditDB interface {
+	// Create appends the event to the hash chain, setting its sequence and
+	// hashes
+	Create(context.Context, models.AuditEvent) error
+
+	// List returns the events matching the options, most recent first
+	List(context.Context, *ListAuditEventOptions) ([]models.AuditEvent, error)
+
+	// Last returns the most recently appended event or ErrNoRows if the log
+	// is empty
+	Last(context.Context) (*models.AuditEvent, error)
+
+	// Chain returns up to limit events appended after the given sequence, in
+	// the order they were appended
+	Chain(context.Context, int64, uint64) ([]models.AuditEvent, error)
+
+	// CreateCheckpoint stores a signed checkpoint, a checkpoint of an event
+	// that has already been checkpointed is ignored
+	CreateCheckpoint(context.Context, models.AuditCheckpoint) error
+	ListCheckpoints(context.Context) ([]models.AuditCheckpoint, error)
 }
 
 // Options
@@ -125,6 +342,17 @@ type ListUserOptions struct {
 	FilterIDs []string
 }
 
+type ListAuditEventOptions struct {
+	ActorEmail models.Email
+	Action     string
+	Target     string
+	Since      *time.Time
+	Until      *time.Time
+
+	Offset uint64
+	Limit  uint64
+}
+
 // Statuses
 
 type DeleteStatus string
@@ -135,15 +363,53 @@ const (
 	DeleteStatusError        DeleteStatus = "error"
 )
 
+// TeamDB stores teams, their members and the roles teams have been granted
+// in projects
+type TeamDB interface {
+	Create(context.Context, models.Team) error
+	Get(context.Context, models.Label) (*models.Team, error)
+	List(context.Context) ([]models.Team, error)
+	Delete(context.Context, models.Label) (DeleteStatus, error)
+
+	// ListForUser returns the teams the user is a member of
+	ListForUser(ctx context.Context, userID string) ([]models.Team, error)
+
+	// AddMember adds the user to the team, or changes whether they're a
+	// maintainer if they're already a member
+	AddMember(context.Context, models.TeamMember) error
+	GetMember(ctx context.Context, teamID string, userID string) (*models.TeamMember, error)
+	RemoveMember(ctx context.Context, teamID string, userID string) error
+	Members(ctx context.Context, teamID string) ([]models.TeamMember, error)
+
+	// SetProjectRole grants the team a role within a project, replacing
+	// any role the team had in the project
+	SetProjectRole(context.Context, models.TeamProject) error
+	RemoveProject(ctx context.Context, teamID string, projectID string) error
+	Projects(ctx context.Context, teamID string) ([]models.TeamProject, error)
+}
+
 // Errors
 
 var ErrDuplicateKey = errors.New("duplicate key")
 var ErrNoRows = errors.New("no rows")
 
 var ErrCannotFindUser = errors.New("cannot find requested user")
+var ErrCannotFindSession = errors.New("cannot find requested session")
 var ErrCannotFindRole = errors.New("cannot find requested role")
+var ErrRoleInUse = errors.New("role is still assigned")
 var ErrCannotFindProject = errors.New("cannot find requested project")
 var ErrCannotFindPolicy = errors.New("cannot find requested policy")
 var ErrCannotFindSuggestion = errors.New("cannot find requested suggestion")
 var ErrCannotFindContributor = errors.New("cannot find requested contributor")
 var ErrCannotFindSecret = errors.New("cannot find requested secret")
+var ErrCannotFindProjectKey = errors.New("cannot find requested project key")
+var ErrCannotFindInvitation = errors.New("cannot find requested invitation")
+var ErrCannotFindEmailChange = errors.New("cannot find requested email change")
+var ErrCannotFindSSOAttempt = errors.New("cannot find requested sso attempt")
+var ErrCannotFindSSOIdentity = errors.New("cannot find requested sso identity")
+var ErrCannotFindMFAEnrollment = errors.New("cannot find requested mfa enrollment")
+var ErrCannotFindRefreshToken = errors.New("cannot find requested refresh token")
+var ErrCannotFindThrottle = errors.New("cannot find requested throttle")
+var ErrCannotFindTeam = errors.New("cannot find requested team")
+var ErrCannotFindTeamMember = errors.New("cannot find requested team member")
+var ErrCannotFindTeamProject = errors.New("cannot find requested team project")
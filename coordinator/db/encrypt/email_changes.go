@@ -0,0 +1,55 @@
+package encrypt
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+type emailChangesEncrypt struct {
+	db    db.EmailChangeDB
+	codec crypto.EncryptionCodec
+}
+
+var _ db.EmailChangeDB = &emailChangesEncrypt{}
+
+func (e *emailChangesEncrypt) Get(ctx context.Context, ID string) (*models.EmailChange, error) {
+	change, err := e.db.Get(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := e.codec.Decrypt(ctx, change.Credentials.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := *change.Credentials
+	creds.Secret = dec
+	change.Credentials = &creds
+
+	return change, nil
+}
+
+func (e *emailChangesEncrypt) Create(ctx context.Context, change models.EmailChange) error {
+	enc, err := e.codec.Encrypt(ctx, change.Credentials.Secret)
+	if err != nil {
+		return err
+	}
+
+	creds := *change.Credentials
+	creds.Secret = enc
+	change.Credentials = &creds
+
+	return e.db.Create(ctx, change)
+}
+
+func (e *emailChangesEncrypt) Delete(ctx context.Context, ID string) error {
+	return e.db.Delete(ctx, ID)
+}
+
+func (e *emailChangesEncrypt) DeleteExpired(ctx context.Context) (int64, error) {
+	return e.db.DeleteExpired(ctx)
+}
@@ -1,6 +1,32 @@
+// Package encrypt wraps a db.Interface so that sensitive values are encrypted
+// before they're written and decrypted after they're read.
+//
+// Keys form an envelope with three layers:
+//
+//   - The root key is loaded from the KMS named by the coordinator's config,
+//     such as a file, Vault or a cloud KMS. It only wraps the data key and
+//     the signing keys stored in the database config, which are unwrapped
+//     once when the coordinator starts.
+//   - The data key encrypts every encrypted column, including the project
+//     keys themselves. It's held in memory so reads never call out to the KMS.
+//   - Each project key encrypts its project's secrets. Project keys are
+//     wrapped with the data key, not the root key, so reading a secret costs
+//     one local decryption of its project key rather than a KMS request.
+//
+// Because project keys sit under the data key, anyone able to decrypt the
+// data key can decrypt every project key. Rotating the root key only
+// re-wraps the keys in the config, while re-encrypting with a new data key
+// re-encrypts the project keys and leaves the secrets encrypted with them
+// untouched.
+//
+// Destroying a project key makes the project's secrets unreadable, but
+// backups holding the key's row can still be decrypted with the data key
+// that wrapped it.
 package encrypt
 
 import (
+	"context"
+
 	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/db/crypto"
 )
@@ -23,11 +49,36 @@ func New(db db.Interface, codec crypto.EncryptionCodec) *CapeDBEncrypt {
 func (c *CapeDBEncrypt) Roles() db.RoleDB               { return c.db.Roles() }
 func (c *CapeDBEncrypt) Users() db.UserDB               { return &userEncrypt{db: c.db.Users(), codec: c.codec} }
 func (c *CapeDBEncrypt) Contributors() db.ContributorDB { return c.db.Contributors() }
-func (c *CapeDBEncrypt) Projects() db.ProjectsDB        { return c.db.Projects() }
 func (c *CapeDBEncrypt) Config() db.ConfigDB            { return c.db.Config() }
 
+// RefreshTokens are stored as hashes so there is nothing to encrypt
+func (c *CapeDBEncrypt) RefreshTokens() db.RefreshTokenDB { return c.db.RefreshTokens() }
+
+// Throttles only hold counters so there is nothing to encrypt
+func (c *CapeDBEncrypt) Throttles() db.ThrottleDB { return c.db.Throttles() }
+func (c *CapeDBEncrypt) Teams() db.TeamDB         { return c.db.Teams() }
+func (c *CapeDBEncrypt) Audit() db.AuditDB        { return c.db.Audit() }
+
+// Tx encrypts the values written within the transaction like any others
+func (c *CapeDBEncrypt) Tx(ctx context.Context, fn func(db.Interface) error) error {
+	return c.db.Tx(ctx, func(tx db.Interface) error {
+		return fn(New(tx, c.codec))
+	})
+}
+
+func (c *CapeDBEncrypt) Projects() db.ProjectsDB {
+	return &projectsEncrypt{db: c.db.Projects(), codec: c.codec}
+}
+
 func (c *CapeDBEncrypt) Secrets() db.SecretDB {
-	return &secretEncrypt{db: c.db.Secrets(), codec: c.codec}
+	return &secretEncrypt{db: c.db.Secrets(), keys: c.ProjectKeys(), codec: c.codec}
+}
+
+func (c *CapeDBEncrypt) ProjectKeys() db.ProjectKeyDB {
+	return &projectKeysEncrypt{
+		db:    c.db.ProjectKeys(),
+		codec: c.codec,
+	}
 }
 
 func (c *CapeDBEncrypt) Tokens() db.TokensDB {
@@ -50,3 +101,33 @@ func (c *CapeDBEncrypt) Recoveries() db.RecoveryDB {
 		codec: c.codec,
 	}
 }
+
+func (c *CapeDBEncrypt) EmailChanges() db.EmailChangeDB {
+	return &emailChangesEncrypt{
+		db:    c.db.EmailChanges(),
+		codec: c.codec,
+	}
+}
+
+func (c *CapeDBEncrypt) Invitations() db.InvitationDB {
+	return &invitationsEncrypt{
+		db:    c.db.Invitations(),
+		codec: c.codec,
+	}
+}
+
+func (c *CapeDBEncrypt) SSOAttempts() db.SSOAttemptDB {
+	return &ssoAttemptsEncrypt{
+		db:    c.db.SSOAttempts(),
+		codec: c.codec,
+	}
+}
+
+func (c *CapeDBEncrypt) SSOIdentities() db.SSOIdentityDB { return c.db.SSOIdentities() }
+
+func (c *CapeDBEncrypt) MFA() db.MFADB {
+	return &mfaEncrypt{
+		db:    c.db.MFA(),
+		codec: c.codec,
+	}
+}
@@ -0,0 +1,79 @@
+package encrypt
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+type invitationsEncrypt struct {
+	db    db.InvitationDB
+	codec crypto.EncryptionCodec
+}
+
+var _ db.InvitationDB = &invitationsEncrypt{}
+
+func (i *invitationsEncrypt) Get(ctx context.Context, ID string) (*models.Invitation, error) {
+	invitation, err := i.db.Get(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := i.codec.Decrypt(ctx, invitation.Credentials.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := *invitation.Credentials
+	creds.Secret = dec
+	invitation.Credentials = &creds
+
+	return invitation, nil
+}
+
+func (i *invitationsEncrypt) Create(ctx context.Context, invitation models.Invitation) error {
+	enc, err := i.encrypt(ctx, invitation)
+	if err != nil {
+		return err
+	}
+
+	return i.db.Create(ctx, *enc)
+}
+
+func (i *invitationsEncrypt) Update(ctx context.Context, invitation models.Invitation) error {
+	enc, err := i.encrypt(ctx, invitation)
+	if err != nil {
+		return err
+	}
+
+	return i.db.Update(ctx, *enc)
+}
+
+func (i *invitationsEncrypt) Delete(ctx context.Context, ID string) (db.DeleteStatus, error) {
+	return i.db.Delete(ctx, ID)
+}
+
+// List does not need to decrypt anything as the credentials are never
+// returned when listing invitations
+func (i *invitationsEncrypt) List(ctx context.Context) ([]models.Invitation, error) {
+	return i.db.List(ctx)
+}
+
+func (i *invitationsEncrypt) DeleteExpired(ctx context.Context) (int64, error) {
+	return i.db.DeleteExpired(ctx)
+}
+
+func (i *invitationsEncrypt) encrypt(ctx context.Context, invitation models.Invitation) (*models.Invitation, error) {
+	enc, err := i.codec.Encrypt(ctx, invitation.Credentials.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := *invitation.Credentials
+	creds.Secret = enc
+	invitation.Credentials = &creds
+
+	return &invitation, nil
+}
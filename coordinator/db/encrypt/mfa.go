@@ -0,0 +1,55 @@
+package encrypt
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+type mfaEncrypt struct {
+	db    db.MFADB
+	codec crypto.EncryptionCodec
+}
+
+var _ db.MFADB = &mfaEncrypt{}
+
+func (m *mfaEncrypt) Get(ctx context.Context, userID string) (*models.MFAEnrollment, error) {
+	enrollment, err := m.db.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := m.codec.Decrypt(ctx, enrollment.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment.Secret = dec
+	return enrollment, nil
+}
+
+func (m *mfaEncrypt) Create(ctx context.Context, enrollment models.MFAEnrollment) error {
+	enc, err := m.codec.Encrypt(ctx, enrollment.Secret)
+	if err != nil {
+		return err
+	}
+
+	enrollment.Secret = enc
+	return m.db.Create(ctx, enrollment)
+}
+
+func (m *mfaEncrypt) Update(ctx context.Context, enrollment models.MFAEnrollment) error {
+	enc, err := m.codec.Encrypt(ctx, enrollment.Secret)
+	if err != nil {
+		return err
+	}
+
+	enrollment.Secret = enc
+	return m.db.Update(ctx, enrollment)
+}
+
+func (m *mfaEncrypt) Delete(ctx context.Context, userID string) error {
+	return m.db.Delete(ctx, userID)
+}
@@ -0,0 +1,83 @@
+package encrypt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+// projectKeyPrefix distinguishes the key versions of values encrypted with a
+// project's key from those encrypted with the data key
+const projectKeyPrefix = "project:"
+
+// ProjectKeyVersion returns the key version recorded in values encrypted
+// with the project key identified by id
+func ProjectKeyVersion(id string) string {
+	return projectKeyPrefix + id
+}
+
+// IsProjectKeyVersion returns whether the key version belongs to a project
+// key rather than the data key
+func IsProjectKeyVersion(version string) bool {
+	return strings.HasPrefix(version, projectKeyPrefix)
+}
+
+// projectKeysEncrypt wraps project keys with the data key, see the package
+// documentation for how they fit into the key envelope
+type projectKeysEncrypt struct {
+	db    db.ProjectKeyDB
+	codec crypto.EncryptionCodec
+}
+
+var _ db.ProjectKeyDB = &projectKeysEncrypt{}
+
+func (p *projectKeysEncrypt) Get(ctx context.Context, projectID string) (*models.ProjectKey, error) {
+	key, err := p.db.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	dec, err := p.codec.Decrypt(ctx, key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Key = dec
+	return key, nil
+}
+
+func (p *projectKeysEncrypt) Create(ctx context.Context, key models.ProjectKey) error {
+	enc, err := p.codec.Encrypt(ctx, key.Key)
+	if err != nil {
+		return err
+	}
+
+	key.Key = enc
+	return p.db.Create(ctx, key)
+}
+
+func (p *projectKeysEncrypt) Delete(ctx context.Context, projectID string) (db.DeleteStatus, error) {
+	return p.db.Delete(ctx, projectID)
+}
+
+// projectCodec returns a codec that encrypts with the project's key and
+// records its version in each value
+func projectCodec(key *models.ProjectKey) (crypto.EncryptionCodec, error) {
+	u, err := crypto.NewBase64KeyURL(*key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	kms, err := crypto.NewLocalKMS(u)
+	if err != nil {
+		return nil, err
+	}
+
+	version := ProjectKeyVersion(key.ID)
+	return crypto.NewKeyring(version, map[string]crypto.EncryptionCodec{
+		version: crypto.NewSecretBoxCodec(kms),
+	})
+}
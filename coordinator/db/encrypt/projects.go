@@ -0,0 +1,154 @@
+package encrypt
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/manifoldco/go-base64"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+// projectsEncrypt encrypts the contents of project specs, which reveal
+// which fields are sensitive and how they're transformed. The ids that
+// specs are looked up by are left in plaintext.
+type projectsEncrypt struct {
+	db    db.ProjectsDB
+	codec crypto.EncryptionCodec
+}
+
+var _ db.ProjectsDB = &projectsEncrypt{}
+
+// policyContents are the parts of a policy that are encrypted
+type policyContents struct {
+	Transformations []*models.NamedTransformation `json:"transformations"`
+	Rules           []*models.Rule                `json:"rules"`
+}
+
+func (p *projectsEncrypt) Get(ctx context.Context, label models.Label) (*models.Project, error) {
+	return p.db.Get(ctx, label)
+}
+
+func (p *projectsEncrypt) GetByID(ctx context.Context, id string) (*models.Project, error) {
+	return p.db.GetByID(ctx, id)
+}
+
+func (p *projectsEncrypt) Create(ctx context.Context, project models.Project) error {
+	return p.db.Create(ctx, project)
+}
+
+func (p *projectsEncrypt) Update(ctx context.Context, project models.Project) error {
+	return p.db.Update(ctx, project)
+}
+
+func (p *projectsEncrypt) List(ctx context.Context) ([]models.Project, error) {
+	return p.db.List(ctx)
+}
+
+func (p *projectsEncrypt) ListByStatus(ctx context.Context, status models.ProjectStatus) ([]models.Project, error) {
+	return p.db.ListByStatus(ctx, status)
+}
+
+// CreateProjectSpec creates the spec's secrets itself as the underlying
+// database can't see the transformations that use them. They're created
+// once the spec is stored as the spec's references to them refer to it.
+func (p *projectsEncrypt) CreateProjectSpec(ctx context.Context, spec models.Policy, secretDB db.SecretDB) error {
+	enc, err := encryptPolicy(ctx, p.codec, spec)
+	if err != nil {
+		return err
+	}
+
+	err = p.db.CreateProjectSpec(ctx, *enc, secretDB)
+	if err != nil {
+		return err
+	}
+
+	return db.CreateSecrets(ctx, secretDB, spec)
+}
+
+// GetProjectSpec decrypts the spec, specs created before they were
+// encrypted are returned as they are
+func (p *projectsEncrypt) GetProjectSpec(ctx context.Context, id string, secretDB db.SecretDB) (*models.Policy, error) {
+	spec, err := p.db.GetProjectSpec(ctx, id, secretDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Encrypted == nil {
+		return spec, nil
+	}
+
+	dec, err := decryptPolicy(ctx, p.codec, *spec)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.ResolveSecrets(ctx, secretDB, dec)
+	if err != nil {
+		return nil, err
+	}
+
+	return dec, nil
+}
+
+func (p *projectsEncrypt) CreateSuggestion(ctx context.Context, suggestion models.Suggestion) error {
+	return p.db.CreateSuggestion(ctx, suggestion)
+}
+
+func (p *projectsEncrypt) GetSuggestions(ctx context.Context, label models.Label) ([]models.Suggestion, error) {
+	return p.db.GetSuggestions(ctx, label)
+}
+
+func (p *projectsEncrypt) GetSuggestion(ctx context.Context, id string) (*models.Suggestion, error) {
+	return p.db.GetSuggestion(ctx, id)
+}
+
+func (p *projectsEncrypt) UpdateSuggestion(ctx context.Context, suggestion models.Suggestion) error {
+	return p.db.UpdateSuggestion(ctx, suggestion)
+}
+
+func encryptPolicy(ctx context.Context, codec crypto.EncryptionCodec, spec models.Policy) (*models.Policy, error) {
+	b, err := json.Marshal(policyContents{
+		Transformations: spec.Transformations,
+		Rules:           spec.Rules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enc, err := codec.Encrypt(ctx, base64.New(b))
+	if err != nil {
+		return nil, err
+	}
+
+	s := spec
+
+	s.Transformations = nil
+	s.Rules = nil
+	s.Encrypted = enc
+
+	return &s, nil
+}
+
+func decryptPolicy(ctx context.Context, codec crypto.EncryptionCodec, spec models.Policy) (*models.Policy, error) {
+	dec, err := codec.Decrypt(ctx, spec.Encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	var contents policyContents
+	err = json.Unmarshal(*dec, &contents)
+	if err != nil {
+		return nil, err
+	}
+
+	s := spec
+
+	s.Transformations = contents.Transformations
+	s.Rules = contents.Rules
+	s.Encrypted = nil
+
+	return &s, nil
+}
@@ -0,0 +1,145 @@
+package encrypt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+type testPgProjects struct {
+	db.ProjectsDB
+	specs map[string]models.Policy
+}
+
+func (t *testPgProjects) CreateProjectSpec(ctx context.Context, spec models.Policy, secretDB db.SecretDB) error {
+	t.specs[spec.ID] = spec
+	return db.CreateSecrets(ctx, secretDB, spec)
+}
+
+func (t *testPgProjects) GetProjectSpec(ctx context.Context, id string, secretDB db.SecretDB) (*models.Policy, error) {
+	spec, ok := t.specs[id]
+	if !ok {
+		return nil, db.ErrCannotFindPolicy
+	}
+
+	return &spec, db.ResolveSecrets(ctx, secretDB, &spec)
+}
+
+type testSecrets struct {
+	secrets    map[string]models.SecretArg
+	references []models.SecretReference
+}
+
+func (t *testSecrets) Create(ctx context.Context, projectID string, secret models.SecretArg) error {
+	secret.Value = SecretArg.Value
+	t.secrets[fmt.Sprintf("%s/%s/%d", projectID, secret.Name, secret.Version)] = secret
+	return nil
+}
+
+func (t *testSecrets) Get(ctx context.Context, projectID string, name string, version int) (*models.SecretArg, error) {
+	secret, ok := t.secrets[fmt.Sprintf("%s/%s/%d", projectID, name, version)]
+	if !ok {
+		return nil, db.ErrCannotFindSecret
+	}
+
+	return &secret, nil
+}
+
+func (t *testSecrets) Delete(ctx context.Context, projectID string, name string) (db.DeleteStatus, error) {
+	panic("not implemented")
+}
+
+func (t *testSecrets) List(ctx context.Context, projectID string) ([]models.SecretArg, error) {
+	panic("not implemented")
+}
+
+func (t *testSecrets) CreateReference(ctx context.Context, ref models.SecretReference) error {
+	t.references = append(t.references, ref)
+	return nil
+}
+
+func (t *testSecrets) ListReferences(ctx context.Context, projectID string, name string) ([]models.SecretReference, error) {
+	panic("not implemented")
+}
+
+func TestProjectSpecs(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	key, _ := crypto.NewBase64KeyURL(nil)
+	kms, _ := crypto.NewLocalKMS(key)
+	codec := crypto.NewSecretBoxCodec(kms)
+
+	pgProjects := &testPgProjects{specs: map[string]models.Policy{}}
+	projects := &projectsEncrypt{db: pgProjects, codec: codec}
+	secrets := &testSecrets{secrets: map[string]models.SecretArg{}}
+
+	rules := []*models.Rule{{
+		Match: models.Match{Name: "email"},
+		Actions: []models.Action{{
+			Transform: models.Transformation{"type": "perturbation", "seed": "1234"},
+		}},
+	}}
+
+	transformations := []*models.NamedTransformation{{
+		Name: "tokenize",
+		Type: "reversible-tokenizer",
+		Args: map[string]interface{}{
+			"key": models.SecretArg{Type: "secret", Name: "my-key"},
+		},
+	}}
+
+	t.Run("Specs are encrypted", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		parent := models.NewID()
+		spec := models.NewPolicy("project-id", &parent, rules, transformations)
+
+		err := projects.CreateProjectSpec(ctx, spec, secrets)
+		gm.Expect(err).To(gm.BeNil())
+
+		stored := pgProjects.specs[spec.ID]
+		gm.Expect(stored.Rules).To(gm.BeNil())
+		gm.Expect(stored.Transformations).To(gm.BeNil())
+		gm.Expect(stored.Encrypted).ToNot(gm.BeNil())
+
+		// ids stay in plaintext so specs can be looked up
+		gm.Expect(stored.ProjectID).To(gm.Equal("project-id"))
+		gm.Expect(*stored.ParentID).To(gm.Equal(parent))
+
+		// secrets are created from the plaintext transformations
+		_, err = secrets.Get(ctx, "project-id", "my-key", models.FirstSecretVersion)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(secrets.references).To(gm.HaveLen(1))
+		gm.Expect(secrets.references[0].SpecID).To(gm.Equal(spec.ID))
+		gm.Expect(secrets.references[0].Version).To(gm.Equal(models.FirstSecretVersion))
+
+		got, err := projects.GetProjectSpec(ctx, spec.ID, secrets)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(got.Encrypted).To(gm.BeNil())
+		gm.Expect(got.Rules).To(gm.Equal(rules))
+		gm.Expect(got.Rules[0].Actions[0].Transform["seed"]).To(gm.Equal("1234"))
+
+		arg := got.Transformations[0].Args["key"].(models.SecretArg)
+		gm.Expect(arg.Value).To(gm.Equal(SecretArg.Value))
+	})
+
+	t.Run("Plaintext specs can still be read", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		spec := models.NewPolicy("project-id", nil, rules, nil)
+		pgProjects.specs[spec.ID] = spec
+
+		got, err := projects.GetProjectSpec(ctx, spec.ID, secrets)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(got.Rules).To(gm.Equal(rules))
+	})
+}
@@ -45,3 +45,13 @@ func (r *recoveriesEncrypt) Create(ctx context.Context, recovery models.Recovery
 func (r *recoveriesEncrypt) Delete(ctx context.Context, ID string) error {
 	return r.db.Delete(ctx, ID)
 }
+
+// List does not need to decrypt anything as the credentials are never
+// returned when listing recoveries
+func (r *recoveriesEncrypt) List(ctx context.Context) ([]models.Recovery, error) {
+	return r.db.List(ctx)
+}
+
+func (r *recoveriesEncrypt) DeleteExpired(ctx context.Context) (int64, error) {
+	return r.db.DeleteExpired(ctx)
+}
@@ -14,12 +14,16 @@ import (
 
 var _ db.SecretDB = &secretEncrypt{}
 
+// secretEncrypt encrypts each secret with its project's key, generating the
+// key when the project's first secret is created. Secrets created before
+// projects had keys are still decrypted with the data key.
 type secretEncrypt struct {
 	db    db.SecretDB
+	keys  db.ProjectKeyDB
 	codec crypto.EncryptionCodec
 }
 
-func (p *secretEncrypt) Create(ctx context.Context, secret models.SecretArg) error {
+func (p *secretEncrypt) Create(ctx context.Context, projectID string, secret models.SecretArg) error {
 	// generate random bytes for secret value
 	b := make([]byte, auth.SecretLength)
 	_, err := rand.Read(b)
@@ -28,26 +32,87 @@ func (p *secretEncrypt) Create(ctx context.Context, secret models.SecretArg) err
 	}
 	secret.Value = base64.New(b)
 
-	s, err := encryptSecret(ctx, p.codec, secret)
+	codec, err := p.projectCodec(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	s, err := encryptSecret(ctx, codec, secret)
 	if err != nil {
 		return fmt.Errorf("error encrypting user for creation: %w", err)
 	}
 
-	return p.db.Create(ctx, *s)
+	return p.db.Create(ctx, projectID, *s)
 }
 
-func (p *secretEncrypt) Delete(ctx context.Context, name string) (db.DeleteStatus, error) {
-	return p.db.Delete(ctx, name)
+func (p *secretEncrypt) Delete(ctx context.Context, projectID string, name string) (db.DeleteStatus, error) {
+	return p.db.Delete(ctx, projectID, name)
 }
 
-func (p *secretEncrypt) Get(ctx context.Context, name string) (*models.SecretArg, error) {
-	secret, err := p.db.Get(ctx, name)
+func (p *secretEncrypt) Get(ctx context.Context, projectID string, name string, version int) (*models.SecretArg, error) {
+	secret, err := p.db.Get(ctx, projectID, name, version)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsProjectKeyVersion(crypto.KeyVersion(secret.Value)) {
+		return decryptSecret(ctx, p.codec, *secret)
+	}
+
+	key, err := p.keys.Get(ctx, projectID)
+	if err == db.ErrCannotFindProjectKey {
+		return nil, fmt.Errorf("secret %s can no longer be decrypted: %w", name, err)
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	codec, err := projectCodec(key)
 	if err != nil {
 		return nil, err
 	}
 
-	return decryptSecret(ctx, p.codec, *secret)
+	return decryptSecret(ctx, codec, *secret)
+}
+
+func (p *secretEncrypt) List(ctx context.Context, projectID string) ([]models.SecretArg, error) {
+	return p.db.List(ctx, projectID)
+}
+
+func (p *secretEncrypt) CreateReference(ctx context.Context, ref models.SecretReference) error {
+	return p.db.CreateReference(ctx, ref)
+}
+
+func (p *secretEncrypt) ListReferences(ctx context.Context, projectID string, name string) ([]models.SecretReference, error) {
+	return p.db.ListReferences(ctx, projectID, name)
+}
+
+// projectCodec returns the codec for the project's key, creating the key if
+// the project doesn't have one yet
+func (p *secretEncrypt) projectCodec(ctx context.Context, projectID string) (crypto.EncryptionCodec, error) {
+	key, err := p.keys.Get(ctx, projectID)
+	if err == db.ErrCannotFindProjectKey {
+		newKey, err := models.NewProjectKey(projectID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.keys.Create(ctx, newKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// Another request may have created a key first
+		key, err = p.keys.Get(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return projectCodec(key)
 }
 
 func encryptSecret(ctx context.Context, codec crypto.EncryptionCodec, secret models.SecretArg) (*models.SecretArg, error) {
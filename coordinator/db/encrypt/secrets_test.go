@@ -2,9 +2,11 @@ package encrypt
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
+	"github.com/capeprivacy/cape/auth"
 	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/db/crypto"
 	"github.com/capeprivacy/cape/models"
@@ -45,12 +47,13 @@ func TestSecretCreate(t *testing.T) {
 	for i, test := range tests {
 		secretDB := secretEncrypt{
 			db:    pgSecret,
+			keys:  &testProjectKeys{},
 			codec: codec,
 		}
 
 		pgSecret.err = test.err
 
-		gotErr := secretDB.Create(context.TODO(), test.secret)
+		gotErr := secretDB.Create(context.TODO(), "project-id", test.secret)
 		if (test.wantErr == nil && gotErr != nil) ||
 			(test.wantErr != nil && gotErr.Error() != test.wantErr.Error()) {
 			t.Errorf("unexpected error on Create() test %d of %d: got %v want %v", i+1, len(tests), gotErr, test.wantErr)
@@ -107,13 +110,14 @@ func TestSecretGet(t *testing.T) {
 	for i, test := range tests {
 		secretDB := secretEncrypt{
 			db:    pgSecret,
+			keys:  &testProjectKeys{},
 			codec: codec,
 		}
 
 		pgSecret.returnSecret = test.secret
 		pgSecret.err = test.err
 
-		gotSpec, gotErr := secretDB.Get(context.TODO(), test.secret.Name)
+		gotSpec, gotErr := secretDB.Get(context.TODO(), "project-id", test.secret.Name, models.FirstSecretVersion)
 		if (test.wantErr == nil && gotErr != nil) ||
 			(test.wantErr != nil && gotErr != nil && gotErr.Error() != test.wantErr.Error()) {
 			t.Errorf("unexpected error on Get() test %d of %d: got %v want %v", i+1, len(tests), gotErr, test.wantErr)
@@ -131,15 +135,147 @@ type testPgSecret struct {
 
 // only testing the below two for now, rest can remain unimplemented
 
-func (t *testPgSecret) Create(ctx context.Context, secret models.SecretArg) error {
+func (t *testPgSecret) Create(ctx context.Context, projectID string, secret models.SecretArg) error {
 	t.receivedSecret = secret
 	return t.err
 }
 
-func (t *testPgSecret) Get(ctx context.Context, name string) (*models.SecretArg, error) {
+func (t *testPgSecret) Get(ctx context.Context, projectID string, name string, version int) (*models.SecretArg, error) {
 	return &t.returnSecret, t.err
 }
 
-func (t *testPgSecret) Delete(ctx context.Context, name string) (db.DeleteStatus, error) {
+func (t *testPgSecret) Delete(ctx context.Context, projectID string, name string) (db.DeleteStatus, error) {
 	panic("not implemented")
 }
+
+func (t *testPgSecret) List(ctx context.Context, projectID string) ([]models.SecretArg, error) {
+	panic("not implemented")
+}
+
+func (t *testPgSecret) CreateReference(ctx context.Context, ref models.SecretReference) error {
+	panic("not implemented")
+}
+
+func (t *testPgSecret) ListReferences(ctx context.Context, projectID string, name string) ([]models.SecretReference, error) {
+	panic("not implemented")
+}
+
+type testProjectKeys struct {
+	keys map[string]models.ProjectKey
+}
+
+func (t *testProjectKeys) Get(ctx context.Context, projectID string) (*models.ProjectKey, error) {
+	key, ok := t.keys[projectID]
+	if !ok {
+		return nil, db.ErrCannotFindProjectKey
+	}
+
+	return &key, nil
+}
+
+func (t *testProjectKeys) Create(ctx context.Context, key models.ProjectKey) error {
+	if t.keys == nil {
+		t.keys = map[string]models.ProjectKey{}
+	}
+
+	if _, ok := t.keys[key.ProjectID]; !ok {
+		t.keys[key.ProjectID] = key
+	}
+
+	return nil
+}
+
+func (t *testProjectKeys) Delete(ctx context.Context, projectID string) (db.DeleteStatus, error) {
+	if _, ok := t.keys[projectID]; !ok {
+		return db.DeleteStatusDoesNotExist, nil
+	}
+
+	delete(t.keys, projectID)
+	return db.DeleteStatusDeleted, nil
+}
+
+func TestProjectSecrets(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	key, _ := crypto.NewBase64KeyURL(nil)
+	kms, _ := crypto.NewLocalKMS(key)
+	codec := crypto.NewSecretBoxCodec(kms)
+
+	rawKeys := &testProjectKeys{}
+	keys := &projectKeysEncrypt{db: rawKeys, codec: codec}
+
+	create := func(projectID string) (*secretEncrypt, *testPgSecret) {
+		pgSecret := &testPgSecret{}
+		secretDB := &secretEncrypt{db: pgSecret, keys: keys, codec: codec}
+
+		err := secretDB.Create(ctx, projectID, SecretArg)
+		gm.Expect(err).To(gm.BeNil())
+
+		pgSecret.returnSecret = pgSecret.receivedSecret
+		return secretDB, pgSecret
+	}
+
+	t.Run("Secrets are encrypted with their project's key", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		secretDB, pgSecret := create("project-a")
+
+		projectKey, err := rawKeys.Get(ctx, "project-a")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(crypto.KeyVersion(pgSecret.receivedSecret.Value)).To(gm.Equal(ProjectKeyVersion(projectKey.ID)))
+
+		// the project key is stored encrypted with the data key
+		decryptedKey, err := keys.Get(ctx, "project-a")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(projectKey.Key).ToNot(gm.Equal(decryptedKey.Key))
+
+		secret, err := secretDB.Get(ctx, "project-a", SecretArg.Name, models.FirstSecretVersion)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(*secret.Value)).To(gm.Equal(auth.SecretLength))
+
+		// the secret can't be decrypted with the data key alone
+		_, err = codec.Decrypt(ctx, pgSecret.receivedSecret.Value)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Projects have their own keys", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		create("project-b")
+		_, pgSecret := create("project-c")
+
+		b, err := rawKeys.Get(ctx, "project-b")
+		gm.Expect(err).To(gm.BeNil())
+
+		c, err := rawKeys.Get(ctx, "project-c")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(b.ID).ToNot(gm.Equal(c.ID))
+
+		// project b's key can't decrypt project c's secrets
+		other := &secretEncrypt{db: pgSecret, keys: keys, codec: codec}
+		_, err = other.Get(ctx, "project-b", SecretArg.Name, models.FirstSecretVersion)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Destroying the key shreds the project's secrets", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		secretDB, _ := create("project-d")
+
+		status, err := keys.Delete(ctx, "project-d")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(status).To(gm.Equal(db.DeleteStatusDeleted))
+
+		_, err = secretDB.Get(ctx, "project-d", SecretArg.Name, models.FirstSecretVersion)
+		gm.Expect(errors.Is(err, db.ErrCannotFindProjectKey)).To(gm.BeTrue())
+
+		// a new key doesn't recover them
+		create("project-e")
+		rawKeys.keys["project-d"] = rawKeys.keys["project-e"]
+
+		_, err = secretDB.Get(ctx, "project-d", SecretArg.Name, models.FirstSecretVersion)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
@@ -45,3 +45,30 @@ func (s *sessionEncrypt) Create(ctx context.Context, session models.Session) err
 func (s *sessionEncrypt) Delete(ctx context.Context, ID string) error {
 	return s.db.Delete(ctx, ID)
 }
+
+func (s *sessionEncrypt) DeleteByUserID(ctx context.Context, userID string, keepID string) error {
+	return s.db.DeleteByUserID(ctx, userID, keepID)
+}
+
+func (s *sessionEncrypt) DeleteByFamilyID(ctx context.Context, familyID string) error {
+	return s.db.DeleteByFamilyID(ctx, familyID)
+}
+
+func (s *sessionEncrypt) DeleteExpired(ctx context.Context) (int64, error) {
+	return s.db.DeleteExpired(ctx)
+}
+
+// ListByUserID does not decrypt the session tokens as they are never
+// returned when listing sessions, instead they are removed entirely
+func (s *sessionEncrypt) ListByUserID(ctx context.Context, userID string) ([]models.Session, error) {
+	sessions, err := s.db.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Token = nil
+	}
+
+	return sessions, nil
+}
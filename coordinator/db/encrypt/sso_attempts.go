@@ -0,0 +1,86 @@
+package encrypt
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+type ssoAttemptsEncrypt struct {
+	db    db.SSOAttemptDB
+	codec crypto.EncryptionCodec
+}
+
+var _ db.SSOAttemptDB = &ssoAttemptsEncrypt{}
+
+func (s *ssoAttemptsEncrypt) Get(ctx context.Context, ID string) (*models.SSOAttempt, error) {
+	attempt, err := s.db.Get(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(ctx, attempt)
+}
+
+func (s *ssoAttemptsEncrypt) GetByState(ctx context.Context, state string) (*models.SSOAttempt, error) {
+	attempt, err := s.db.GetByState(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(ctx, attempt)
+}
+
+func (s *ssoAttemptsEncrypt) Create(ctx context.Context, attempt models.SSOAttempt) error {
+	enc, err := s.encrypt(ctx, attempt)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Create(ctx, *enc)
+}
+
+func (s *ssoAttemptsEncrypt) Update(ctx context.Context, attempt models.SSOAttempt) error {
+	enc, err := s.encrypt(ctx, attempt)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(ctx, *enc)
+}
+
+func (s *ssoAttemptsEncrypt) Delete(ctx context.Context, ID string) error {
+	return s.db.Delete(ctx, ID)
+}
+
+func (s *ssoAttemptsEncrypt) DeleteExpired(ctx context.Context) (int64, error) {
+	return s.db.DeleteExpired(ctx)
+}
+
+func (s *ssoAttemptsEncrypt) decrypt(ctx context.Context, attempt *models.SSOAttempt) (*models.SSOAttempt, error) {
+	dec, err := s.codec.Decrypt(ctx, attempt.Credentials.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := *attempt.Credentials
+	creds.Secret = dec
+	attempt.Credentials = &creds
+
+	return attempt, nil
+}
+
+func (s *ssoAttemptsEncrypt) encrypt(ctx context.Context, attempt models.SSOAttempt) (*models.SSOAttempt, error) {
+	enc, err := s.codec.Encrypt(ctx, attempt.Credentials.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := *attempt.Credentials
+	creds.Secret = enc
+	attempt.Credentials = &creds
+
+	return &attempt, nil
+}
@@ -2,6 +2,8 @@ package encrypt
 
 import (
 	"context"
+	"time"
+
 	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/db/crypto"
 	"github.com/capeprivacy/cape/models"
@@ -49,3 +51,11 @@ func (t *tokensEncrypt) Delete(ctx context.Context, ID string) error {
 func (t *tokensEncrypt) ListByUserID(ctx context.Context, userID string) ([]models.Token, error) {
 	return t.db.ListByUserID(ctx, userID)
 }
+
+func (t *tokensEncrypt) UpdateLastUsed(ctx context.Context, ID string, at time.Time, ip string) error {
+	return t.db.UpdateLastUsed(ctx, ID, at, ip)
+}
+
+func (t *tokensEncrypt) DeleteExpired(ctx context.Context) (int64, error) {
+	return t.db.DeleteExpired(ctx)
+}
@@ -85,6 +85,20 @@ func encryptUser(ctx context.Context, codec crypto.EncryptionCodec, user models.
 
 	u.Credentials.Secret = enc
 
+	// Previous passwords are as sensitive as the current one
+	if len(user.PasswordHistory) > 0 {
+		u.PasswordHistory = make([]models.Credentials, len(user.PasswordHistory))
+		for i, creds := range user.PasswordHistory {
+			enc, err := codec.Encrypt(ctx, creds.Secret)
+			if err != nil {
+				return nil, err
+			}
+
+			creds.Secret = enc
+			u.PasswordHistory[i] = creds
+		}
+	}
+
 	return &u, nil
 }
 
@@ -98,5 +112,18 @@ func decryptUser(ctx context.Context, codec crypto.EncryptionCodec, user models.
 
 	u.Credentials.Secret = dec
 
+	if len(user.PasswordHistory) > 0 {
+		u.PasswordHistory = make([]models.Credentials, len(user.PasswordHistory))
+		for i, creds := range user.PasswordHistory {
+			dec, err := codec.Decrypt(ctx, creds.Secret)
+			if err != nil {
+				return nil, err
+			}
+
+			creds.Secret = dec
+			u.PasswordHistory[i] = creds
+		}
+	}
+
 	return &u, nil
 }
@@ -340,6 +340,45 @@ func TestUserstList(t *testing.T) {
 	}
 }
 
+func TestUserPasswordHistory(t *testing.T) {
+	key, _ := crypto.NewBase64KeyURL(nil)
+	kms, _ := crypto.NewLocalKMS(key)
+	codec := crypto.NewSecretBoxCodec(kms)
+
+	previous := base64.New([]byte("PREVIOUSPREVIOUS"))
+	user := SecretUser
+	user.PasswordHistory = []models.Credentials{{Secret: previous}}
+
+	pgUser := &testPgUser{}
+	userDB := userEncrypt{
+		db:    pgUser,
+		codec: codec,
+	}
+
+	err := userDB.Update(context.TODO(), user.ID, user)
+	if err != nil {
+		t.Fatalf("unexpected error on Update(): %v", err)
+	}
+
+	stored := pgUser.receivedUser.PasswordHistory[0].Secret
+	if reflect.DeepEqual(stored, previous) {
+		t.Errorf("password history was not encrypted")
+	}
+	if !reflect.DeepEqual(user.PasswordHistory[0].Secret, previous) {
+		t.Errorf("encrypting modified the password history of the given user")
+	}
+
+	pgUser.returnUser = pgUser.receivedUser
+	gotUser, err := userDB.Get(context.TODO(), user.Email)
+	if err != nil {
+		t.Fatalf("unexpected error on Get(): %v", err)
+	}
+
+	if !reflect.DeepEqual(gotUser, &user) {
+		t.Errorf("incorrect user returned on Get(): got %v want %v", gotUser, user)
+	}
+}
+
 type testPgUser struct {
 	returnUser   models.User
 	receivedUser models.User
@@ -0,0 +1,206 @@
+package capepg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgAudit struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.AuditDB = &pgAudit{}
+
+// Create appends the event to the chain. The table is locked while the
+// event is linked to the one before it so concurrent appends can't fork the
+// chain, reads are unaffected.
+func (p *pgAudit) Create(ctx context.Context, event models.AuditEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	pool, ok := p.pool.(TxPool)
+	if !ok {
+		return errors.New("error recording audit event: database does not support transactions")
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error recording audit event: %w", err)
+	}
+	defer tx.Rollback(ctx) // nolint: errcheck
+
+	_, err = tx.Exec(ctx, "lock table audit_events in exclusive mode;")
+	if err != nil {
+		return fmt.Errorf("error recording audit event: %w", err)
+	}
+
+	var last models.AuditEvent
+	s := "select data from audit_events order by sequence desc limit 1;"
+	err = tx.QueryRow(ctx, s).Scan(&last)
+	if err != nil && err.Error() != pgx.ErrNoRows.Error() {
+		return fmt.Errorf("error recording audit event: %w", err)
+	}
+
+	event.Sequence = last.Sequence + 1
+	event.PrevHash = last.Hash
+	event.Hash = event.ComputeHash()
+
+	s = "insert into audit_events (data) values ($1);"
+	_, err = tx.Exec(ctx, s, event)
+	if err != nil {
+		return fmt.Errorf("error recording audit event: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *pgAudit) Last(ctx context.Context) (*models.AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	var event models.AuditEvent
+	s := "select data from audit_events order by sequence desc limit 1;"
+	err := p.pool.QueryRow(ctx, s).Scan(&event)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrNoRows
+		}
+		return nil, fmt.Errorf("error retrieving audit event: %w", err)
+	}
+
+	return &event, nil
+}
+
+func (p *pgAudit) Chain(ctx context.Context, after int64, limit uint64) ([]models.AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from audit_events where sequence > $1 order by sequence asc limit $2;"
+	rows, err := p.pool.Query(ctx, s, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		var event models.AuditEvent
+		err := rows.Scan(&event)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving audit event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+func (p *pgAudit) CreateCheckpoint(ctx context.Context, checkpoint models.AuditCheckpoint) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into audit_checkpoints (data) values ($1) on conflict (sequence) do nothing;"
+	_, err := p.pool.Exec(ctx, s, checkpoint)
+	if err != nil {
+		return fmt.Errorf("error creating audit checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgAudit) ListCheckpoints(ctx context.Context) ([]models.AuditCheckpoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from audit_checkpoints order by sequence asc;"
+	rows, err := p.pool.Query(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving audit checkpoints: %w", err)
+	}
+	defer rows.Close()
+
+	var checkpoints []models.AuditCheckpoint
+	for rows.Next() {
+		var checkpoint models.AuditCheckpoint
+		err := rows.Scan(&checkpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving audit checkpoint: %w", err)
+		}
+
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	return checkpoints, rows.Err()
+}
+
+func (p *pgAudit) List(ctx context.Context, opts *db.ListAuditEventOptions) ([]models.AuditEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	query := sq.Select("data").
+		PlaceholderFormat(sq.Dollar).
+		From("audit_events").
+		OrderBy("sequence desc")
+
+	if opts != nil {
+		if opts.ActorEmail != "" {
+			query = query.Where(sq.Eq{"data->>'actor_email'": opts.ActorEmail.String()})
+		}
+
+		if opts.Action != "" {
+			query = query.Where(sq.Eq{"data->>'action'": opts.Action})
+		}
+
+		if opts.Target != "" {
+			query = query.Where(sq.Eq{"data->>'target'": opts.Target})
+		}
+
+		if opts.Since != nil {
+			query = query.Where(sq.GtOrEq{"created_at": *opts.Since})
+		}
+
+		if opts.Until != nil {
+			query = query.Where(sq.Lt{"created_at": *opts.Until})
+		}
+
+		if opts.Limit > 0 {
+			query = query.Limit(opts.Limit)
+		}
+
+		query = query.Offset(opts.Offset)
+	}
+
+	s, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.pool.Query(ctx, s, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.AuditEvent
+	for rows.Next() {
+		var event models.AuditEvent
+		err := rows.Scan(&event)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving audit event: %w", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
@@ -46,12 +46,21 @@ func (c *pgConfig) Create(ctx context.Context, config models.Config) error {
 }
 
 func (c *pgConfig) Get(ctx context.Context) (*models.Config, error) {
+	return c.get(ctx, "")
+}
+
+func (c *pgConfig) GetForUpdate(ctx context.Context) (*models.Config, error) {
+	return c.get(ctx, "for update")
+}
+
+func (c *pgConfig) get(ctx context.Context, suffix string) (*models.Config, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
 	s, args, err := sq.Select("data").
 		PlaceholderFormat(sq.Dollar).
 		From("config").
+		Suffix(suffix).
 		ToSql()
 
 	if err != nil {
@@ -71,3 +80,29 @@ func (c *pgConfig) Get(ctx context.Context) (*models.Config, error) {
 
 	return &config, nil
 }
+
+func (c *pgConfig) Update(ctx context.Context, config models.Config) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	s, args, err := sq.Update("config").
+		PlaceholderFormat(sq.Dollar).
+		Set("data", config).
+		Where(sq.Eq{"id": config.ID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("error generating query: %w", err)
+	}
+
+	tag, err := c.pool.Exec(ctx, s, args...)
+	if err != nil {
+		return fmt.Errorf("error updating config: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrNoRows
+	}
+
+	return nil
+}
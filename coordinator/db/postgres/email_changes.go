@@ -0,0 +1,79 @@
+package capepg
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgEmailChange struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.EmailChangeDB = &pgEmailChange{}
+
+type dbEmailChange struct {
+	*models.EmailChange
+	Credentials *models.Credentials `json:"credentials"`
+}
+
+func (p *pgEmailChange) Get(ctx context.Context, ID string) (*models.EmailChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	change := dbEmailChange{EmailChange: &models.EmailChange{}}
+	s := "select data from email_changes where id = $1;"
+	row := p.pool.QueryRow(ctx, s, ID)
+	err := row.Scan(&change)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindEmailChange
+		}
+		return nil, err
+	}
+
+	out := change.EmailChange
+	out.Credentials = change.Credentials
+	return out, nil
+}
+
+func (p *pgEmailChange) Create(ctx context.Context, change models.EmailChange) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	c := dbEmailChange{
+		EmailChange: &change,
+		Credentials: change.Credentials,
+	}
+
+	s := "insert into email_changes (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, c)
+	return err
+}
+
+func (p *pgEmailChange) Delete(ctx context.Context, ID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from email_changes where id = $1;"
+	_, err := p.pool.Exec(ctx, s, ID)
+	return err
+}
+
+func (p *pgEmailChange) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from email_changes where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
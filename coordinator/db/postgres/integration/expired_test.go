@@ -0,0 +1,118 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	capepg "github.com/capeprivacy/cape/coordinator/db/postgres"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestDeleteExpired(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.TODO()
+
+	testDB, err := CreateTestDB()
+	gm.Expect(err).To(gm.BeNil())
+	err = testDB.Setup(ctx)
+
+	gm.Expect(err).To(gm.BeNil())
+	defer testDB.Teardown(ctx) // nolint: errcheck
+
+	cape := capepg.New(testDB.Pool)
+
+	user := models.NewUser("Me Me", "me@cape.com", *models.GenerateCredentials())
+	err = cape.Users().Create(ctx, user)
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("removes expired sessions", func(t *testing.T) {
+		expired := models.NewSession(&user)
+		expired.SetToken(base64.New([]byte("expired")), time.Now().Add(-time.Hour))
+		gm.Expect(cape.Session().Create(ctx, expired)).To(gm.BeNil())
+
+		valid := models.NewSession(&user)
+		valid.SetToken(base64.New([]byte("valid")), time.Now().Add(time.Hour))
+		gm.Expect(cape.Session().Create(ctx, valid)).To(gm.BeNil())
+
+		count, err := cape.Session().DeleteExpired(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(count).To(gm.Equal(int64(1)))
+
+		_, err = cape.Session().Get(ctx, valid.ID)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("removes expired recoveries", func(t *testing.T) {
+		expired := models.NewRecovery(user.ID, models.GenerateCredentials())
+		expired.ExpiresAt = time.Now().UTC().Add(-time.Hour)
+		gm.Expect(cape.Recoveries().Create(ctx, expired)).To(gm.BeNil())
+
+		valid := models.NewRecovery(user.ID, models.GenerateCredentials())
+		gm.Expect(cape.Recoveries().Create(ctx, valid)).To(gm.BeNil())
+
+		count, err := cape.Recoveries().DeleteExpired(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(count).To(gm.Equal(int64(1)))
+
+		_, err = cape.Recoveries().Get(ctx, valid.ID)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("removes expired invitations", func(t *testing.T) {
+		expired := models.NewInvitation("expired@cape.com", models.UserRole, nil, user.ID, models.GenerateCredentials())
+		expired.ExpiresAt = time.Now().UTC().Add(-time.Hour)
+		gm.Expect(cape.Invitations().Create(ctx, expired)).To(gm.BeNil())
+
+		valid := models.NewInvitation("valid@cape.com", models.UserRole, nil, user.ID, models.GenerateCredentials())
+		gm.Expect(cape.Invitations().Create(ctx, valid)).To(gm.BeNil())
+
+		count, err := cape.Invitations().DeleteExpired(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(count).To(gm.Equal(int64(1)))
+
+		_, err = cape.Invitations().Get(ctx, valid.ID)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("removes expired tokens but keeps tokens without an expiry", func(t *testing.T) {
+		past := time.Now().UTC().Add(-time.Hour)
+		expired := models.NewToken(user.ID, models.GenerateCredentials())
+		expired.ExpiresAt = &past
+		gm.Expect(cape.Tokens().Create(ctx, expired)).To(gm.BeNil())
+
+		forever := models.NewToken(user.ID, models.GenerateCredentials())
+		gm.Expect(cape.Tokens().Create(ctx, forever)).To(gm.BeNil())
+
+		count, err := cape.Tokens().DeleteExpired(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(count).To(gm.Equal(int64(1)))
+
+		_, err = cape.Tokens().Get(ctx, forever.ID)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("only one caller can hold the advisory lock", func(t *testing.T) {
+		pool, ok := testDB.Pool.(capepg.TxPool)
+		gm.Expect(ok).To(gm.BeTrue())
+
+		var inner bool
+		ran, err := capepg.WithAdvisoryLock(ctx, pool, 1, func(db.Interface) error {
+			var err error
+			inner, err = capepg.WithAdvisoryLock(ctx, pool, 1, func(db.Interface) error {
+				return nil
+			})
+			return err
+		})
+
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(ran).To(gm.BeTrue())
+		gm.Expect(inner).To(gm.BeFalse())
+	})
+}
@@ -0,0 +1,87 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	capepg "github.com/capeprivacy/cape/coordinator/db/postgres"
+)
+
+func TestThrottles(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.TODO()
+
+	testDB, err := CreateTestDB()
+	gm.Expect(err).To(gm.BeNil())
+	err = testDB.Setup(ctx)
+
+	gm.Expect(err).To(gm.BeNil())
+	defer testDB.Teardown(ctx) // nolint: errcheck
+
+	throttles := capepg.New(testDB.Pool).Throttles()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	t.Run("counts failures", func(t *testing.T) {
+		for i := 1; i <= 3; i++ {
+			throttle, err := throttles.RecordFailure(ctx, "counts", now, time.Hour)
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(throttle.Failures).To(gm.Equal(i))
+		}
+	})
+
+	t.Run("starts again once the window has passed", func(t *testing.T) {
+		_, err := throttles.RecordFailure(ctx, "window", now.Add(-2*time.Hour), time.Hour)
+		gm.Expect(err).To(gm.BeNil())
+
+		throttle, err := throttles.RecordFailure(ctx, "window", now, time.Hour)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(throttle.Failures).To(gm.Equal(1))
+	})
+
+	t.Run("lock clears failures", func(t *testing.T) {
+		_, err := throttles.RecordFailure(ctx, "lock", now, time.Hour)
+		gm.Expect(err).To(gm.BeNil())
+
+		until := now.Add(2 * time.Hour)
+		gm.Expect(throttles.Lock(ctx, "lock", until)).To(gm.BeNil())
+
+		throttle, err := throttles.Get(ctx, "lock")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(throttle.Failures).To(gm.Equal(0))
+		gm.Expect(throttle.Locked(now)).To(gm.BeTrue())
+		gm.Expect(throttle.ExpiresAt).To(gm.BeTemporally("==", until))
+	})
+
+	t.Run("refund takes back a failure", func(t *testing.T) {
+		gm.Expect(throttles.Refund(ctx, "counts")).To(gm.BeNil())
+
+		throttle, err := throttles.Get(ctx, "counts")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(throttle.Failures).To(gm.Equal(2))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		gm.Expect(throttles.Delete(ctx, "counts")).To(gm.BeNil())
+
+		_, err := throttles.Get(ctx, "counts")
+		gm.Expect(err).To(gm.Equal(db.ErrCannotFindThrottle))
+	})
+
+	t.Run("removes expired throttles", func(t *testing.T) {
+		_, err := throttles.RecordFailure(ctx, "expired", now.Add(-2*time.Hour), time.Hour)
+		gm.Expect(err).To(gm.BeNil())
+
+		count, err := throttles.DeleteExpired(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(count).To(gm.Equal(int64(1)))
+
+		_, err = throttles.Get(ctx, "lock")
+		gm.Expect(err).To(gm.BeNil())
+	})
+}
@@ -0,0 +1,82 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	capepg "github.com/capeprivacy/cape/coordinator/db/postgres"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestTx(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.TODO()
+
+	testDB, err := CreateTestDB()
+	gm.Expect(err).To(gm.BeNil())
+	err = testDB.Setup(ctx)
+
+	gm.Expect(err).To(gm.BeNil())
+	defer testDB.Teardown(ctx) // nolint: errcheck
+
+	cape := capepg.New(testDB.Pool)
+
+	t.Run("commits when fn succeeds", func(t *testing.T) {
+		project := models.NewProject("Committed", "committed", "")
+		err := cape.Tx(ctx, func(tx db.Interface) error {
+			return tx.Projects().Create(ctx, project)
+		})
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = cape.Projects().Get(ctx, project.Label)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("rolls back when fn fails", func(t *testing.T) {
+		failure := errors.New("failure")
+		project := models.NewProject("Rolled Back", "rolled-back", "")
+		err := cape.Tx(ctx, func(tx db.Interface) error {
+			if err := tx.Projects().Create(ctx, project); err != nil {
+				return err
+			}
+			return failure
+		})
+		gm.Expect(err).To(gm.Equal(failure))
+
+		_, err = cape.Projects().Get(ctx, project.Label)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("nested transactions roll back on their own", func(t *testing.T) {
+		outer := models.NewProject("Outer", "outer", "")
+		inner := models.NewProject("Inner", "inner", "")
+		err := cape.Tx(ctx, func(tx db.Interface) error {
+			if err := tx.Projects().Create(ctx, outer); err != nil {
+				return err
+			}
+
+			err := tx.Tx(ctx, func(tx db.Interface) error {
+				if err := tx.Projects().Create(ctx, inner); err != nil {
+					return err
+				}
+				return errors.New("failure")
+			})
+			gm.Expect(err).ToNot(gm.BeNil())
+
+			return nil
+		})
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = cape.Projects().Get(ctx, outer.Label)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = cape.Projects().Get(ctx, inner.Label)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
@@ -0,0 +1,145 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgInvitation struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.InvitationDB = &pgInvitation{}
+
+type dbInvitation struct {
+	*models.Invitation
+	Credentials *models.Credentials `json:"credentials"`
+}
+
+func (p *pgInvitation) Get(ctx context.Context, ID string) (*models.Invitation, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	invitation := dbInvitation{Invitation: &models.Invitation{}}
+	s := "select data from invitations where id = $1;"
+	row := p.pool.QueryRow(ctx, s, ID)
+	err := row.Scan(&invitation)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindInvitation
+		}
+		return nil, fmt.Errorf("error retrieving invitation: %w", err)
+	}
+
+	out := invitation.Invitation
+	out.Credentials = invitation.Credentials
+	return out, nil
+}
+
+func (p *pgInvitation) Create(ctx context.Context, invitation models.Invitation) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	i := dbInvitation{
+		Invitation:  &invitation,
+		Credentials: invitation.Credentials,
+	}
+
+	s := "insert into invitations (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, i)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return db.ErrDuplicateKey
+		}
+
+		return fmt.Errorf("error creating invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgInvitation) Update(ctx context.Context, invitation models.Invitation) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	i := dbInvitation{
+		Invitation:  &invitation,
+		Credentials: invitation.Credentials,
+	}
+
+	s := "update invitations set data = $1 where id = $2;"
+	tag, err := p.pool.Exec(ctx, s, i, invitation.ID)
+	if err != nil {
+		return fmt.Errorf("error updating invitation: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindInvitation
+	}
+
+	return nil
+}
+
+func (p *pgInvitation) Delete(ctx context.Context, ID string) (db.DeleteStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from invitations where id = $1;"
+	tag, err := p.pool.Exec(ctx, s, ID)
+	if err != nil {
+		return db.DeleteStatusError, fmt.Errorf("error deleting invitation: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.DeleteStatusDoesNotExist, nil
+	}
+
+	return db.DeleteStatusDeleted, nil
+}
+
+func (p *pgInvitation) List(ctx context.Context) ([]models.Invitation, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from invitations order by data->>'created_at';"
+	rows, err := p.pool.Query(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	invitations := make([]models.Invitation, 0)
+	for rows.Next() {
+		var invitation models.Invitation
+		err := rows.Scan(&invitation)
+		if err != nil {
+			return nil, err
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, rows.Err()
+}
+
+func (p *pgInvitation) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from invitations where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
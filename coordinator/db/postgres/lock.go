@@ -0,0 +1,46 @@
+package capepg
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+)
+
+// TxPool is a Pool that is also capable of starting transactions
+type TxPool interface {
+	Pool
+	Begin(context.Context) (pgx.Tx, error)
+}
+
+// WithAdvisoryLock attempts to take the transaction scoped advisory lock
+// identified by key and, if successful, calls fn with a database bound to
+// that transaction. The lock is released when the transaction ends.
+//
+// If the lock is already held (e.g. by another coordinator) fn is not called
+// and false is returned.
+func WithAdvisoryLock(ctx context.Context, pool TxPool, key int64, fn func(db.Interface) error) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx) // nolint: errcheck
+
+	var acquired bool
+	err = tx.QueryRow(ctx, "select pg_try_advisory_xact_lock($1);", key).Scan(&acquired)
+	if err != nil {
+		return false, err
+	}
+
+	if !acquired {
+		return false, nil
+	}
+
+	err = fn(New(tx))
+	if err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit(ctx)
+}
@@ -0,0 +1,74 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgMFA struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.MFADB = &pgMFA{}
+
+func (p *pgMFA) Get(ctx context.Context, userID string) (*models.MFAEnrollment, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	enrollment := &models.MFAEnrollment{}
+	s := "select data from mfa_enrollments where user_id = $1;"
+	row := p.pool.QueryRow(ctx, s, userID)
+	err := row.Scan(enrollment)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindMFAEnrollment
+		}
+		return nil, fmt.Errorf("error retrieving mfa enrollment: %w", err)
+	}
+
+	return enrollment, nil
+}
+
+func (p *pgMFA) Create(ctx context.Context, enrollment models.MFAEnrollment) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into mfa_enrollments (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, enrollment)
+	return err
+}
+
+func (p *pgMFA) Update(ctx context.Context, enrollment models.MFAEnrollment) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	enrollment.UpdatedAt = time.Now().UTC()
+
+	s := "update mfa_enrollments set data = $1 where id = $2;"
+	tag, err := p.pool.Exec(ctx, s, enrollment, enrollment.ID)
+	if err != nil {
+		return fmt.Errorf("error updating mfa enrollment: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindMFAEnrollment
+	}
+
+	return nil
+}
+
+func (p *pgMFA) Delete(ctx context.Context, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from mfa_enrollments where user_id = $1;"
+	_, err := p.pool.Exec(ctx, s, userID)
+	return err
+}
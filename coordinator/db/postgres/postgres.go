@@ -2,6 +2,8 @@ package capepg
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/capeprivacy/cape/coordinator/db"
@@ -24,15 +26,52 @@ func New(pool Pool) *CapePg {
 	}
 }
 
-func (c *CapePg) Roles() db.RoleDB               { return &pgRole{c.pool, c.timeout} }
-func (c *CapePg) Contributors() db.ContributorDB { return &pgContributor{c.pool, c.timeout} }
-func (c *CapePg) Projects() db.ProjectsDB        { return &pgProject{c.pool, c.timeout} }
-func (c *CapePg) Users() db.UserDB               { return &pgUser{c.pool, c.timeout} }
-func (c *CapePg) Config() db.ConfigDB            { return &pgConfig{c.pool, c.timeout} }
-func (c *CapePg) Secrets() db.SecretDB           { return &pgSecret{c.pool, c.timeout} }
-func (c *CapePg) Tokens() db.TokensDB            { return &pgToken{c.pool, c.timeout} }
-func (c *CapePg) Session() db.SessionDB          { return &pgSession{c.pool, c.timeout} }
-func (c *CapePg) Recoveries() db.RecoveryDB      { return &pgRecovery{c.pool, c.timeout} }
+func (c *CapePg) Roles() db.RoleDB                 { return &pgRole{c.pool, c.timeout} }
+func (c *CapePg) Contributors() db.ContributorDB   { return &pgContributor{c.pool, c.timeout} }
+func (c *CapePg) Projects() db.ProjectsDB          { return &pgProject{c.pool, c.timeout} }
+func (c *CapePg) Users() db.UserDB                 { return &pgUser{c.pool, c.timeout} }
+func (c *CapePg) Config() db.ConfigDB              { return &pgConfig{c.pool, c.timeout} }
+func (c *CapePg) Secrets() db.SecretDB             { return &pgSecret{c.pool, c.timeout} }
+func (c *CapePg) Tokens() db.TokensDB              { return &pgToken{c.pool, c.timeout} }
+func (c *CapePg) Session() db.SessionDB            { return &pgSession{c.pool, c.timeout} }
+func (c *CapePg) Recoveries() db.RecoveryDB        { return &pgRecovery{c.pool, c.timeout} }
+func (c *CapePg) EmailChanges() db.EmailChangeDB   { return &pgEmailChange{c.pool, c.timeout} }
+func (c *CapePg) Invitations() db.InvitationDB     { return &pgInvitation{c.pool, c.timeout} }
+func (c *CapePg) SSOAttempts() db.SSOAttemptDB     { return &pgSSOAttempt{c.pool, c.timeout} }
+func (c *CapePg) SSOIdentities() db.SSOIdentityDB  { return &pgSSOIdentity{c.pool, c.timeout} }
+func (c *CapePg) MFA() db.MFADB                    { return &pgMFA{c.pool, c.timeout} }
+func (c *CapePg) RefreshTokens() db.RefreshTokenDB { return &pgRefreshToken{c.pool, c.timeout} }
+func (c *CapePg) Throttles() db.ThrottleDB         { return &pgThrottle{c.pool, c.timeout} }
+func (c *CapePg) Teams() db.TeamDB                 { return &pgTeam{c.pool, c.timeout} }
+func (c *CapePg) Audit() db.AuditDB                { return &pgAudit{c.pool, c.timeout} }
+func (c *CapePg) ProjectKeys() db.ProjectKeyDB     { return &pgProjectKey{c.pool, c.timeout} }
+
+// Tx calls fn with a database bound to a new transaction. When the database
+// is already bound to a transaction fn runs within a savepoint of it.
+func (c *CapePg) Tx(ctx context.Context, fn func(db.Interface) error) error {
+	pool, ok := c.pool.(TxPool)
+	if !ok {
+		return errors.New("database does not support transactions")
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) // nolint: errcheck
+
+	err = fn(&CapePg{pool: tx, timeout: c.timeout})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
 
 type Pool interface {
 	Exec(_ context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
@@ -103,26 +103,7 @@ func (p *pgProject) CreateProjectSpec(ctx context.Context, spec models.Policy, s
 		return err
 	}
 
-	for _, transform := range spec.Transformations {
-		for _, arg := range transform.Args {
-			sec, ok := arg.(models.SecretArg)
-			if ok {
-				_, err := secretDB.Get(ctx, sec.Name)
-				if err != nil {
-					if err == db.ErrCannotFindSecret {
-						err := secretDB.Create(ctx, sec)
-						if err != nil {
-							return err
-						}
-						continue
-					}
-					return err
-				}
-			}
-		}
-	}
-
-	return err
+	return db.CreateSecrets(ctx, secretDB, spec)
 }
 
 func (p *pgProject) GetProjectSpec(ctx context.Context, id string, secretDB db.SecretDB) (*models.Policy, error) {
@@ -140,18 +121,9 @@ func (p *pgProject) GetProjectSpec(ctx context.Context, id string, secretDB db.S
 		return nil, err
 	}
 
-	for _, transform := range spec.Transformations {
-		for i, arg := range transform.Args {
-			sec, ok := arg.(models.SecretArg)
-			if ok {
-				sec2, err := secretDB.Get(ctx, sec.Name)
-				if err != nil {
-					return nil, err
-				}
-
-				transform.Args[i] = sec2
-			}
-		}
+	err = db.ResolveSecrets(ctx, secretDB, &spec)
+	if err != nil {
+		return nil, err
 	}
 
 	return &spec, nil
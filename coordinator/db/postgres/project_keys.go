@@ -0,0 +1,67 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgProjectKey struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.ProjectKeyDB = &pgProjectKey{}
+
+func (p *pgProjectKey) Get(ctx context.Context, projectID string) (*models.ProjectKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	key := &models.ProjectKey{}
+	s := "select data from project_keys where project_id = $1;"
+	row := p.pool.QueryRow(ctx, s, projectID)
+	err := row.Scan(key)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindProjectKey
+		}
+		return nil, fmt.Errorf("error retrieving project key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (p *pgProjectKey) Create(ctx context.Context, key models.ProjectKey) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into project_keys (data) values ($1) on conflict (project_id) do nothing;"
+	_, err := p.pool.Exec(ctx, s, key)
+	if err != nil {
+		return fmt.Errorf("error creating project key: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgProjectKey) Delete(ctx context.Context, projectID string) (db.DeleteStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from project_keys where project_id = $1;"
+	tag, err := p.pool.Exec(ctx, s, projectID)
+	if err != nil {
+		return db.DeleteStatusError, fmt.Errorf("error deleting project key: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.DeleteStatusDoesNotExist, nil
+	}
+
+	return db.DeleteStatusDeleted, nil
+}
@@ -61,3 +61,42 @@ func (p *pgRecovery) Delete(ctx context.Context, ID string) error {
 	_, err := p.pool.Exec(ctx, s, ID)
 	return err
 }
+
+func (p *pgRecovery) List(ctx context.Context) ([]models.Recovery, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from recoveries order by data->>'created_at';"
+	rows, err := p.pool.Query(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	recoveries := make([]models.Recovery, 0)
+	for rows.Next() {
+		var recovery models.Recovery
+		err := rows.Scan(&recovery)
+		if err != nil {
+			return nil, err
+		}
+
+		recoveries = append(recoveries, recovery)
+	}
+
+	return recoveries, rows.Err()
+}
+
+func (p *pgRecovery) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from recoveries where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
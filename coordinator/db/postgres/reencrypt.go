@@ -0,0 +1,316 @@
+package capepg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/manifoldco/go-base64"
+)
+
+// EncryptedTable describes where the values encrypted with the data key are
+// stored within a table's documents
+type EncryptedTable struct {
+	Name string
+
+	// Key is the column, or expression, that uniquely identifies each row
+	Key string
+
+	// Paths are the locations of the encrypted values within each document,
+	// a * segment matches every element of an array
+	Paths [][]string
+
+	// Plaintext lists the top level fields of documents written before the
+	// table was encrypted that are now encrypted together as a JSON object.
+	// It's stored in the top level field named by the first of Paths.
+	Plaintext []string
+}
+
+// EncryptedTables lists every table holding values encrypted by the encrypt
+// package, it must be kept up to date as encrypted values are added
+var EncryptedTables = []EncryptedTable{
+	{Name: "users", Key: "id", Paths: [][]string{{"credentials", "secret"}, {"password_history", "*", "secret"}}},
+	{Name: "tokens", Key: "id", Paths: [][]string{{"credentials", "secret"}}},
+	{Name: "sessions", Key: "id", Paths: [][]string{{"token"}}},
+	{Name: "recoveries", Key: "id", Paths: [][]string{{"credentials", "secret"}}},
+	{Name: "email_changes", Key: "id", Paths: [][]string{{"credentials", "secret"}}},
+	{Name: "invitations", Key: "id", Paths: [][]string{{"credentials", "secret"}}},
+	{Name: "sso_attempts", Key: "id", Paths: [][]string{{"credentials", "secret"}}},
+	{Name: "mfa_enrollments", Key: "id", Paths: [][]string{{"secret"}}},
+	{Name: "project_keys", Key: "id", Paths: [][]string{{"key"}}},
+	{Name: "project_specs", Key: "id", Paths: [][]string{{"encrypted"}}, Plaintext: []string{"transformations", "rules"}},
+
+	// Secrets are encrypted with their project's key, only those created
+	// before projects had keys are encrypted with the data key
+	{Name: "secrets", Key: "coalesce(project_id, '') || '/' || name || '/' || version", Paths: [][]string{{"value"}}},
+}
+
+// ReencryptFunc encrypts a value with the current data key, returning false
+// if it was already encrypted with it
+type ReencryptFunc func(context.Context, *base64.Value) (*base64.Value, bool, error)
+
+// EncryptFunc encrypts a value with the current data key
+type EncryptFunc func(context.Context, *base64.Value) (*base64.Value, error)
+
+// documentFunc rewrites a document, returning false if it was left alone
+type documentFunc func(context.Context, []byte) ([]byte, bool, error)
+
+// Reencrypt re-encrypts every encrypted value in the table, batchSize rows
+// at a time. Each batch is committed on its own and values already
+// encrypted with the current key are left alone so an interrupted
+// re-encryption can be resumed by running it again.
+//
+// The number of rows that were re-encrypted is returned.
+func Reencrypt(ctx context.Context, pool TxPool, table EncryptedTable, batchSize int, fn ReencryptFunc) (int, error) {
+	return rewriteTable(ctx, pool, table, batchSize, func(ctx context.Context, data []byte) ([]byte, bool, error) {
+		return reencryptDocument(ctx, data, table.Paths, fn)
+	})
+}
+
+// EncryptPlaintext encrypts the plaintext fields of documents written before
+// the table was encrypted, batchSize rows at a time. Like Reencrypt it can
+// be resumed by running it again.
+//
+// The number of rows that were encrypted is returned.
+func EncryptPlaintext(ctx context.Context, pool TxPool, table EncryptedTable, batchSize int, fn EncryptFunc) (int, error) {
+	if len(table.Plaintext) == 0 {
+		return 0, nil
+	}
+
+	return rewriteTable(ctx, pool, table, batchSize, func(ctx context.Context, data []byte) ([]byte, bool, error) {
+		return encryptDocument(ctx, data, table.Paths[0][0], table.Plaintext, fn)
+	})
+}
+
+// rewriteTable calls fn with every document in the table, batchSize rows at
+// a time, storing the documents that it changes
+func rewriteTable(ctx context.Context, pool TxPool, table EncryptedTable, batchSize int, fn documentFunc) (int, error) {
+	total := 0
+	after := ""
+	for {
+		n, last, err := rewriteBatch(ctx, pool, table, after, batchSize, fn)
+		if err != nil {
+			return total, err
+		}
+
+		total += n
+		if last == "" {
+			return total, nil
+		}
+
+		after = last
+	}
+}
+
+// rewriteBatch rewrites the rows following after, returning the key of the
+// last row in the batch or an empty string once there are no rows left
+func rewriteBatch(ctx context.Context, pool TxPool, table EncryptedTable, after string, batchSize int, fn documentFunc) (int, string, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	defer tx.Rollback(ctx) // nolint: errcheck
+
+	s := fmt.Sprintf("select %[1]s, data from %[2]s where %[1]s > $1 order by %[1]s limit $2 for update;", table.Key, table.Name)
+	rows, err := tx.Query(ctx, s, after, batchSize)
+	if err != nil {
+		return 0, "", fmt.Errorf("error retrieving %s: %w", table.Name, err)
+	}
+
+	type row struct {
+		key  string
+		data []byte
+	}
+
+	var batch []row
+	for rows.Next() {
+		var r row
+		err := rows.Scan(&r.key, &r.data)
+		if err != nil {
+			rows.Close()
+			return 0, "", fmt.Errorf("error retrieving %s: %w", table.Name, err)
+		}
+
+		batch = append(batch, r)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return 0, "", fmt.Errorf("error retrieving %s: %w", table.Name, err)
+	}
+
+	if len(batch) == 0 {
+		return 0, "", nil
+	}
+
+	n := 0
+	for _, r := range batch {
+		doc, changed, err := fn(ctx, r.data)
+		if err != nil {
+			return 0, "", fmt.Errorf("error re-encrypting %s %s: %w", table.Name, r.key, err)
+		}
+
+		if !changed {
+			continue
+		}
+
+		s := fmt.Sprintf("update %s set data = $1::jsonb where %s = $2;", table.Name, table.Key)
+		_, err = tx.Exec(ctx, s, string(doc), r.key)
+		if err != nil {
+			return 0, "", fmt.Errorf("error updating %s %s: %w", table.Name, r.key, err)
+		}
+
+		n++
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return n, batch[len(batch)-1].key, nil
+}
+
+// reencryptDocument re-encrypts the values found at paths within the JSON
+// document, returning whether any of them changed
+func reencryptDocument(ctx context.Context, data []byte, paths [][]string, fn ReencryptFunc) ([]byte, bool, error) {
+	var doc interface{}
+
+	// Numbers are kept as they were written rather than converted to floats
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	err := dec.Decode(&doc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	changed := false
+	for _, path := range paths {
+		var c bool
+		doc, c, err = reencryptPath(ctx, doc, path, fn)
+		if err != nil {
+			return nil, false, err
+		}
+
+		changed = changed || c
+	}
+
+	if !changed {
+		return data, false, nil
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return b, true, nil
+}
+
+// encryptDocument moves the plaintext fields of the JSON document into a
+// single encrypted JSON object stored in the given field, returning whether
+// the document had any plaintext fields
+func encryptDocument(ctx context.Context, data []byte, field string, plaintext []string, fn EncryptFunc) ([]byte, bool, error) {
+	var doc map[string]json.RawMessage
+	err := json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	contents := map[string]json.RawMessage{}
+	for _, name := range plaintext {
+		v, ok := doc[name]
+		if !ok {
+			continue
+		}
+
+		contents[name] = v
+		delete(doc, name)
+	}
+
+	if len(contents) == 0 {
+		return data, false, nil
+	}
+
+	b, err := json.Marshal(contents)
+	if err != nil {
+		return nil, false, err
+	}
+
+	encrypted, err := fn(ctx, base64.New(b))
+	if err != nil {
+		return nil, false, err
+	}
+
+	doc[field], err = json.Marshal(encrypted.String())
+	if err != nil {
+		return nil, false, err
+	}
+
+	b, err = json.Marshal(doc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return b, true, nil
+}
+
+func reencryptPath(ctx context.Context, v interface{}, path []string, fn ReencryptFunc) (interface{}, bool, error) {
+	if len(path) == 0 {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return v, false, nil
+		}
+
+		value, err := base64.NewFromString(s)
+		if err != nil {
+			return nil, false, err
+		}
+
+		encrypted, changed, err := fn(ctx, value)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return encrypted.String(), changed, nil
+	}
+
+	if path[0] == "*" {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return v, false, nil
+		}
+
+		changed := false
+		for i, elem := range arr {
+			e, c, err := reencryptPath(ctx, elem, path[1:], fn)
+			if err != nil {
+				return nil, false, err
+			}
+
+			arr[i] = e
+			changed = changed || c
+		}
+
+		return arr, changed, nil
+	}
+
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return v, false, nil
+	}
+
+	child, ok := obj[path[0]]
+	if !ok {
+		return v, false, nil
+	}
+
+	child, changed, err := reencryptPath(ctx, child, path[1:], fn)
+	if err != nil {
+		return nil, false, err
+	}
+
+	obj[path[0]] = child
+	return obj, changed, nil
+}
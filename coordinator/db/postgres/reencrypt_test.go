@@ -0,0 +1,117 @@
+package capepg
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+// rewrap stands in for re-encryption by prefixing values that haven't been
+// rewrapped yet
+func rewrap(ctx context.Context, v *base64.Value) (*base64.Value, bool, error) {
+	if len(*v) > 0 && (*v)[0] == '!' {
+		return v, false, nil
+	}
+
+	return base64.New(append([]byte("!"), *v...)), true, nil
+}
+
+func TestReencryptDocument(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	secret := base64.New([]byte("secret")).String()
+	rewrapped := base64.New([]byte("!secret")).String()
+
+	users := EncryptedTables[0]
+	doc := []byte(`{"id":"user","version":12345678901234567,"credentials":{"secret":"` + secret + `","alg":"sha256"},` +
+		`"password_history":[{"secret":"` + secret + `"},{"secret":"` + secret + `"}]}`)
+
+	t.Run("Re-encrypts every path", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		out, changed, err := reencryptDocument(ctx, doc, users.Paths, rewrap)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeTrue())
+
+		var user struct {
+			Version     json.Number `json:"version"`
+			Credentials struct {
+				Secret string `json:"secret"`
+				Alg    string `json:"alg"`
+			} `json:"credentials"`
+			PasswordHistory []struct {
+				Secret string `json:"secret"`
+			} `json:"password_history"`
+		}
+
+		err = json.Unmarshal(out, &user)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(user.Version.String()).To(gm.Equal("12345678901234567"))
+		gm.Expect(user.Credentials.Secret).To(gm.Equal(rewrapped))
+		gm.Expect(user.Credentials.Alg).To(gm.Equal("sha256"))
+		gm.Expect(user.PasswordHistory[0].Secret).To(gm.Equal(rewrapped))
+		gm.Expect(user.PasswordHistory[1].Secret).To(gm.Equal(rewrapped))
+
+		_, changed, err = reencryptDocument(ctx, out, users.Paths, rewrap)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeFalse())
+	})
+
+	t.Run("Ignores missing values", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		_, changed, err := reencryptDocument(ctx, []byte(`{"id":"user"}`), users.Paths, rewrap)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeFalse())
+	})
+}
+
+func TestEncryptDocument(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	// seal stands in for encryption by prefixing the value
+	seal := func(ctx context.Context, v *base64.Value) (*base64.Value, error) {
+		return base64.New(append([]byte("!"), *v...)), nil
+	}
+
+	var specs EncryptedTable
+	for _, table := range EncryptedTables {
+		if table.Name == "project_specs" {
+			specs = table
+		}
+	}
+
+	t.Run("Encrypts plaintext fields", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		doc := []byte(`{"id":"spec","version":12345678901234567,"transformations":[{"name":"t"}],"rules":[{"match":{"name":"f"}}]}`)
+		out, changed, err := encryptDocument(ctx, doc, specs.Paths[0][0], specs.Plaintext, seal)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeTrue())
+
+		var spec map[string]json.RawMessage
+		err = json.Unmarshal(out, &spec)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(spec).ToNot(gm.HaveKey("transformations"))
+		gm.Expect(spec).ToNot(gm.HaveKey("rules"))
+		gm.Expect(string(spec["version"])).To(gm.Equal("12345678901234567"))
+
+		var encrypted string
+		err = json.Unmarshal(spec["encrypted"], &encrypted)
+		gm.Expect(err).To(gm.BeNil())
+
+		value, err := base64.NewFromString(encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(string(*value)).To(gm.Equal(`!{"rules":[{"match":{"name":"f"}}],"transformations":[{"name":"t"}]}`))
+
+		_, changed, err = encryptDocument(ctx, out, specs.Paths[0][0], specs.Plaintext, seal)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeFalse())
+	})
+}
@@ -0,0 +1,82 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgRefreshToken struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.RefreshTokenDB = &pgRefreshToken{}
+
+func (p *pgRefreshToken) GetByHash(ctx context.Context, hash string) (*models.RefreshToken, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	token := &models.RefreshToken{}
+	s := "select data from refresh_tokens where data->>'hash' = $1;"
+	row := p.pool.QueryRow(ctx, s, hash)
+	err := row.Scan(token)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindRefreshToken
+		}
+		return nil, fmt.Errorf("error retrieving refresh token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (p *pgRefreshToken) Create(ctx context.Context, token models.RefreshToken) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into refresh_tokens (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, token)
+	return err
+}
+
+func (p *pgRefreshToken) MarkUsed(ctx context.Context, id string, at time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `update refresh_tokens set data = jsonb_set(data, '{used_at}', to_jsonb($1::timestamptz))
+		where id = $2 and data->>'used_at' is null;`
+	tag, err := p.pool.Exec(ctx, s, at, id)
+	if err != nil {
+		return false, fmt.Errorf("error marking refresh token used: %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (p *pgRefreshToken) DeleteByFamilyID(ctx context.Context, familyID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from refresh_tokens where data->>'family_id' = $1;"
+	_, err := p.pool.Exec(ctx, s, familyID)
+	return err
+}
+
+func (p *pgRefreshToken) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from refresh_tokens where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
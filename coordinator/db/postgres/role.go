@@ -2,7 +2,6 @@ package capepg
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -75,12 +74,120 @@ func (r *pgRole) GetByID(ctx context.Context, ID string) (*models.Role, error) {
 	return role, nil
 }
 
-// List is not yet implemented
-func (r *pgRole) List(context.Context, *db.ListRoleOptions) ([]*models.Role, error) {
-	return nil, errors.New("not implemented")
+// List returns every role ordered by label
+func (r *pgRole) List(ctx context.Context, opts *db.ListRoleOptions) ([]*models.Role, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	query := sq.Select("data").
+		PlaceholderFormat(sq.Dollar).
+		From("roles").
+		OrderBy("data->>'label'")
+
+	if opts != nil {
+		if opts.Limit > 0 {
+			query = query.Limit(opts.Limit)
+		}
+		query = query.Offset(opts.Offset)
+	}
+
+	s, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, s, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*models.Role
+	for rows.Next() {
+		role := &models.Role{}
+		err = rows.Scan(role)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+func (r *pgRole) Create(ctx context.Context, role *models.Role) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s := "insert into roles (data) values ($1);"
+	_, err := r.pool.Exec(ctx, s, role)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return db.ErrDuplicateKey
+		}
+
+		return fmt.Errorf("error creating role: %w", err)
+	}
+
+	return nil
+}
+
+// Update replaces a custom role, system roles are never updated
+func (r *pgRole) Update(ctx context.Context, role *models.Role) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s := `update roles set data = $1
+		where id = $2 and not coalesce((data->>'system')::boolean, false);`
+	tag, err := r.pool.Exec(ctx, s, role, role.ID)
+	if err != nil {
+		return fmt.Errorf("error updating role: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindRole
+	}
+
+	return nil
 }
 
-// GetAll returns all of the roles (global & project) that a user belongs to
+// Delete removes a custom role that isn't assigned to anyone. Assignments
+// cascade when a role is deleted so a role in use would otherwise leave its
+// users without a role.
+func (r *pgRole) Delete(ctx context.Context, label models.Label) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s := `delete from roles
+		where data->>'label' = $1 and
+		not coalesce((data->>'system')::boolean, false) and
+		not exists (select 1 from assignments where assignments.role_id = roles.id) and
+		not exists (select 1 from team_projects where team_projects.role_id = roles.id);`
+	tag, err := r.pool.Exec(ctx, s, label)
+	if err != nil {
+		return fmt.Errorf("error deleting role: %w", err)
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	role, err := r.Get(ctx, label)
+	if err != nil {
+		return err
+	}
+
+	if role.System {
+		return db.ErrCannotFindRole
+	}
+
+	return db.ErrRoleInUse
+}
+
+// GetAll returns all of the roles (global & project) that a user belongs to.
+// A user's role in a project is the strongest of their own role and the
+// roles of any teams they belong to that contribute to the project.
 func (r *pgRole) GetAll(ctx context.Context, userID string) (*models.UserRoles, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
@@ -124,10 +231,42 @@ func (r *pgRole) GetAll(ctx context.Context, userID string) (*models.UserRoles,
 			return nil, err
 		}
 
-		userRoles.Projects[models.Label(resp.Project)] = resp.Role
+		userRoles.Projects.Grant(models.Label(resp.Project), resp.Role)
 	}
 
-	return &userRoles, nil
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	s = `select projects.data->>'label' as project_label, roles.data as role
+		from
+			roles, team_projects, team_members, projects
+		where
+			team_members.user_id = $1 and
+			team_projects.team_id = team_members.team_id and
+			projects.id = team_projects.project_id and
+			roles.id = team_projects.role_id;`
+
+	teamRows, err := r.pool.Query(ctx, s, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer teamRows.Close()
+	for teamRows.Next() {
+		var project string
+		var role models.Role
+
+		err = teamRows.Scan(&project, &role)
+		if err != nil {
+			return nil, err
+		}
+
+		userRoles.Projects.Grant(models.Label(project), role)
+	}
+
+	return &userRoles, teamRows.Err()
 }
 
 type assignmentIDs struct {
@@ -228,6 +367,26 @@ func (r *pgRole) SetProjectRole(ctx context.Context, email models.Email, project
 	return &assignment, nil
 }
 
+func (r *pgRole) DeleteProjectRole(ctx context.Context, email models.Email, project models.Label) error {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	s := `delete from assignments
+		using users, projects
+		where
+			assignments.data->>'user_id' = users.data->>'id' and
+			assignments.data->>'project_id' = projects.data->>'id' and
+			users.data->>'email' = $1 and
+			projects.data->>'label' = $2;`
+
+	_, err := r.pool.Exec(ctx, s, email, project)
+	if err != nil {
+		return fmt.Errorf("error deleting project role: %w", err)
+	}
+
+	return nil
+}
+
 func (r *pgRole) GetOrgRole(ctx context.Context, email models.Email) (*models.Role, error) {
 	s := `select roles.data from roles, assignments, users
 		where roles.data->>'id' = assignments.data->>'role_id' and
@@ -274,6 +433,7 @@ func (r *pgRole) CreateSystemRoles(ctx context.Context) error {
 			Version:   1,
 			Label:     r,
 			System:    true,
+			Kind:      models.SystemRoleKind(r),
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
 		}
@@ -19,14 +19,14 @@ type pgSecret struct {
 
 var _ db.SecretDB = &pgSecret{}
 
-func (p *pgSecret) Create(ctx context.Context, secret models.SecretArg) error {
+func (p *pgSecret) Create(ctx context.Context, projectID string, secret models.SecretArg) error {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
 
 	s, args, err := sq.Insert("secrets").
 		PlaceholderFormat(sq.Dollar).
-		Columns("data").
-		Values(secret).
+		Columns("project_id", "data").
+		Values(projectID, secret).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("error building secret create query: %w", err)
@@ -40,16 +40,16 @@ func (p *pgSecret) Create(ctx context.Context, secret models.SecretArg) error {
 	return nil
 }
 
-func (p *pgSecret) Delete(ctx context.Context, e string) (db.DeleteStatus, error) {
+func (p *pgSecret) Delete(ctx context.Context, projectID string, name string) (db.DeleteStatus, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
 
 	s, args, err := sq.Delete("secrets").
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{"data->>'email'": e}).
+		Where(sq.Eq{"project_id": projectID, "name": name}).
 		ToSql()
 	if err != nil {
-		return db.DeleteStatusError, fmt.Errorf("error building secret update query: %w", err)
+		return db.DeleteStatusError, fmt.Errorf("error building secret delete query: %w", err)
 	}
 
 	tag, err := p.pool.Exec(ctx, s, args...)
@@ -63,14 +63,20 @@ func (p *pgSecret) Delete(ctx context.Context, e string) (db.DeleteStatus, error
 	return db.DeleteStatusDeleted, nil
 }
 
-func (p *pgSecret) Get(ctx context.Context, name string) (*models.SecretArg, error) {
+// Get returns the version of the project's secret with the given name.
+// Secrets created before secrets belonged to projects are found by name
+// alone.
+func (p *pgSecret) Get(ctx context.Context, projectID string, name string, version int) (*models.SecretArg, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
 
 	s, args, err := sq.Select("data").
 		PlaceholderFormat(sq.Dollar).
 		From("secrets").
-		Where(sq.Eq{"data->>'name'": name}).
+		Where(sq.Eq{"name": name, "version": version}).
+		Where(sq.Or{sq.Eq{"project_id": projectID}, sq.Eq{"project_id": nil}}).
+		OrderBy("project_id nulls last").
+		Limit(1).
 		ToSql()
 
 	if err != nil {
@@ -89,3 +95,86 @@ func (p *pgSecret) Get(ctx context.Context, name string) (*models.SecretArg, err
 
 	return secret, nil
 }
+
+func (p *pgSecret) List(ctx context.Context, projectID string) ([]models.SecretArg, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s, args, err := sq.Select("data - 'value'").
+		PlaceholderFormat(sq.Dollar).
+		From("secrets").
+		Where(sq.Eq{"project_id": projectID}).
+		OrderBy("name", "version").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building secret list query: %w", err)
+	}
+
+	rows, err := p.pool.Query(ctx, s, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing secrets: %w", err)
+	}
+	defer rows.Close()
+
+	var secrets []models.SecretArg
+	for rows.Next() {
+		var secret models.SecretArg
+		err := rows.Scan(&secret)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning secret: %w", err)
+		}
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, rows.Err()
+}
+
+// CreateReference records the reference, a spec referring to the same
+// version of a secret more than once is only recorded the first time
+func (p *pgSecret) CreateReference(ctx context.Context, ref models.SecretReference) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into secret_references (data) values ($1) on conflict (spec_id, name, version) do nothing;"
+	_, err := p.pool.Exec(ctx, s, ref)
+	if err != nil {
+		return fmt.Errorf("error creating secret reference: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgSecret) ListReferences(ctx context.Context, projectID string, name string) ([]models.SecretReference, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s, args, err := sq.Select("data").
+		PlaceholderFormat(sq.Dollar).
+		From("secret_references").
+		Where(sq.Eq{"project_id": projectID, "name": name}).
+		OrderBy("(data->>'created_at')::timestamptz").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building secret reference list query: %w", err)
+	}
+
+	rows, err := p.pool.Query(ctx, s, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing secret references: %w", err)
+	}
+	defer rows.Close()
+
+	var refs []models.SecretReference
+	for rows.Next() {
+		var ref models.SecretReference
+		err := rows.Scan(&ref)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning secret reference: %w", err)
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs, rows.Err()
+}
@@ -2,9 +2,12 @@ package capepg
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
 	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/models"
-	"time"
 )
 
 type pgSession struct {
@@ -23,6 +26,9 @@ func (p *pgSession) Get(ctx context.Context, ID string) (*models.Session, error)
 	row := p.pool.QueryRow(ctx, s, ID)
 	err := row.Scan(&session)
 	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindSession
+		}
 		return nil, err
 	}
 
@@ -46,3 +52,62 @@ func (p *pgSession) Delete(ctx context.Context, ID string) error {
 	_, err := p.pool.Exec(ctx, s, ID)
 	return err
 }
+
+func (p *pgSession) DeleteByUserID(ctx context.Context, userID string, keepID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from sessions where user_id = $1 and id != $2;"
+	_, err := p.pool.Exec(ctx, s, userID, keepID)
+	return err
+}
+
+func (p *pgSession) DeleteByFamilyID(ctx context.Context, familyID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from sessions where id = $1 or data->>'family_id' = $1;"
+	_, err := p.pool.Exec(ctx, s, familyID)
+	return err
+}
+
+func (p *pgSession) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from sessions where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
+
+func (p *pgSession) ListByUserID(ctx context.Context, userID string) ([]models.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `select data from sessions
+		where user_id = $1 and (data->>'expires_at')::timestamptz > now()
+		order by data->>'created_at';`
+	rows, err := p.pool.Query(ctx, s, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	sessions := make([]models.Session, 0)
+	for rows.Next() {
+		var session models.Session
+		err := rows.Scan(&session)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
@@ -0,0 +1,109 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgSSOAttempt struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.SSOAttemptDB = &pgSSOAttempt{}
+
+type dbSSOAttempt struct {
+	*models.SSOAttempt
+	Credentials *models.Credentials `json:"credentials"`
+}
+
+func (p *pgSSOAttempt) Get(ctx context.Context, ID string) (*models.SSOAttempt, error) {
+	return p.get(ctx, "select data from sso_attempts where id = $1;", ID)
+}
+
+func (p *pgSSOAttempt) GetByState(ctx context.Context, state string) (*models.SSOAttempt, error) {
+	return p.get(ctx, "select data from sso_attempts where data->>'state' = $1;", state)
+}
+
+func (p *pgSSOAttempt) get(ctx context.Context, s string, arg string) (*models.SSOAttempt, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	attempt := dbSSOAttempt{SSOAttempt: &models.SSOAttempt{}}
+	row := p.pool.QueryRow(ctx, s, arg)
+	err := row.Scan(&attempt)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindSSOAttempt
+		}
+		return nil, fmt.Errorf("error retrieving sso attempt: %w", err)
+	}
+
+	out := attempt.SSOAttempt
+	out.Credentials = attempt.Credentials
+	return out, nil
+}
+
+func (p *pgSSOAttempt) Create(ctx context.Context, attempt models.SSOAttempt) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	a := dbSSOAttempt{
+		SSOAttempt:  &attempt,
+		Credentials: attempt.Credentials,
+	}
+
+	s := "insert into sso_attempts (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, a)
+	return err
+}
+
+func (p *pgSSOAttempt) Update(ctx context.Context, attempt models.SSOAttempt) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	a := dbSSOAttempt{
+		SSOAttempt:  &attempt,
+		Credentials: attempt.Credentials,
+	}
+
+	s := "update sso_attempts set data = $1 where id = $2;"
+	tag, err := p.pool.Exec(ctx, s, a, attempt.ID)
+	if err != nil {
+		return fmt.Errorf("error updating sso attempt: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindSSOAttempt
+	}
+
+	return nil
+}
+
+func (p *pgSSOAttempt) Delete(ctx context.Context, ID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from sso_attempts where id = $1;"
+	_, err := p.pool.Exec(ctx, s, ID)
+	return err
+}
+
+func (p *pgSSOAttempt) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from sso_attempts where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
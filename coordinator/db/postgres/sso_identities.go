@@ -0,0 +1,81 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgSSOIdentity struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.SSOIdentityDB = &pgSSOIdentity{}
+
+func (p *pgSSOIdentity) Get(ctx context.Context, issuer string, subject string) (*models.SSOIdentity, error) {
+	s := "select data from sso_identities where issuer = $1 and subject = $2;"
+	return p.get(ctx, s, issuer, subject)
+}
+
+func (p *pgSSOIdentity) GetByUser(ctx context.Context, userID string, issuer string) (*models.SSOIdentity, error) {
+	s := "select data from sso_identities where user_id = $1 and issuer = $2;"
+	return p.get(ctx, s, userID, issuer)
+}
+
+func (p *pgSSOIdentity) get(ctx context.Context, s string, args ...interface{}) (*models.SSOIdentity, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	identity := &models.SSOIdentity{}
+	row := p.pool.QueryRow(ctx, s, args...)
+	err := row.Scan(identity)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindSSOIdentity
+		}
+		return nil, fmt.Errorf("error retrieving sso identity: %w", err)
+	}
+
+	return identity, nil
+}
+
+func (p *pgSSOIdentity) Create(ctx context.Context, identity models.SSOIdentity) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into sso_identities (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, identity)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return db.ErrDuplicateKey
+		}
+
+		return fmt.Errorf("error creating sso identity: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgSSOIdentity) Update(ctx context.Context, identity models.SSOIdentity) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "update sso_identities set data = $1 where id = $2;"
+	tag, err := p.pool.Exec(ctx, s, identity, identity.ID)
+	if err != nil {
+		return fmt.Errorf("error updating sso identity: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindSSOIdentity
+	}
+
+	return nil
+}
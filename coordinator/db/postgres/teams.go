@@ -0,0 +1,245 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgTeam struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.TeamDB = &pgTeam{}
+
+func (p *pgTeam) Create(ctx context.Context, team models.Team) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "insert into teams (data) values ($1);"
+	_, err := p.pool.Exec(ctx, s, team)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return db.ErrDuplicateKey
+		}
+
+		return fmt.Errorf("error creating team: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgTeam) Get(ctx context.Context, label models.Label) (*models.Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	team := &models.Team{}
+	s := "select data from teams where data->>'label' = $1;"
+	err := p.pool.QueryRow(ctx, s, label).Scan(team)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindTeam
+		}
+		return nil, fmt.Errorf("error retrieving team: %w", err)
+	}
+
+	return team, nil
+}
+
+func (p *pgTeam) List(ctx context.Context) ([]models.Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from teams order by data->>'label';"
+	return p.queryTeams(ctx, s)
+}
+
+func (p *pgTeam) ListForUser(ctx context.Context, userID string) ([]models.Team, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `select teams.data from teams, team_members
+		where teams.id = team_members.team_id and team_members.user_id = $1
+		order by teams.data->>'label';`
+	return p.queryTeams(ctx, s, userID)
+}
+
+func (p *pgTeam) queryTeams(ctx context.Context, s string, args ...interface{}) ([]models.Team, error) {
+	rows, err := p.pool.Query(ctx, s, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		var team models.Team
+		err := rows.Scan(&team)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving team: %w", err)
+		}
+
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
+
+func (p *pgTeam) Delete(ctx context.Context, label models.Label) (db.DeleteStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from teams where data->>'label' = $1;"
+	tag, err := p.pool.Exec(ctx, s, label)
+	if err != nil {
+		return db.DeleteStatusError, fmt.Errorf("error deleting team: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.DeleteStatusDoesNotExist, nil
+	}
+
+	return db.DeleteStatusDeleted, nil
+}
+
+func (p *pgTeam) AddMember(ctx context.Context, member models.TeamMember) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `insert into team_members (data) values ($1)
+		on conflict on constraint team_member
+		do update set data = team_members.data || jsonb_build_object(
+			'maintainer', $2::boolean,
+			'updated_at', $3::timestamptz);`
+	_, err := p.pool.Exec(ctx, s, member, member.Maintainer, member.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error adding team member: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgTeam) GetMember(ctx context.Context, teamID string, userID string) (*models.TeamMember, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	member := &models.TeamMember{}
+	s := "select data from team_members where team_id = $1 and user_id = $2;"
+	err := p.pool.QueryRow(ctx, s, teamID, userID).Scan(member)
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindTeamMember
+		}
+		return nil, fmt.Errorf("error retrieving team member: %w", err)
+	}
+
+	return member, nil
+}
+
+func (p *pgTeam) RemoveMember(ctx context.Context, teamID string, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from team_members where team_id = $1 and user_id = $2;"
+	tag, err := p.pool.Exec(ctx, s, teamID, userID)
+	if err != nil {
+		return fmt.Errorf("error removing team member: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindTeamMember
+	}
+
+	return nil
+}
+
+func (p *pgTeam) Members(ctx context.Context, teamID string) ([]models.TeamMember, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from team_members where team_id = $1 order by data->>'created_at';"
+	rows, err := p.pool.Query(ctx, s, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving team members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []models.TeamMember
+	for rows.Next() {
+		var member models.TeamMember
+		err := rows.Scan(&member)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving team member: %w", err)
+		}
+
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+func (p *pgTeam) SetProjectRole(ctx context.Context, tp models.TeamProject) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `insert into team_projects (data) values ($1)
+		on conflict on constraint team_project
+		do update set data = team_projects.data || jsonb_build_object(
+			'role_id', $2::text,
+			'updated_at', $3::timestamptz), role_id = $2;`
+	_, err := p.pool.Exec(ctx, s, tp, tp.RoleID, tp.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error setting team project role: %w", err)
+	}
+
+	return nil
+}
+
+func (p *pgTeam) RemoveProject(ctx context.Context, teamID string, projectID string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from team_projects where team_id = $1 and project_id = $2;"
+	tag, err := p.pool.Exec(ctx, s, teamID, projectID)
+	if err != nil {
+		return fmt.Errorf("error removing team from project: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return db.ErrCannotFindTeamProject
+	}
+
+	return nil
+}
+
+func (p *pgTeam) Projects(ctx context.Context, teamID string) ([]models.TeamProject, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select data from team_projects where team_id = $1 order by data->>'created_at';"
+	rows, err := p.pool.Query(ctx, s, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving team projects: %w", err)
+	}
+	defer rows.Close()
+
+	var projects []models.TeamProject
+	for rows.Next() {
+		var tp models.TeamProject
+		err := rows.Scan(&tp)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving team project: %w", err)
+		}
+
+		projects = append(projects, tp)
+	}
+
+	return projects, rows.Err()
+}
@@ -0,0 +1,122 @@
+package capepg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type pgThrottle struct {
+	pool    Pool
+	timeout time.Duration
+}
+
+var _ db.ThrottleDB = &pgThrottle{}
+
+const throttleColumns = "key, failures, last_failure_at, blocked_until, locked_until, expires_at"
+
+func scanThrottle(row pgx.Row) (*models.Throttle, error) {
+	throttle := &models.Throttle{}
+	err := row.Scan(&throttle.Key, &throttle.Failures, &throttle.LastFailureAt,
+		&throttle.BlockedUntil, &throttle.LockedUntil, &throttle.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return throttle, nil
+}
+
+func (p *pgThrottle) Get(ctx context.Context, key string) (*models.Throttle, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "select " + throttleColumns + " from throttles where key = $1;"
+	throttle, err := scanThrottle(p.pool.QueryRow(ctx, s, key))
+	if err != nil {
+		if err.Error() == pgx.ErrNoRows.Error() {
+			return nil, db.ErrCannotFindThrottle
+		}
+		return nil, fmt.Errorf("error retrieving throttle: %w", err)
+	}
+
+	return throttle, nil
+}
+
+func (p *pgThrottle) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (*models.Throttle, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	// The failures start again from one if the last failure has been
+	// forgotten, otherwise the count is incremented in place so concurrent
+	// failures on different coordinators are all counted
+	s := `insert into throttles (key, failures, last_failure_at, expires_at)
+		values ($1, 1, $2, $3)
+		on conflict (key) do update set
+			failures = case when throttles.last_failure_at < $4 then 1 else throttles.failures + 1 end,
+			last_failure_at = excluded.last_failure_at,
+			expires_at = greatest(excluded.expires_at, throttles.blocked_until, throttles.locked_until)
+		returning ` + throttleColumns + ";"
+
+	throttle, err := scanThrottle(p.pool.QueryRow(ctx, s, key, at, at.Add(window), at.Add(-window)))
+	if err != nil {
+		return nil, fmt.Errorf("error recording failure: %w", err)
+	}
+
+	return throttle, nil
+}
+
+func (p *pgThrottle) Block(ctx context.Context, key string, until time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `update throttles set blocked_until = $2, expires_at = greatest(expires_at, $2)
+		where key = $1;`
+	_, err := p.pool.Exec(ctx, s, key, until)
+	return err
+}
+
+func (p *pgThrottle) Refund(ctx context.Context, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "update throttles set failures = greatest(failures - 1, 0) where key = $1;"
+	_, err := p.pool.Exec(ctx, s, key)
+	return err
+}
+
+func (p *pgThrottle) Lock(ctx context.Context, key string, until time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := `update throttles set failures = 0, blocked_until = null, locked_until = $2,
+		expires_at = greatest(expires_at, $2) where key = $1;`
+	_, err := p.pool.Exec(ctx, s, key, until)
+	return err
+}
+
+func (p *pgThrottle) Delete(ctx context.Context, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from throttles where key = $1;"
+	_, err := p.pool.Exec(ctx, s, key)
+	return err
+}
+
+func (p *pgThrottle) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from throttles where expires_at < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
@@ -28,7 +28,7 @@ func (p pgToken) Get(ctx context.Context, ID string) (*models.Token, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
 
-	var token dbToken
+	token := dbToken{Token: &models.Token{}}
 	s := "select data from tokens where id = $1;"
 	row := p.pool.QueryRow(ctx, s, ID)
 	err := row.Scan(&token)
@@ -36,11 +36,9 @@ func (p pgToken) Get(ctx context.Context, ID string) (*models.Token, error) {
 		return nil, err
 	}
 
-	return &models.Token{
-		ID:          token.ID,
-		UserID:      token.UserID,
-		Credentials: token.Credentials,
-	}, nil
+	out := token.Token
+	out.Credentials = token.Credentials
+	return out, nil
 }
 
 func (p pgToken) Create(ctx context.Context, token models.Token) error {
@@ -91,3 +89,32 @@ func (p pgToken) ListByUserID(ctx context.Context, UserID string) ([]models.Toke
 
 	return tokens, nil
 }
+
+func (p pgToken) UpdateLastUsed(ctx context.Context, ID string, at time.Time, ip string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	// Only the usage fields are replaced so the stored credentials don't
+	// need to be read and re-encrypted
+	usage := struct {
+		LastUsedAt time.Time `json:"last_used_at"`
+		LastUsedIP string    `json:"last_used_ip"`
+	}{at, ip}
+
+	s := "update tokens set data = data || $1::jsonb where id = $2;"
+	_, err := p.pool.Exec(ctx, s, usage, ID)
+	return err
+}
+
+func (p pgToken) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	s := "delete from tokens where (data->>'expires_at')::timestamptz < now();"
+	ct, err := p.pool.Exec(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return ct.RowsAffected(), nil
+}
@@ -2,10 +2,12 @@ package capepg
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 
 	"github.com/capeprivacy/cape/coordinator/db"
@@ -52,6 +54,12 @@ func (p *pgUser) Update(ctx context.Context, id string, user models.User) error
 
 	_, err = p.pool.Exec(ctx, s, args...)
 	if err != nil {
+		// 23505 is the postgres error code for a unique constraint violation
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return db.ErrDuplicateKey
+		}
+
 		return fmt.Errorf("error updating user: %w", err)
 	}
 	return nil
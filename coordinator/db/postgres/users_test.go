@@ -63,6 +63,12 @@ func TestUsersUpdate(t *testing.T) {
 			wantErr: fmt.Errorf("error updating user: %w", ErrGenericDBError),
 			err:     ErrGenericDBError,
 		},
+		{
+			user:    models.User{},
+			id:      "idididid",
+			wantErr: db.ErrDuplicateKey,
+			err:     &pgconn.PgError{Code: "23505"},
+		},
 	}
 
 	pool := &testPgPool{}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/models"
+)
+
+// CreateSecrets creates any of the secret arguments of the spec's
+// transformations that don't exist in its project yet and records that the
+// spec references them
+func CreateSecrets(ctx context.Context, secretDB SecretDB, spec models.Policy) error {
+	for _, sec := range spec.SecretArgs() {
+		sec.Version = sec.SecretVersion()
+
+		_, err := secretDB.Get(ctx, spec.ProjectID, sec.Name, sec.Version)
+		if err == ErrCannotFindSecret {
+			err = secretDB.Create(ctx, spec.ProjectID, sec)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		ref := models.NewSecretReference(spec.ProjectID, spec.ID, sec.Name, sec.Version)
+		err = secretDB.CreateReference(ctx, ref)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveSecrets replaces the secret arguments of the spec's transformations
+// with the versions of the secrets they refer to, including their values
+func ResolveSecrets(ctx context.Context, secretDB SecretDB, spec *models.Policy) error {
+	for _, transform := range spec.Transformations {
+		for i, arg := range transform.Args {
+			sec, ok := arg.(models.SecretArg)
+			if !ok {
+				continue
+			}
+
+			stored, err := secretDB.Get(ctx, spec.ProjectID, sec.Name, sec.SecretVersion())
+			if err != nil {
+				return err
+			}
+
+			transform.Args[i] = *stored
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,341 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/manifoldco/go-base64"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/coordinator/db/encrypt"
+	capepg "github.com/capeprivacy/cape/coordinator/db/postgres"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// DefaultReencryptBatchSize is the number of rows re-encrypted in each
+// transaction if no batch size is given
+const DefaultReencryptBatchSize = 100
+
+// RotateKeysOptions configures a key rotation
+type RotateKeysOptions struct {
+	// NewRootKey is the url of the root key to wrap the data and signing
+	// keys with, the root key is kept if it's empty
+	NewRootKey string
+
+	// Rewrap asks the root key's KMS to re-wrap the keys with the latest
+	// version of the root key, such as after rotating a Vault transit key.
+	// The keys are never decrypted outside of the KMS.
+	Rewrap bool
+
+	// Reencrypt generates a new data key and re-encrypts every row with it,
+	// encrypting any rows written before their table was encrypted. If a
+	// previous re-encryption didn't finish it's resumed instead.
+	Reencrypt bool
+	BatchSize int
+
+	// Progress is called once each table has been re-encrypted
+	Progress func(table string, rows int)
+}
+
+// RotateKeysResult describes what was rotated
+type RotateKeysResult struct {
+	RootKeyRotated bool
+	Rewrapped      bool
+
+	// DataKeyID is the id of the data key rows are now encrypted with,
+	// Resumed is true if an unfinished re-encryption was continued
+	DataKeyID string
+	Resumed   bool
+
+	// Reencrypted is the number of rows re-encrypted in each table
+	Reencrypted map[string]int
+}
+
+// RotateKeys re-wraps the keys stored in the database config of the
+// coordinator described by cfg with a new root key and, optionally,
+// re-encrypts every row with a fresh data key.
+//
+// Coordinators only load their keys when they start so they should be
+// stopped while keys are rotated. Once the root key has been rotated they
+// must be started with the new root key.
+func RotateKeys(ctx context.Context, cfg *Config, opts RotateKeysOptions) (*RotateKeysResult, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.NewRootKey == "" && !opts.Rewrap && !opts.Reencrypt {
+		return nil, errors.New(InvalidArgumentCause, "Either a new root key, re-wrapping or re-encryption must be requested")
+	}
+
+	if opts.NewRootKey != "" && opts.Rewrap {
+		return nil, errors.New(InvalidArgumentCause, "Keys are already wrapped with the latest version of a new root key")
+	}
+
+	if opts.BatchSize < 0 {
+		return nil, errors.New(InvalidArgumentCause, "Batch size cannot be negative")
+	}
+
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultReencryptBatchSize
+	}
+
+	rootURL, err := crypto.NewKeyURL(cfg.RootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rootKMS, err := crypto.LoadKMS(rootURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var rewrapper crypto.Rewrapper
+	if opts.Rewrap {
+		r, ok := rootKMS.(crypto.Rewrapper)
+		if !ok {
+			return nil, errors.New(InvalidArgumentCause, "The %s root key does not support re-wrapping", rootURL.Type())
+		}
+
+		rewrapper = r
+	}
+
+	newRootURL, newRootKMS := rootURL, rootKMS
+	if opts.NewRootKey != "" {
+		newRootURL, err = crypto.NewKeyURL(opts.NewRootKey)
+		if err != nil {
+			return nil, err
+		}
+
+		newRootKMS, err = crypto.LoadKMS(newRootURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pool := mustPgxPool(cfg.DB.Addr.ToURL().String(), cfg.InstanceID.String())
+	defer pool.Close()
+
+	res := &RotateKeysResult{
+		RootKeyRotated: opts.NewRootKey != "",
+		Rewrapped:      opts.Rewrap,
+		Reencrypted:    map[string]int{},
+	}
+
+	// The config is locked while its keys are replaced so no concurrent
+	// change to it, such as a signing key rotation, is lost
+	capedb := capepg.New(pool)
+	var config *models.Config
+	err = capedb.Tx(ctx, func(tx db.Interface) error {
+		var err error
+		config, err = tx.Config().GetForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.Resumed = opts.Reencrypt && config.Reencrypting()
+		if opts.Reencrypt && !config.Reencrypting() {
+			keyURL, err := newDataKey()
+			if err != nil {
+				return err
+			}
+
+			wrapped, err := rootKMS.Encrypt(ctx, []byte(keyURL.String()))
+			if err != nil {
+				return err
+			}
+
+			config.RotateEncryptionKey(models.NewID(), base64.New(wrapped))
+		}
+
+		if opts.NewRootKey != "" {
+			err = rewrapConfig(ctx, rootKMS, newRootKMS, config)
+			if err != nil {
+				return err
+			}
+		}
+
+		if rewrapper != nil {
+			err = rewrapConfigInPlace(ctx, rewrapper, config)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The new data key and re-wrapped keys are stored together so the
+		// config is never left with keys wrapped by different root keys
+		return tx.Config().Update(ctx, *config)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.DataKeyID = config.EncryptionKeyID
+	if !opts.Reencrypt {
+		return res, nil
+	}
+
+	keyring, err := dataKeyring(ctx, newRootKMS, config)
+	if err != nil {
+		return nil, err
+	}
+
+	reencrypt := func(ctx context.Context, v *base64.Value) (*base64.Value, bool, error) {
+		// Secrets encrypted with a project's key are left alone, the project
+		// keys are re-encrypted instead
+		if encrypt.IsProjectKeyVersion(crypto.KeyVersion(v)) {
+			return v, false, nil
+		}
+
+		return keyring.Reencrypt(ctx, v)
+	}
+
+	for _, table := range capepg.EncryptedTables {
+		// Rows written before the table was encrypted are encrypted with
+		// the new key along with the rest
+		encrypted, err := capepg.EncryptPlaintext(ctx, pool, table, opts.BatchSize, keyring.Encrypt)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := capepg.Reencrypt(ctx, pool, table, opts.BatchSize, reencrypt)
+		if err != nil {
+			return nil, err
+		}
+
+		n += encrypted
+		res.Reencrypted[table.Name] = n
+		if opts.Progress != nil {
+			opts.Progress(table.Name, n)
+		}
+	}
+
+	// The config is read again as it may have changed while rows were
+	// being re-encrypted
+	err = capedb.Tx(ctx, func(tx db.Interface) error {
+		config, err := tx.Config().GetForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+
+		config.RetireEncryptionKeys()
+		return tx.Config().Update(ctx, *config)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// newDataKey returns the url of a new random data key. It's generated no
+// matter what kind of root key is used, the root key only wraps it, so
+// values are encrypted by the coordinator rather than sent to a key
+// management service.
+func newDataKey() (*crypto.KeyURL, error) {
+	return crypto.NewBase64KeyURL(nil)
+}
+
+// dataKeyring returns a keyring holding the current data key along with any
+// previous keys that rows may still be encrypted with
+func dataKeyring(ctx context.Context, rootKMS crypto.KMS, cfg *models.Config) (*crypto.Keyring, error) {
+	codecs := map[string]crypto.EncryptionCodec{}
+
+	codec, err := dataCodec(ctx, rootKMS, cfg.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	codecs[cfg.EncryptionKeyID] = codec
+	for _, key := range cfg.PreviousEncryptionKeys {
+		codec, err := dataCodec(ctx, rootKMS, key.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		codecs[key.ID] = codec
+	}
+
+	return crypto.NewKeyring(cfg.EncryptionKeyID, codecs)
+}
+
+func dataCodec(ctx context.Context, rootKMS crypto.KMS, wrapped *base64.Value) (crypto.EncryptionCodec, error) {
+	key, err := rootKMS.Decrypt(ctx, *wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	keyURL, err := crypto.NewKeyURL(string(key))
+	if err != nil {
+		return nil, err
+	}
+
+	kms, err := crypto.LoadKMS(keyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.NewSecretBoxCodec(kms), nil
+}
+
+// rewrapConfig decrypts every key in the config that's wrapped by the root
+// key and encrypts it again with the new root key
+func rewrapConfig(ctx context.Context, from crypto.KMS, to crypto.KMS, cfg *models.Config) error {
+	return rewrapKeys(cfg, func(wrapped *base64.Value) (*base64.Value, error) {
+		key, err := from.Decrypt(ctx, *wrapped)
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted, err := to.Encrypt(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return base64.New(encrypted), nil
+	})
+}
+
+// rewrapConfigInPlace has the root key's KMS re-wrap every key in the config
+// with the latest version of the root key
+func rewrapConfigInPlace(ctx context.Context, r crypto.Rewrapper, cfg *models.Config) error {
+	return rewrapKeys(cfg, func(wrapped *base64.Value) (*base64.Value, error) {
+		rewrapped, err := r.Rewrap(ctx, *wrapped)
+		if err != nil {
+			return nil, err
+		}
+
+		return base64.New(rewrapped), nil
+	})
+}
+
+// rewrapKeys replaces every key in the config that's wrapped by the root key
+// with the result of rewrap
+func rewrapKeys(cfg *models.Config, rewrap func(*base64.Value) (*base64.Value, error)) error {
+	var err error
+	cfg.EncryptionKey, err = rewrap(cfg.EncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	for i, key := range cfg.PreviousEncryptionKeys {
+		cfg.PreviousEncryptionKeys[i].Key, err = rewrap(key.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	cfg.AuthKeypair, err = rewrap(cfg.AuthKeypair)
+	if err != nil {
+		return err
+	}
+
+	for i, key := range cfg.RetiredAuthKeys {
+		cfg.RetiredAuthKeys[i].Keypair, err = rewrap(key.Keypair)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
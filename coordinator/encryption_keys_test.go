@@ -0,0 +1,139 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	"github.com/capeprivacy/cape/models"
+)
+
+// rewrappingKMS counts the keys re-wrapped by a local KMS
+type rewrappingKMS struct {
+	crypto.KMS
+	rewrapped int
+}
+
+func (r *rewrappingKMS) Rewrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	key, err := r.Decrypt(ctx, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rewrapped++
+	return r.Encrypt(ctx, key)
+}
+
+func TestEncryptionKeys(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	rootURL, err := crypto.NewBase64KeyURL(nil)
+	gm.Expect(err).To(gm.BeNil())
+
+	rootKMS, err := crypto.LoadKMS(rootURL)
+	gm.Expect(err).To(gm.BeNil())
+
+	config, _, err := createDatabaseConfig(rootURL.String())
+	gm.Expect(err).To(gm.BeNil())
+
+	keyring, err := dataKeyring(ctx, rootKMS, config)
+	gm.Expect(err).To(gm.BeNil())
+
+	data := base64.New([]byte("super secret data"))
+	encrypted, err := keyring.Encrypt(ctx, data)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(crypto.KeyVersion(encrypted)).To(gm.Equal(config.EncryptionKeyID))
+
+	t.Run("Previous data keys can still decrypt", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		keyURL, err := newDataKey()
+		gm.Expect(err).To(gm.BeNil())
+
+		wrapped, err := rootKMS.Encrypt(ctx, []byte(keyURL.String()))
+		gm.Expect(err).To(gm.BeNil())
+
+		config.RotateEncryptionKey(models.NewID(), base64.New(wrapped))
+
+		keyring, err := dataKeyring(ctx, rootKMS, config)
+		gm.Expect(err).To(gm.BeNil())
+
+		decrypted, err := keyring.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted).To(gm.Equal(data))
+
+		reencrypted, changed, err := keyring.Reencrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(changed).To(gm.BeTrue())
+		gm.Expect(crypto.KeyVersion(reencrypted)).To(gm.Equal(config.EncryptionKeyID))
+	})
+
+	t.Run("Data keys are generated whatever the root key", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		config, keyURL, err := createDatabaseConfig(rootURL.String())
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(keyURL.Type()).To(gm.Equal(crypto.Base64Key))
+		gm.Expect(keyURL.String()).ToNot(gm.Equal(rootURL.String()))
+
+		unwrapped, err := rootKMS.Decrypt(ctx, *config.EncryptionKey)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(string(unwrapped)).To(gm.Equal(keyURL.String()))
+	})
+
+	t.Run("Keys can be re-wrapped with a new root key", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		newRootURL, err := crypto.NewBase64KeyURL(nil)
+		gm.Expect(err).To(gm.BeNil())
+
+		newRootKMS, err := crypto.LoadKMS(newRootURL)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = rewrapConfig(ctx, rootKMS, newRootKMS, config)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = dataKeyring(ctx, rootKMS, config)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		keyring, err := dataKeyring(ctx, newRootKMS, config)
+		gm.Expect(err).To(gm.BeNil())
+
+		decrypted, err := keyring.Decrypt(ctx, encrypted)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decrypted).To(gm.Equal(data))
+
+		_, err = decryptKeypair(ctx, newRootKMS, config.AuthKeypair)
+		gm.Expect(err).To(gm.BeNil())
+	})
+	t.Run("Keys can be re-wrapped in place", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		rootURL, err := crypto.NewBase64KeyURL(nil)
+		gm.Expect(err).To(gm.BeNil())
+
+		rootKMS, err := crypto.LoadKMS(rootURL)
+		gm.Expect(err).To(gm.BeNil())
+
+		config, _, err := createDatabaseConfig(rootURL.String())
+		gm.Expect(err).To(gm.BeNil())
+
+		before := config.EncryptionKey.String()
+
+		r := &rewrappingKMS{KMS: rootKMS}
+		err = rewrapConfigInPlace(ctx, r, config)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(r.rewrapped).To(gm.Equal(2))
+		gm.Expect(config.EncryptionKey.String()).ToNot(gm.Equal(before))
+
+		_, err = dataKeyring(ctx, rootKMS, config)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = decryptKeypair(ctx, rootKMS, config.AuthKeypair)
+		gm.Expect(err).To(gm.BeNil())
+	})
+}
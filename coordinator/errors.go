@@ -13,6 +13,22 @@ import (
 var (
 	InvalidConfigCause   = errors.NewCause(errors.BadRequestCategory, "invalid_config")
 	InvalidArgumentCause = errors.NewCause(errors.BadRequestCategory, "invalid_argument")
+
+	SSOFailedCause  = errors.NewCause(errors.UnauthorizedCategory, "sso_failed")
+	SSOPendingCause = errors.NewCause(errors.BadRequestCategory, "sso_pending")
+	SSOExpiredCause = errors.NewCause(errors.BadRequestCategory, "sso_expired")
+
+	ErrSSOFailed = errors.New(SSOFailedCause, "Failed to sign in with the identity provider")
+
+	// MFARequiredCause is returned from login when the user has enrolled in
+	// multi-factor authentication but didn't provide a one-time code
+	MFARequiredCause = errors.NewCause(errors.UnauthorizedCategory, "mfa_required")
+
+	ErrMFARequired = errors.New(MFARequiredCause, "A one-time code is required to login")
+
+	// NoRefreshTokenCause happens when refreshing a session that wasn't
+	// issued with a refresh token
+	NoRefreshTokenCause = errors.NewCause(errors.BadRequestCategory, "no_refresh_token")
 )
 
 func errorPresenter(ctx context.Context, e error) *gqlerror.Error {
@@ -0,0 +1,5 @@
+package graph
+
+// defaultAuditChainLimit is the most events returned by a single request for
+// the audit chain, exports page through the chain by sequence
+const defaultAuditChainLimit = 1000
@@ -0,0 +1,101 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	"github.com/capeprivacy/cape/models"
+)
+
+func (r *queryResolver) AuditEvents(ctx context.Context, filter *model.AuditEventFilter, limit *int, offset *int) ([]*models.AuditEvent, error) {
+	opts := &db.ListAuditEventOptions{}
+	if filter != nil {
+		if filter.ActorEmail != nil {
+			opts.ActorEmail = *filter.ActorEmail
+		}
+
+		if filter.Action != nil {
+			opts.Action = *filter.Action
+		}
+
+		if filter.Target != nil {
+			opts.Target = *filter.Target
+		}
+
+		opts.Since = filter.Since
+		opts.Until = filter.Until
+	}
+
+	if limit != nil {
+		opts.Limit = uint64(*limit)
+	}
+
+	if offset != nil {
+		opts.Offset = uint64(*offset)
+	}
+
+	events, err := r.Database.Audit().List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.AuditEvent, len(events))
+	for i := range events {
+		res[i] = &events[i]
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) AuditChain(ctx context.Context, afterSequence *int, limit *int) ([]string, error) {
+	var after int64
+	if afterSequence != nil {
+		after = int64(*afterSequence)
+	}
+
+	max := uint64(defaultAuditChainLimit)
+	if limit != nil && *limit > 0 && *limit < defaultAuditChainLimit {
+		max = uint64(*limit)
+	}
+
+	events, err := r.Database.Audit().Chain(ctx, after, max)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(events))
+	for i, event := range events {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = string(b)
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) AuditCheckpoints(ctx context.Context) ([]string, error) {
+	checkpoints, err := r.Database.Audit().ListCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(checkpoints))
+	for i, checkpoint := range checkpoints {
+		b, err := json.Marshal(checkpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = string(b)
+	}
+
+	return res, nil
+}
@@ -12,6 +12,23 @@ var (
 	// CannotDeleteSystemRole occurs when deletion of a system role is attempted
 	CannotDeleteSystemRole = errors.NewCause(errors.ForbiddenCategory, "cannot_delete_system_role")
 
+	// CannotUpdateSystemRole occurs when changing the permissions of a
+	// system role is attempted
+	CannotUpdateSystemRole = errors.NewCause(errors.ForbiddenCategory, "cannot_update_system_role")
+
+	RoleNotFoundCause = errors.NewCause(errors.NotFoundCategory, "role_not_found")
+	RoleExistsCause   = errors.NewCause(errors.ConflictCategory, "role_exists")
+
+	// RoleInUseCause occurs when deleting a role that's still assigned to
+	// someone
+	RoleInUseCause = errors.NewCause(errors.ConflictCategory, "role_in_use")
+
+	TeamNotFoundCause = errors.NewCause(errors.NotFoundCategory, "team_not_found")
+	TeamExistsCause   = errors.NewCause(errors.ConflictCategory, "team_exists")
+
+	TeamMemberNotFoundCause  = errors.NewCause(errors.NotFoundCategory, "team_member_not_found")
+	TeamProjectNotFoundCause = errors.NewCause(errors.NotFoundCategory, "team_project_not_found")
+
 	// PolicyNotSupplied occurs when a policy has not been supplied for attachPolicy route.
 	// Must either supply policy ID or a policy input object
 	PolicyNotSupplied = errors.NewCause(errors.BadRequestCategory, "policy_not_supplied")
@@ -20,9 +37,43 @@ var (
 
 	NoActiveSpecCause = errors.NewCause(errors.BadRequestCategory, "no_active_spec")
 
+	ProjectKeyNotFoundCause = errors.NewCause(errors.NotFoundCategory, "project_key_not_found")
+
+	SecretNotFoundCause      = errors.NewCause(errors.NotFoundCategory, "secret_not_found")
+	SecretNotReferencedCause = errors.NewCause(errors.BadRequestCategory, "secret_not_referenced")
+
 	RecoveryFailedCause = errors.NewCause(errors.UnauthorizedCategory, "recovery_failed")
 	ErrRecoveryFailed   = errors.New(RecoveryFailedCause, "recovery_failed")
 
+	ChangePasswordFailedCause = errors.NewCause(errors.UnauthorizedCategory, "change_password_failed")
+	ErrChangePasswordFailed   = errors.New(ChangePasswordFailedCause, "change_password_failed")
+
+	// PasswordReusedCause happens when choosing a password that was used
+	// recently and the password policy forbids reusing it
+	PasswordReusedCause = errors.NewCause(errors.BadRequestCategory, "password_reused")
+
+	EmailChangeFailedCause = errors.NewCause(errors.UnauthorizedCategory, "email_change_failed")
+	ErrEmailChangeFailed   = errors.New(EmailChangeFailedCause, "email_change_failed")
+
+	EmailInUseCause = errors.NewCause(errors.BadRequestCategory, "email_in_use")
+
+	InvalidInvitationCause  = errors.NewCause(errors.BadRequestCategory, "invalid_invitation")
+	InvitationExistsCause   = errors.NewCause(errors.ConflictCategory, "invitation_exists")
+	InvitationNotFoundCause = errors.NewCause(errors.NotFoundCategory, "invitation_not_found")
+
+	AcceptInvitationFailedCause = errors.NewCause(errors.UnauthorizedCategory, "accept_invitation_failed")
+	ErrAcceptInvitationFailed   = errors.New(AcceptInvitationFailedCause, "accept_invitation_failed")
+
+	SessionNotFoundCause = errors.NewCause(errors.NotFoundCategory, "session_not_found")
+
+	MFANotEnrolledCause     = errors.NewCause(errors.NotFoundCategory, "mfa_not_enrolled")
+	MFAAlreadyEnrolledCause = errors.NewCause(errors.ConflictCategory, "mfa_already_enrolled")
+
+	InvalidMFACodeCause = errors.NewCause(errors.UnauthorizedCategory, "invalid_mfa_code")
+	ErrInvalidMFACode   = errors.New(InvalidMFACodeCause, "invalid_mfa_code")
+
+	InvalidTokenCause = errors.NewCause(errors.BadRequestCategory, "invalid_token")
+
 	DuplicateKeyCause = errors.NewCause(errors.BadRequestCategory, "duplicate_key")
 
 	ErrDuplicateKey = errors.New(DuplicateKeyCause, "duplicate_key")
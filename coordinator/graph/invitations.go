@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+// canInvite checks that the caller could grant the invited user the roles
+// the invitation gives them, otherwise inviting someone would be a way to
+// hand out roles the caller can't assign directly. The user role grants
+// nothing beyond what adding a user does so it needs no permission.
+func canInvite(ctx context.Context, input model.CreateInvitationRequest) error {
+	if input.Role != models.UserRole && !fw.Session(ctx).Roles.Global.Can(models.ChangeRole) {
+		return errs.New(auth.AuthorizationFailure, "invalid permissions: inviting a user as %s requires the %s permission", input.Role, models.ChangeRole)
+	}
+
+	for _, p := range input.Projects {
+		if !projectCan(ctx, p.Label, models.ChangeProjectRole) {
+			return errs.New(auth.AuthorizationFailure, "invalid permissions: inviting a user to project %s requires the %s permission", p.Label, models.ChangeProjectRole)
+		}
+	}
+
+	return nil
+}
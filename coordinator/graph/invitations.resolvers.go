@@ -0,0 +1,234 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/generated"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func (r *invitationResolver) InvitedBy(ctx context.Context, obj *models.Invitation) (*models.User, error) {
+	return r.Database.Users().GetByID(ctx, obj.InvitedBy)
+}
+
+func (r *mutationResolver) CreateInvitation(ctx context.Context, input model.CreateInvitationRequest) (*models.Invitation, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx)
+
+	err := canInvite(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.Database.Users().Get(ctx, input.Email)
+	if err == nil {
+		return nil, errs.New(EmailInUseCause, "Email %s is already in use", input.Email)
+	}
+	if err != db.ErrCannotFindUser {
+		return nil, err
+	}
+
+	projects := make([]models.InvitationProject, len(input.Projects))
+	for i, p := range input.Projects {
+		_, err := r.Database.Projects().Get(ctx, p.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		projects[i] = models.InvitationProject{Label: p.Label, Role: p.Role}
+	}
+
+	secret := models.GeneratePassword()
+	creds, err := r.CredentialProducer.Generate(secret)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not generate credentials")
+		return nil, err
+	}
+
+	invitation := models.NewInvitation(input.Email, input.Role, projects, currSession.User.ID, creds)
+	err = invitation.Validate()
+	if err != nil {
+		return nil, errs.New(InvalidInvitationCause, err.Error())
+	}
+
+	err = r.Database.Invitations().Create(ctx, invitation)
+	if err == db.ErrDuplicateKey {
+		return nil, errs.New(InvitationExistsCause, "An invitation has already been sent to %s", input.Email)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not insert invitation into database")
+		return nil, err
+	}
+
+	err = r.Mailer.SendInvitation(ctx, invitation, secret)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not send invitation")
+		return nil, err
+	}
+
+	logger.Info().Msgf("Invitation created with id %s", invitation.ID)
+	return &invitation, nil
+}
+
+func (r *mutationResolver) ResendInvitation(ctx context.Context, id string) (*models.Invitation, error) {
+	logger := fw.Logger(ctx).With().Str("invitation_id", id).Logger()
+
+	invitation, err := r.Database.Invitations().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new secret is generated so any previously sent secret stops working
+	secret := models.GeneratePassword()
+	creds, err := r.CredentialProducer.Generate(secret)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not generate credentials")
+		return nil, err
+	}
+
+	invitation.Renew(creds)
+	err = r.Database.Invitations().Update(ctx, *invitation)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not update invitation")
+		return nil, err
+	}
+
+	err = r.Mailer.SendInvitation(ctx, *invitation, secret)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not send invitation")
+		return nil, err
+	}
+
+	logger.Info().Msg("Invitation resent")
+	return invitation, nil
+}
+
+func (r *mutationResolver) RevokeInvitation(ctx context.Context, id string) (*string, error) {
+	status, err := r.Database.Invitations().Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == db.DeleteStatusDoesNotExist {
+		return nil, errs.New(InvitationNotFoundCause, "Invitation %s does not exist", id)
+	}
+
+	return nil, nil
+}
+
+func (r *mutationResolver) AcceptInvitation(ctx context.Context, input model.AcceptInvitationRequest) (*string, error) {
+	logger := fw.Logger(ctx).With().Str("invitation_id", input.ID).Logger()
+
+	invitation, err := r.Database.Invitations().Get(ctx, input.ID)
+	if err != nil {
+		logger.Info().Err(err).Msg("Could not retrieve invitation")
+		return nil, ErrAcceptInvitationFailed
+	}
+
+	if invitation.Expired() {
+		logger.Info().Msg("Invitation has expired")
+		return nil, ErrAcceptInvitationFailed
+	}
+
+	err = r.CredentialProducer.Compare(input.Secret, invitation.Credentials)
+	if err != nil {
+		logger.Info().Err(err).Msg("Invalid credentials provided")
+		return nil, ErrAcceptInvitationFailed
+	}
+
+	err = r.PasswordPolicy.Check(input.Password)
+	if err != nil {
+		logger.Info().Err(err).Msg("Password does not satisfy the password policy")
+		return nil, err
+	}
+
+	creds, err := r.CredentialProducer.Generate(input.Password)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not generate credentials")
+		return nil, err
+	}
+
+	// The user only exists once they have every role they were invited
+	// with and the invitation can't be accepted again
+	user := models.NewUser(input.Name, invitation.Email, *creds)
+	logger = logger.With().Str("user_id", user.ID).Logger()
+
+	err = r.Database.Tx(ctx, func(tx db.Interface) error {
+		err := tx.Users().Create(ctx, user)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not create user")
+			return err
+		}
+
+		_, err = tx.Roles().SetOrgRole(ctx, user.Email, invitation.Role)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not set org role")
+			return err
+		}
+
+		for _, p := range invitation.Projects {
+			_, err = tx.Contributors().Add(ctx, p.Label, user.Email)
+			if err != nil {
+				logger.Error().Err(err).Msgf("Could not add user to project %s", p.Label)
+				return err
+			}
+
+			_, err = tx.Roles().SetProjectRole(ctx, user.Email, p.Label, p.Role)
+			if err != nil {
+				logger.Error().Err(err).Msgf("Could not set role in project %s", p.Label)
+				return err
+			}
+		}
+
+		status, err := tx.Invitations().Delete(ctx, invitation.ID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not delete invitation")
+			return err
+		}
+
+		// Another request accepted the invitation first
+		if status == db.DeleteStatusDoesNotExist {
+			logger.Info().Msg("Invitation was already accepted")
+			return ErrAcceptInvitationFailed
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Msg("Invitation accepted")
+	return nil, nil
+}
+
+func (r *queryResolver) Invitations(ctx context.Context) ([]*models.Invitation, error) {
+	invitations, err := r.Database.Invitations().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationPtrs := make([]*models.Invitation, len(invitations))
+	for i, invitation := range invitations {
+		inv := invitation
+		invitationPtrs[i] = &inv
+	}
+
+	return invitationPtrs, nil
+}
+
+// Invitation returns generated.InvitationResolver implementation.
+func (r *Resolver) Invitation() generated.InvitationResolver { return &invitationResolver{r} }
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+type invitationResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
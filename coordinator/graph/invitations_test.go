@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestCreateInvitationRoles(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	// Users() panics so an invitation that isn't rejected fails the test
+	resolver := &Resolver{Database: testDatabase{}}
+	mutationResolver := resolver.Mutation()
+
+	project := models.Label("my-project")
+
+	t.Run("granting an org role requires change-role", func(t *testing.T) {
+		ctx := resolverContext(context.TODO(), &ctxOptions{role: models.UserRole})
+		_, err := mutationResolver.CreateInvitation(ctx, model.CreateInvitationRequest{
+			Email: "invited@cape.com",
+			Role:  models.AdminRole,
+		})
+		gm.Expect(errs.CausedBy(err, auth.AuthorizationFailure)).To(gm.BeTrue())
+	})
+
+	t.Run("granting a project role requires change-project-role in the project", func(t *testing.T) {
+		ctx := resolverContext(context.TODO(), nil)
+		_, err := mutationResolver.CreateInvitation(ctx, model.CreateInvitationRequest{
+			Email:    "invited@cape.com",
+			Role:     models.UserRole,
+			Projects: []*model.InvitationProjectInput{{Label: project, Role: models.ProjectOwnerRole}},
+		})
+		gm.Expect(errs.CausedBy(err, auth.AuthorizationFailure)).To(gm.BeTrue())
+
+		ctx = resolverContext(context.TODO(), nil)
+		fw.Session(ctx).Roles.Projects = models.ProjectRolesMap{
+			project: {Label: models.ProjectContributorRole, System: true},
+		}
+
+		_, err = mutationResolver.CreateInvitation(ctx, model.CreateInvitationRequest{
+			Email:    "invited@cape.com",
+			Role:     models.UserRole,
+			Projects: []*model.InvitationProjectInput{{Label: project, Role: models.ProjectOwnerRole}},
+		})
+		gm.Expect(errs.CausedBy(err, auth.AuthorizationFailure)).To(gm.BeTrue())
+	})
+}
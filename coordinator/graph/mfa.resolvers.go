@@ -0,0 +1,198 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/manifoldco/go-base64"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func (r *mutationResolver) EnrollMfa(ctx context.Context) (*model.EnrollMFAResponse, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx)
+
+	enrollment, err := r.Database.MFA().Get(ctx, currSession.User.ID)
+	if err != nil && err != db.ErrCannotFindMFAEnrollment {
+		return nil, err
+	}
+
+	if enrollment != nil {
+		if enrollment.Confirmed {
+			return nil, errs.New(MFAAlreadyEnrolledCause, "Multi-factor authentication is already enabled")
+		}
+
+		err = r.Database.MFA().Delete(ctx, currSession.User.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totp, err := auth.NewTOTP()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.MFA().Create(ctx, models.NewMFAEnrollment(currSession.User.ID, base64.New(totp.Secret)))
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not create mfa enrollment")
+		return nil, err
+	}
+
+	return &model.EnrollMFAResponse{
+		Secret: totp.EncodedSecret(),
+		URI:    totp.URI("Cape", currSession.User.Email.String()),
+	}, nil
+}
+
+func (r *mutationResolver) ConfirmMfa(ctx context.Context, code string) (*model.ConfirmMFAResponse, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx)
+
+	enrollment, err := r.Database.MFA().Get(ctx, currSession.User.ID)
+	if err == db.ErrCannotFindMFAEnrollment {
+		return nil, errs.New(MFANotEnrolledCause, "You must enroll before confirming multi-factor authentication")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if enrollment.Confirmed {
+		return nil, errs.New(MFAAlreadyEnrolledCause, "Multi-factor authentication is already enabled")
+	}
+
+	if !auth.VerifyMFA(enrollment, code, false) {
+		logger.Info().Msg("Invalid one-time code provided to confirm mfa")
+		return nil, ErrInvalidMFACode
+	}
+
+	codes, err := models.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment.SetRecoveryCodes(codes)
+	enrollment.Confirmed = true
+
+	err = r.Database.MFA().Update(ctx, *enrollment)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not confirm mfa enrollment")
+		return nil, err
+	}
+
+	logger.Info().Msg("MFA enabled")
+	return &model.ConfirmMFAResponse{RecoveryCodes: codes}, nil
+}
+
+func (r *mutationResolver) DisableMfa(ctx context.Context, code string) (*string, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx)
+
+	enrollment, err := r.Database.MFA().Get(ctx, currSession.User.ID)
+	if err == db.ErrCannotFindMFAEnrollment {
+		return nil, errs.New(MFANotEnrolledCause, "Multi-factor authentication is not enabled")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if enrollment.Confirmed && !auth.VerifyMFA(enrollment, code, true) {
+		logger.Info().Msg("Invalid one-time code provided to disable mfa")
+		return nil, ErrInvalidMFACode
+	}
+
+	err = r.Database.MFA().Delete(ctx, currSession.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Msg("MFA disabled")
+	return nil, nil
+}
+
+func (r *mutationResolver) ResetMfa(ctx context.Context, userID string) (*string, error) {
+	logger := fw.Logger(ctx).With().Str("reset_user_id", userID).Logger()
+
+	_, err := r.Database.MFA().Get(ctx, userID)
+	if err == db.ErrCannotFindMFAEnrollment {
+		return nil, errs.New(MFANotEnrolledCause, "User %s has not enabled multi-factor authentication", userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.MFA().Delete(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Msg("MFA reset")
+	return nil, nil
+}
+
+func (r *mutationResolver) SetAdminMFARequired(ctx context.Context, required bool) (*string, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx)
+
+	// Requiring mfa without being enrolled would immediately take away the
+	// caller's own admin permissions
+	if required {
+		enrollment, err := r.Database.MFA().Get(ctx, currSession.User.ID)
+		if err != nil && err != db.ErrCannotFindMFAEnrollment {
+			return nil, err
+		}
+
+		if enrollment == nil || !enrollment.Confirmed {
+			return nil, errs.New(MFANotEnrolledCause, "You must enable multi-factor authentication before requiring it for admins")
+		}
+	}
+
+	err := r.Database.Tx(ctx, func(tx db.Interface) error {
+		cfg, err := tx.Config().GetForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+
+		cfg.RequireAdminMFA = required
+		return tx.Config().Update(ctx, *cfg)
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not update config")
+		return nil, err
+	}
+
+	logger.Info().Bool("required", required).Msg("Admin MFA requirement changed")
+	return nil, nil
+}
+
+func (r *queryResolver) MfaStatus(ctx context.Context) (*model.MFAStatus, error) {
+	currSession := fw.Session(ctx)
+
+	cfg, err := r.Database.Config().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &model.MFAStatus{AdminMfaRequired: cfg.RequireAdminMFA}
+
+	enrollment, err := r.Database.MFA().Get(ctx, currSession.User.ID)
+	if err == db.ErrCannotFindMFAEnrollment {
+		return status, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status.Enrolled = enrollment.Confirmed
+	status.RecoveryCodesRemaining = len(enrollment.RecoveryCodes)
+
+	return status, nil
+}
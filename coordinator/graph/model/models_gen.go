@@ -3,15 +3,55 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
 	"github.com/capeprivacy/cape/models"
 )
 
+type AcceptInvitationRequest struct {
+	ID       string          `json:"id"`
+	Secret   models.Password `json:"secret"`
+	Name     models.Name     `json:"name"`
+	Password models.Password `json:"password"`
+}
+
 type AttemptRecoveryRequest struct {
 	NewPassword models.Password `json:"new_password"`
 	Secret      models.Password `json:"secret"`
 	ID          string          `json:"id"`
 }
 
+type AuditEventFilter struct {
+	ActorEmail *models.Email `json:"actor_email"`
+	Action     *string       `json:"action"`
+	Target     *string       `json:"target"`
+	Since      *time.Time    `json:"since"`
+	Until      *time.Time    `json:"until"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword models.Password `json:"current_password"`
+	NewPassword     models.Password `json:"new_password"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	ID     string          `json:"id"`
+	Secret models.Password `json:"secret"`
+}
+
+type ConfirmMFAResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type CreateInvitationRequest struct {
+	Email    models.Email              `json:"email"`
+	Role     models.Label              `json:"role"`
+	Projects []*InvitationProjectInput `json:"projects"`
+}
+
 type CreateProjectRequest struct {
 	Name        models.ProjectDisplayName `json:"name"`
 	Label       *models.Label             `json:"label"`
@@ -22,8 +62,17 @@ type CreateRecoveryRequest struct {
 	Email models.Email `json:"email"`
 }
 
+type CreateRoleRequest struct {
+	Label       models.Label        `json:"label"`
+	Kind        models.RoleKind     `json:"kind"`
+	Permissions []models.Permission `json:"permissions"`
+}
+
 type CreateTokenRequest struct {
-	UserID string `json:"user_id"`
+	UserID    string              `json:"user_id"`
+	Name      *string             `json:"name"`
+	ExpiresAt *time.Time          `json:"expires_at"`
+	Scope     []models.Permission `json:"scope"`
 }
 
 type CreateTokenResponse struct {
@@ -31,18 +80,24 @@ type CreateTokenResponse struct {
 	Token  *models.Token   `json:"token"`
 }
 
-type CreateUserRequest struct {
-	Name  models.Name  `json:"name"`
-	Email models.Email `json:"email"`
+type DeleteRecoveriesRequest struct {
+	Ids []string `json:"ids"`
 }
 
-type CreateUserResponse struct {
-	Password models.Password `json:"password"`
-	User     *models.User    `json:"user"`
+type EnrollMFAResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
 }
 
-type DeleteRecoveriesRequest struct {
-	Ids []string `json:"ids"`
+type InvitationProjectInput struct {
+	Label models.Label `json:"label"`
+	Role  models.Label `json:"role"`
+}
+
+type MFAStatus struct {
+	Enrolled               bool `json:"enrolled"`
+	RecoveryCodesRemaining int  `json:"recovery_codes_remaining"`
+	AdminMfaRequired       bool `json:"admin_mfa_required"`
 }
 
 type ProjectSpecFile struct {
@@ -50,7 +105,65 @@ type ProjectSpecFile struct {
 	Rules           []*models.Rule                `json:"rules"`
 }
 
+type Secret struct {
+	Name       string                    `json:"name"`
+	Type       string                    `json:"type"`
+	Versions   []int                     `json:"versions"`
+	References []*models.SecretReference `json:"references"`
+}
+
+type UpdateMeRequest struct {
+	Name  *models.Name  `json:"name"`
+	Email *models.Email `json:"email"`
+}
+
 type UpdateProjectRequest struct {
 	Name        *models.ProjectDisplayName `json:"name"`
 	Description *models.ProjectDescription `json:"description"`
 }
+
+type UpdateRoleRequest struct {
+	Label       models.Label        `json:"label"`
+	Permissions []models.Permission `json:"permissions"`
+}
+
+type PermissionScope string
+
+const (
+	PermissionScopeOrg     PermissionScope = "ORG"
+	PermissionScopeProject PermissionScope = "PROJECT"
+)
+
+var AllPermissionScope = []PermissionScope{
+	PermissionScopeOrg,
+	PermissionScopeProject,
+}
+
+func (e PermissionScope) IsValid() bool {
+	switch e {
+	case PermissionScopeOrg, PermissionScopeProject:
+		return true
+	}
+	return false
+}
+
+func (e PermissionScope) String() string {
+	return string(e)
+}
+
+func (e *PermissionScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PermissionScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PermissionScope", str)
+	}
+	return nil
+}
+
+func (e PermissionScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+// setPassword checks the new password against the password policy and the
+// user's previous passwords, then replaces the user's credentials. The old
+// credentials are kept in the user's password history so they can't be
+// reused. The caller is responsible for storing the user.
+func (r *Resolver) setPassword(user *models.User, password models.Password) error {
+	err := r.PasswordPolicy.Check(password)
+	if err != nil {
+		return err
+	}
+
+	if r.PasswordPolicy.History > 0 {
+		previous := append([]models.Credentials{user.Credentials}, user.PasswordHistory...)
+		if len(previous) > r.PasswordPolicy.History {
+			previous = previous[:r.PasswordPolicy.History]
+		}
+
+		for _, creds := range previous {
+			c := creds
+			if r.CredentialProducer.Compare(password, &c) == nil {
+				return errs.New(PasswordReusedCause, "You cannot reuse any of your last %d passwords", r.PasswordPolicy.History)
+			}
+		}
+	}
+
+	creds, err := r.CredentialProducer.Generate(password)
+	if err != nil {
+		return err
+	}
+
+	user.RememberPassword(*creds, r.PasswordPolicy.History)
+	user.UpdatedAt = time.Now().UTC()
+	return nil
+}
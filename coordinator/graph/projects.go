@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/audit"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+// getProject returns the project a field refers to by either its id or its
+// label. Exactly one must be supplied so the project is always the one the
+// @hasPermission directive authorized the caller against.
+func (r *Resolver) getProject(ctx context.Context, id *string, label *models.Label) (*models.Project, error) {
+	hasID := id != nil && *id != ""
+	hasLabel := label != nil && *label != ""
+
+	var project *models.Project
+	var err error
+	switch {
+	case hasID && hasLabel:
+		return nil, errs.New(fw.InvalidParametersCause, "only one of id or label can be supplied")
+	case hasID:
+		project, err = r.Database.Projects().GetByID(ctx, *id)
+	case hasLabel:
+		project, err = r.Database.Projects().Get(ctx, *label)
+	default:
+		return nil, errs.New(fw.InvalidParametersCause, "either id or label must be supplied")
+	}
+
+	if err != nil {
+		return nil, errs.New(fw.InvalidParametersCause, "could not find the requested project")
+	}
+
+	return project, nil
+}
+
+// setArchived archives a project or returns an archived project to the state
+// it was in before, which is active once it has a spec
+func (r *Resolver) setArchived(ctx context.Context, id *string, label *models.Label, archived bool) (*models.Project, error) {
+	project, err := r.getProject(ctx, id, label)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.Target(ctx, project.Label.String())
+	audit.Before(ctx, map[string]string{"status": project.Status.String()})
+
+	switch {
+	case archived:
+		project.Status = models.ProjectArchived
+	case project.Status != models.ProjectArchived:
+		return project, nil
+	case project.CurrentSpecID != "":
+		project.Status = models.ProjectActive
+	default:
+		project.Status = models.ProjectPending
+	}
+
+	err = r.Database.Projects().Update(ctx, *project)
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
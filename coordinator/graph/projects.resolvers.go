@@ -8,7 +8,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/audit"
+	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/graph/generated"
 	"github.com/capeprivacy/cape/coordinator/graph/model"
 	fw "github.com/capeprivacy/cape/framework"
@@ -44,23 +45,21 @@ func (r *mutationResolver) CreateProject(ctx context.Context, project model.Crea
 		label = models.Label(labelStr)
 	}
 
-	if !currSession.Roles.Global.Can(models.CreateProject) {
-		return nil, errs.New(auth.AuthorizationFailure, "invalid permissions to create a project")
-	}
-
 	p := models.NewProject(project.Name, label, project.Description)
-	if err := r.Database.Projects().Create(ctx, p); err != nil {
-		return nil, err
-	}
+	err := r.Database.Tx(ctx, func(tx db.Interface) error {
+		if err := tx.Projects().Create(ctx, p); err != nil {
+			return err
+		}
 
-	// Now make the creator the project owner
-	// TODO -- this should happen in a transaction
-	_, err := r.Database.Contributors().Add(ctx, label, currSession.User.Email)
-	if err != nil {
-		return nil, err
-	}
+		// Now make the creator the project owner
+		_, err := tx.Contributors().Add(ctx, label, currSession.User.Email)
+		if err != nil {
+			return err
+		}
 
-	_, err = r.Database.Roles().SetProjectRole(ctx, currSession.User.Email, label, models.ProjectOwnerRole)
+		_, err = tx.Roles().SetProjectRole(ctx, currSession.User.Email, label, models.ProjectOwnerRole)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -69,20 +68,13 @@ func (r *mutationResolver) CreateProject(ctx context.Context, project model.Crea
 }
 
 func (r *mutationResolver) UpdateProject(ctx context.Context, id *string, label *models.Label, update model.UpdateProjectRequest) (*models.Project, error) {
-	var project *models.Project
-	var err error
-	if id != nil && *id != "" {
-		project, err = r.Database.Projects().GetByID(ctx, *id)
-	} else if label != nil {
-		project, err = r.Database.Projects().Get(ctx, *label)
-	} else {
-		return nil, errs.New(fw.InvalidParametersCause, "either id or label must be supplied to updateProject")
-	}
-
+	project, err := r.getProject(ctx, id, label)
 	if err != nil {
-		return nil, errs.New(fw.InvalidParametersCause, "could not find the requested project")
+		return nil, err
 	}
 
+	audit.Before(ctx, project)
+
 	if update.Name != nil {
 		project.Name = *update.Name
 	}
@@ -96,48 +88,47 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, id *string, label
 }
 
 func (r *mutationResolver) UpdateProjectSpec(ctx context.Context, id *string, label *models.Label, request model.ProjectSpecFile) (*models.Project, error) {
-	var project *models.Project
-	var err error
-	if id != nil && *id != "" {
-		project, err = r.Database.Projects().GetByID(ctx, *id)
-	} else if label != nil {
-		project, err = r.Database.Projects().Get(ctx, *label)
-	} else {
-		return nil, errs.New(fw.InvalidParametersCause, "either id or label must be supplied to updateProject")
+	project, err := r.getProject(ctx, id, label)
+	if err != nil {
+		return nil, err
 	}
 
+	err = canUseSecrets(ctx, project.Label, request.Transformations)
 	if err != nil {
-		return nil, errs.New(fw.InvalidParametersCause, "could not find the requested project")
+		return nil, err
 	}
 
+	audit.Before(ctx, map[string]string{"current_spec_id": project.CurrentSpecID})
+
 	// Insert the spec
 	// TODO -- How do you specify the parent? This concept doesn't make sense until we have proposals & diffing
 	spec := models.NewPolicy(project.ID, nil, request.Rules, request.Transformations)
-	err = r.Database.Projects().CreateProjectSpec(ctx, spec, r.Database.Secrets())
+	err = r.Database.Tx(ctx, func(tx db.Interface) error {
+		err := tx.Projects().CreateProjectSpec(ctx, spec, tx.Secrets())
+		if err != nil {
+			return err
+		}
+
+		// Make this spec active on the project
+		project.CurrentSpecID = spec.ID
+		// A spec makes the project active!
+		project.Status = models.ProjectActive
+		return tx.Projects().Update(ctx, *project)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Make this spec active on the project
-	project.CurrentSpecID = spec.ID
-	// A spec makes the project active!
-	project.Status = models.ProjectActive
-	err = r.Database.Projects().Update(ctx, *project)
-	return project, err
+	return project, nil
 }
 
 func (r *mutationResolver) SuggestProjectPolicy(ctx context.Context, label models.Label, name string, description string, request model.ProjectSpecFile) (*models.Suggestion, error) {
-	session := fw.Session(ctx)
-	role, err := session.Roles.Projects.Get(label)
+	project, err := r.Database.Projects().Get(ctx, label)
 	if err != nil {
 		return nil, err
 	}
 
-	if !role.Can(models.SuggestPolicy) {
-		return nil, fmt.Errorf("you must be a project contributor to suggest policy changes")
-	}
-
-	project, err := r.Database.Projects().Get(ctx, label)
+	err = canUseSecrets(ctx, label, request.Transformations)
 	if err != nil {
 		return nil, err
 	}
@@ -153,11 +144,6 @@ func (r *mutationResolver) SuggestProjectPolicy(ctx context.Context, label model
 		UpdatedAt:       time.Now(),
 	}
 
-	err = r.Database.Projects().CreateProjectSpec(ctx, spec, r.Database.Secrets())
-	if err != nil {
-		return nil, err
-	}
-
 	suggestion := models.Suggestion{
 		ID:          models.NewID(),
 		Title:       name,
@@ -169,7 +155,14 @@ func (r *mutationResolver) SuggestProjectPolicy(ctx context.Context, label model
 		UpdatedAt:   time.Now(),
 	}
 
-	err = r.Database.Projects().CreateSuggestion(ctx, suggestion)
+	err = r.Database.Tx(ctx, func(tx db.Interface) error {
+		err := tx.Projects().CreateProjectSpec(ctx, spec, tx.Secrets())
+		if err != nil {
+			return err
+		}
+
+		return tx.Projects().CreateSuggestion(ctx, suggestion)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -178,16 +171,6 @@ func (r *mutationResolver) SuggestProjectPolicy(ctx context.Context, label model
 }
 
 func (r *mutationResolver) GetProjectSuggestions(ctx context.Context, label models.Label) ([]*models.Suggestion, error) {
-	session := fw.Session(ctx)
-	role, err := session.Roles.Projects.Get(label)
-	if err != nil {
-		return nil, err
-	}
-
-	if !role.Can(models.ListPolicySuggestions) {
-		return nil, fmt.Errorf("you must be a project contributor to suggest policy changes")
-	}
-
 	suggestions, err := r.Database.Projects().GetSuggestions(ctx, label)
 	if err != nil {
 		return nil, err
@@ -203,8 +186,6 @@ func (r *mutationResolver) GetProjectSuggestions(ctx context.Context, label mode
 }
 
 func (r *mutationResolver) ApproveProjectSuggestion(ctx context.Context, id string) (*models.Project, error) {
-	session := fw.Session(ctx)
-
 	suggestion, err := r.Database.Projects().GetSuggestion(ctx, id)
 	if err != nil {
 		return nil, err
@@ -220,26 +201,21 @@ func (r *mutationResolver) ApproveProjectSuggestion(ctx context.Context, id stri
 		return nil, err
 	}
 
-	role, err := session.Roles.Projects.Get(project.Label)
-	if err != nil {
-		return nil, err
-	}
-
-	if !role.Can(models.AcceptPolicy) {
-		return nil, fmt.Errorf("you must be a project contributor to suggest policy changes")
-	}
+	audit.Target(ctx, project.Label.String())
+	audit.Before(ctx, map[string]string{"current_spec_id": project.CurrentSpecID})
 
 	// Make this spec active on the project
 	project.CurrentSpecID = projectPolicy.ID
 	// A spec makes the project active!
 	project.Status = models.ProjectActive
-	err = r.Database.Projects().Update(ctx, *project)
-	if err != nil {
-		return nil, err
-	}
-
 	suggestion.State = models.SuggestionApproved
-	err = r.Database.Projects().UpdateSuggestion(ctx, *suggestion)
+	err = r.Database.Tx(ctx, func(tx db.Interface) error {
+		if err := tx.Projects().Update(ctx, *project); err != nil {
+			return err
+		}
+
+		return tx.Projects().UpdateSuggestion(ctx, *suggestion)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -248,8 +224,6 @@ func (r *mutationResolver) ApproveProjectSuggestion(ctx context.Context, id stri
 }
 
 func (r *mutationResolver) RejectProjectSuggestion(ctx context.Context, id string) (*models.Project, error) {
-	session := fw.Session(ctx)
-
 	suggestion, err := r.Database.Projects().GetSuggestion(ctx, id)
 	if err != nil {
 		return nil, err
@@ -260,15 +234,6 @@ func (r *mutationResolver) RejectProjectSuggestion(ctx context.Context, id strin
 		return nil, err
 	}
 
-	role, err := session.Roles.Projects.Get(project.Label)
-	if err != nil {
-		return nil, err
-	}
-
-	if !role.Can(models.RejectPolicy) {
-		return nil, fmt.Errorf("you must be a project contributor to reject policy changes")
-	}
-
 	suggestion.State = models.SuggestionRejected
 	err = r.Database.Projects().UpdateSuggestion(ctx, *suggestion)
 	if err != nil {
@@ -279,36 +244,33 @@ func (r *mutationResolver) RejectProjectSuggestion(ctx context.Context, id strin
 }
 
 func (r *mutationResolver) GetProjectSuggestion(ctx context.Context, id string) (*models.Suggestion, error) {
-	session := fw.Session(ctx)
+	return r.Database.Projects().GetSuggestion(ctx, id)
+}
 
-	suggestion, err := r.Database.Projects().GetSuggestion(ctx, id)
-	if err != nil {
-		return nil, err
-	}
+func (r *mutationResolver) ArchiveProject(ctx context.Context, id *string, label *models.Label) (*models.Project, error) {
+	return r.setArchived(ctx, id, label, true)
+}
 
-	project, err := r.Database.Projects().GetByID(ctx, suggestion.ProjectID)
+func (r *mutationResolver) UnarchiveProject(ctx context.Context, id *string, label *models.Label) (*models.Project, error) {
+	return r.setArchived(ctx, id, label, false)
+}
+
+func (r *mutationResolver) DestroyProjectKey(ctx context.Context, label models.Label) (*models.Project, error) {
+	project, err := r.Database.Projects().Get(ctx, label)
 	if err != nil {
 		return nil, err
 	}
 
-	role, err := session.Roles.Projects.Get(project.Label)
+	status, err := r.Database.ProjectKeys().Delete(ctx, project.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if !role.Can(models.ListPolicySuggestions) {
-		return nil, fmt.Errorf("you must be a project contributor to reject policy changes")
+	if status == db.DeleteStatusDoesNotExist {
+		return nil, errs.New(ProjectKeyNotFoundCause, "Project %s has no key to destroy", label)
 	}
 
-	return suggestion, nil
-}
-
-func (r *mutationResolver) ArchiveProject(ctx context.Context, id *string, label *models.Label) (*models.Project, error) {
-	panic(fmt.Errorf("not implemented"))
-}
-
-func (r *mutationResolver) UnarchiveProject(ctx context.Context, id *string, label *models.Label) (*models.Project, error) {
-	panic(fmt.Errorf("not implemented"))
+	return project, nil
 }
 
 func (r *mutationResolver) UpdateContributor(ctx context.Context, projectLabel models.Label, userEmail models.Email, roleLabel models.Label) (*models.Contributor, error) {
@@ -332,6 +294,24 @@ func (r *policyResolver) Parent(ctx context.Context, obj *models.Policy) (*model
 	panic(fmt.Errorf("not implemented"))
 }
 
+func (r *policyResolver) Transformations(ctx context.Context, obj *models.Policy) ([]*models.NamedTransformation, error) {
+	if len(obj.SecretArgs()) == 0 {
+		return obj.Transformations, nil
+	}
+
+	project, err := r.Database.Projects().GetByID(ctx, obj.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Secret values are only shown to those allowed to reveal them
+	if projectCan(ctx, project.Label, models.RevealSecrets) {
+		return obj.Transformations, nil
+	}
+
+	return models.RedactSecrets(obj.Transformations), nil
+}
+
 func (r *projectResolver) CurrentSpec(ctx context.Context, obj *models.Project) (*models.Policy, error) {
 	if obj.CurrentSpecID == "" {
 		// If there is no set spec ID, it means the project doesn't yet have a policy
@@ -382,28 +362,7 @@ func (r *queryResolver) Projects(ctx context.Context, status models.ProjectStatu
 }
 
 func (r *queryResolver) Project(ctx context.Context, id *string, label *models.Label) (*models.Project, error) {
-	if id == nil && label == nil {
-		return nil, errs.New(fw.InvalidParametersCause, "Must provide an id or label")
-	}
-
-	var project *models.Project
-	var err error
-
-	if id != nil {
-		project, err = r.Database.Projects().GetByID(ctx, *id)
-	} else {
-		project, err = r.Database.Projects().Get(ctx, *label)
-	}
-
-	if err != nil {
-		if err.Error() == "no rows" {
-			return nil, fmt.Errorf("could not find %s", label)
-		}
-
-		return nil, err
-	}
-
-	return project, nil
+	return r.getProject(ctx, id, label)
 }
 
 func (r *queryResolver) ListContributors(ctx context.Context, projectLabel models.Label) ([]*models.Contributor, error) {
@@ -432,9 +391,6 @@ func (r *suggestionResolver) Policy(ctx context.Context, obj *models.Suggestion)
 // Contributor returns generated.ContributorResolver implementation.
 func (r *Resolver) Contributor() generated.ContributorResolver { return &contributorResolver{r} }
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
-
 // Policy returns generated.PolicyResolver implementation.
 func (r *Resolver) Policy() generated.PolicyResolver { return &policyResolver{r} }
 
@@ -445,7 +401,6 @@ func (r *Resolver) Project() generated.ProjectResolver { return &projectResolver
 func (r *Resolver) Suggestion() generated.SuggestionResolver { return &suggestionResolver{r} }
 
 type contributorResolver struct{ *Resolver }
-type mutationResolver struct{ *Resolver }
 type policyResolver struct{ *Resolver }
 type projectResolver struct{ *Resolver }
 type suggestionResolver struct{ *Resolver }
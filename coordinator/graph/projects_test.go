@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestProjectIDAndLabel(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	// Projects() panics so any lookup of either project fails the test
+	resolver := &Resolver{Database: testDatabase{}}
+	mutationResolver := resolver.Mutation()
+	queryResolver := resolver.Query()
+
+	id := models.NewID()
+	label := models.Label("my-project")
+	name := models.ProjectDisplayName("Not Mine")
+
+	t.Run("update project rejects a mismatched id and label", func(t *testing.T) {
+		ctx := resolverContext(context.TODO(), nil)
+		_, err := mutationResolver.UpdateProject(ctx, &id, &label, model.UpdateProjectRequest{Name: &name})
+		gm.Expect(errs.CausedBy(err, fw.InvalidParametersCause)).To(gm.BeTrue())
+	})
+
+	t.Run("update project spec rejects a mismatched id and label", func(t *testing.T) {
+		ctx := resolverContext(context.TODO(), nil)
+		_, err := mutationResolver.UpdateProjectSpec(ctx, &id, &label, model.ProjectSpecFile{})
+		gm.Expect(errs.CausedBy(err, fw.InvalidParametersCause)).To(gm.BeTrue())
+	})
+
+	t.Run("archive project rejects a mismatched id and label", func(t *testing.T) {
+		ctx := resolverContext(context.TODO(), nil)
+		_, err := mutationResolver.ArchiveProject(ctx, &id, &label)
+		gm.Expect(errs.CausedBy(err, fw.InvalidParametersCause)).To(gm.BeTrue())
+	})
+
+	t.Run("project rejects a mismatched id and label", func(t *testing.T) {
+		ctx := resolverContext(context.TODO(), nil)
+		_, err := queryResolver.Project(ctx, &id, &label)
+		gm.Expect(errs.CausedBy(err, fw.InvalidParametersCause)).To(gm.BeTrue())
+	})
+}
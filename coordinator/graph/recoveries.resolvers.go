@@ -5,8 +5,11 @@ package graph
 
 import (
 	"context"
+
 	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/generated"
 	"github.com/capeprivacy/cape/coordinator/graph/model"
+	"github.com/capeprivacy/cape/coordinator/throttle"
 	fw "github.com/capeprivacy/cape/framework"
 	"github.com/capeprivacy/cape/models"
 )
@@ -14,6 +17,16 @@ import (
 func (r *mutationResolver) CreateRecovery(ctx context.Context, input model.CreateRecoveryRequest) (*string, error) {
 	logger := fw.Logger(ctx)
 
+	// Every request counts as an attempt, whether or not the account exists,
+	// so recoveries can't be used to flood someone's inbox or to find out
+	// which email addresses have accounts
+	keys := []throttle.Key{throttle.IPKey("recovery", fw.RemoteIP(ctx)), throttle.RecoveryKey(input.Email.String())}
+	_, err := r.Limiter.Reserve(ctx, keys...)
+	if err != nil {
+		logger.Info().Err(err).Msg("Recovery request throttled")
+		return nil, err
+	}
+
 	user, err := r.Database.Users().Get(ctx, input.Email)
 	if err != nil {
 		// If the error is not found, we don't propagate it up, we pretend
@@ -65,6 +78,15 @@ func (r *mutationResolver) AttemptRecovery(ctx context.Context, input model.Atte
 
 	logger = logger.With().Str("recovery_id", input.ID).Logger()
 
+	// The attempt is counted as a failure before the secret is compared
+	// and refunded if it turns out to be right
+	keys := []throttle.Key{throttle.IPKey("recovery", fw.RemoteIP(ctx)), throttle.RecoveryKey(input.ID)}
+	reservation, err := r.Limiter.Reserve(ctx, keys...)
+	if err != nil {
+		logger.Info().Err(err).Msg("Recovery attempt throttled")
+		return nil, err
+	}
+
 	recovery, err := r.Database.Recoveries().Get(ctx, input.ID)
 	if err != nil {
 		logger.Error().Err(err).Msg("Could not retrieve recovery")
@@ -84,19 +106,23 @@ func (r *mutationResolver) AttemptRecovery(ctx context.Context, input model.Atte
 		return nil, ErrRecoveryFailed
 	}
 
+	err = reservation.Refund(ctx, keys...)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not refund recovery attempt")
+	}
+
 	user, err := r.Database.Users().GetByID(ctx, recovery.UserID)
 	if err != nil {
 		logger.Error().Err(err).Msgf("Could not get user %s", recovery.UserID)
 		return nil, ErrRecoveryFailed
 	}
 
-	creds, err := r.CredentialProducer.Generate(input.NewPassword)
+	err = r.setPassword(user, input.NewPassword)
 	if err != nil {
-		logger.Error().Err(err).Msg("Could not generate creds for user")
-		return nil, ErrRecoveryFailed
+		logger.Info().Err(err).Msg("Could not set new password for user")
+		return nil, err
 	}
 
-	user.Credentials = *creds
 	err = r.Database.Users().Update(ctx, user.ID, *user)
 	if err != nil {
 		logger.Error().Err(err).Msg("Could not update user with new password")
@@ -112,3 +138,41 @@ func (r *mutationResolver) AttemptRecovery(ctx context.Context, input model.Atte
 	logger.Info().Msg("Successfully recovered account with a new password")
 	return nil, nil
 }
+
+func (r *mutationResolver) DeleteRecoveries(ctx context.Context, input model.DeleteRecoveriesRequest) (*string, error) {
+	logger := fw.Logger(ctx)
+
+	for _, id := range input.Ids {
+		err := r.Database.Recoveries().Delete(ctx, id)
+		if err != nil {
+			logger.Error().Err(err).Msgf("Could not delete recovery %s", id)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *queryResolver) Recoveries(ctx context.Context) ([]*models.Recovery, error) {
+	recoveries, err := r.Database.Recoveries().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryPtrs := make([]*models.Recovery, len(recoveries))
+	for i, recovery := range recoveries {
+		rec := recovery
+		recoveryPtrs[i] = &rec
+	}
+
+	return recoveryPtrs, nil
+}
+
+func (r *recoveryResolver) User(ctx context.Context, obj *models.Recovery) (*models.User, error) {
+	return r.Database.Users().GetByID(ctx, obj.UserID)
+}
+
+// Recovery returns generated.RecoveryResolver implementation.
+func (r *Resolver) Recovery() generated.RecoveryResolver { return &recoveryResolver{r} }
+
+type recoveryResolver struct{ *Resolver }
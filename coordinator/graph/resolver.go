@@ -6,6 +6,8 @@ import (
 	"github.com/capeprivacy/cape/auth"
 	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/mailer"
+	"github.com/capeprivacy/cape/coordinator/throttle"
+	"github.com/capeprivacy/cape/models"
 )
 
 // Resolver is used by graphql to resolve queries/mutations
@@ -13,4 +15,6 @@ type Resolver struct {
 	Database           db.Interface
 	CredentialProducer auth.CredentialProducer
 	Mailer             mailer.Mailer
+	Limiter            *throttle.Limiter
+	PasswordPolicy     models.PasswordPolicy
 }
@@ -0,0 +1,35 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+// getRole returns the role with the given label, wrapping a missing role in
+// an error that can be shown to the caller
+func (r *Resolver) getRole(ctx context.Context, label models.Label) (*models.Role, error) {
+	role, err := r.Database.Roles().Get(ctx, label)
+	if err == db.ErrCannotFindRole {
+		return nil, errs.New(RoleNotFoundCause, "Role %s does not exist", label)
+	}
+
+	return role, err
+}
+
+// assignableRole checks that a system or custom role of the given kind
+// exists before it's assigned to someone, returning the role
+func (r *Resolver) assignableRole(ctx context.Context, label models.Label, kind models.RoleKind) (*models.Role, error) {
+	role, err := r.getRole(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.Kind != kind {
+		return nil, errs.New(models.InvalidRoleCause, "invalid %s role: %s", kind, label)
+	}
+
+	return role, nil
+}
@@ -6,9 +6,12 @@ package graph
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/audit"
+	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/graph/generated"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
 	fw "github.com/capeprivacy/cape/framework"
 	"github.com/capeprivacy/cape/models"
 	errs "github.com/capeprivacy/cape/partyerrors"
@@ -23,34 +26,25 @@ func (r *assignmentResolver) User(ctx context.Context, obj *models.Assignment) (
 }
 
 func (r *mutationResolver) SetOrgRole(ctx context.Context, userEmail models.Email, roleLabel models.Label) (*models.Assignment, error) {
-	currSession := fw.Session(ctx)
-
-	if !models.ValidOrgRole(roleLabel) {
-		return nil, fmt.Errorf("invalid role: %s", roleLabel)
+	_, err := r.assignableRole(ctx, roleLabel, models.OrgRoleKind)
+	if err != nil {
+		return nil, err
 	}
 
-	if !currSession.Roles.Global.Can(models.ChangeRole) {
-		return nil, errs.New(auth.AuthorizationFailure, "invalid permissions to change user role")
+	previous, err := r.Database.Roles().GetOrgRole(ctx, userEmail)
+	if err == nil {
+		audit.Before(ctx, map[string]models.Label{"role": previous.Label})
 	}
 
 	return r.Database.Roles().SetOrgRole(ctx, userEmail, roleLabel)
 }
 
 func (r *mutationResolver) SetProjectRole(ctx context.Context, userEmail models.Email, projectLabel models.Label, roleLabel models.Label) (*models.Assignment, error) {
-	if !models.ValidProjectRole(roleLabel) {
-		return nil, fmt.Errorf("invalid role: %s", roleLabel)
-	}
-
-	s := fw.Session(ctx)
-	role, err := s.Roles.Projects.Get(projectLabel)
+	_, err := r.assignableRole(ctx, roleLabel, models.ProjectRoleKind)
 	if err != nil {
 		return nil, err
 	}
 
-	if !role.Can(models.ChangeProjectRole) {
-		return nil, fmt.Errorf("invalid permissions to change roles in project %s", projectLabel)
-	}
-
 	contributors, err := r.Database.Contributors().List(ctx, projectLabel)
 	if err != nil {
 		return nil, err
@@ -72,9 +66,97 @@ func (r *mutationResolver) SetProjectRole(ctx context.Context, userEmail models.
 		return nil, fmt.Errorf("provided user %s not found in project %s", userEmail, projectLabel)
 	}
 
+	project, err := r.Database.Projects().Get(ctx, projectLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := r.Database.Roles().GetProjectRole(ctx, userEmail, project.ID)
+	if err == nil {
+		audit.Before(ctx, map[string]models.Label{"role": previous.Label})
+	}
+
 	return r.Database.Roles().SetProjectRole(ctx, userEmail, projectLabel, roleLabel)
 }
 
+func (r *mutationResolver) CreateRole(ctx context.Context, input model.CreateRoleRequest) (*models.Role, error) {
+	logger := fw.Logger(ctx)
+
+	role := models.NewCustomRole(input.Label, input.Kind, input.Permissions)
+	err := role.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.Roles().Create(ctx, &role)
+	if err == db.ErrDuplicateKey {
+		return nil, errs.New(RoleExistsCause, "Role %s already exists", input.Label)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("role_label", role.Label.String()).Msg("Role created")
+	return &role, nil
+}
+
+func (r *mutationResolver) UpdateRole(ctx context.Context, input model.UpdateRoleRequest) (*models.Role, error) {
+	logger := fw.Logger(ctx)
+
+	role, err := r.getRole(ctx, input.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.System {
+		return nil, errs.New(CannotUpdateSystemRole, "%s is a system role", input.Label)
+	}
+
+	audit.Before(ctx, role)
+
+	role.Permissions = input.Permissions
+	role.UpdatedAt = time.Now()
+
+	err = role.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.Roles().Update(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("role_label", role.Label.String()).Msg("Role updated")
+	return role, nil
+}
+
+func (r *mutationResolver) DeleteRole(ctx context.Context, label models.Label) (*string, error) {
+	logger := fw.Logger(ctx)
+
+	role, err := r.getRole(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.System {
+		return nil, errs.New(CannotDeleteSystemRole, "%s is a system role", label)
+	}
+
+	audit.Before(ctx, role)
+
+	err = r.Database.Roles().Delete(ctx, label)
+	if err == db.ErrRoleInUse {
+		return nil, errs.New(RoleInUseCause, "Role %s is still assigned, reassign its users before deleting it", label)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("role_label", label.String()).Msg("Role deleted")
+	return nil, nil
+}
+
 func (r *queryResolver) MyRole(ctx context.Context, projectLabel *models.Label) (*models.Role, error) {
 	currSession := fw.Session(ctx)
 
@@ -85,6 +167,14 @@ func (r *queryResolver) MyRole(ctx context.Context, projectLabel *models.Label)
 	return currSession.Roles.Projects.Get(*projectLabel)
 }
 
+func (r *queryResolver) Roles(ctx context.Context) ([]*models.Role, error) {
+	return r.Database.Roles().List(ctx, nil)
+}
+
+func (r *queryResolver) Role(ctx context.Context, label models.Label) (*models.Role, error) {
+	return r.getRole(ctx, label)
+}
+
 // Assignment returns generated.AssignmentResolver implementation.
 func (r *Resolver) Assignment() generated.AssignmentResolver { return &assignmentResolver{r} }
 
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/auth"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+// projectCan reports whether the caller's role in the project grants the
+// permission
+func projectCan(ctx context.Context, label models.Label, perm models.Permission) bool {
+	if !fw.Authenticated(ctx) {
+		return false
+	}
+
+	role, err := fw.Session(ctx).Roles.Projects.Get(label)
+	if err != nil {
+		return false
+	}
+
+	return role.Can(perm)
+}
+
+// canUseSecrets checks that the caller may write transformations that use
+// the project's secrets, transformations without secrets need no permission
+func canUseSecrets(ctx context.Context, label models.Label, transforms []*models.NamedTransformation) error {
+	policy := models.Policy{Transformations: transforms}
+	if len(policy.SecretArgs()) == 0 {
+		return nil
+	}
+
+	if !projectCan(ctx, label, models.UseSecrets) {
+		return errs.New(auth.AuthorizationFailure, "invalid permissions: transformations using secrets require the %s permission", models.UseSecrets)
+	}
+
+	return nil
+}
+
+// secretVersions returns every version of the named secret, oldest first
+func (r *Resolver) secretVersions(ctx context.Context, project *models.Project, name string) ([]models.SecretArg, error) {
+	secrets, err := r.Database.Secrets().List(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []models.SecretArg
+	for _, secret := range secrets {
+		if secret.Name == name {
+			versions = append(versions, secret)
+		}
+	}
+
+	if len(versions) == 0 {
+		return nil, errs.New(SecretNotFoundCause, "Secret %s does not exist in project %s", name, project.Label)
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,158 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/generated"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func (r *mutationResolver) RotateSecret(ctx context.Context, projectLabel models.Label, name string) (*models.Suggestion, error) {
+	project, err := r.Database.Projects().Get(ctx, projectLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := r.secretVersions(ctx, project, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if project.CurrentSpecID == "" {
+		return nil, errs.New(NoActiveSpecCause, "Project %s has no spec to update", projectLabel)
+	}
+
+	current, err := r.Database.Projects().GetProjectSpec(ctx, project.CurrentSpecID, r.Database.Secrets())
+	if err != nil {
+		return nil, err
+	}
+
+	// Creating the spec creates the new version of the secret
+	version := versions[len(versions)-1].Version + 1
+	spec, ok := current.WithSecretVersion(name, version)
+	if !ok {
+		return nil, errs.New(SecretNotReferencedCause, "The current spec of project %s does not use secret %s", projectLabel, name)
+	}
+
+	suggestion := models.Suggestion{
+		ID:          models.NewID(),
+		Title:       fmt.Sprintf("Rotate secret %s", name),
+		Description: fmt.Sprintf("Use version %d of secret %s", version, name),
+		ProjectID:   project.ID,
+		PolicyID:    spec.ID,
+		State:       models.SuggestionPending,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+
+	// The new version of the secret is only kept along with the spec and
+	// suggestion that use it
+	err = r.Database.Tx(ctx, func(tx db.Interface) error {
+		err := tx.Projects().CreateProjectSpec(ctx, *spec, tx.Secrets())
+		if err != nil {
+			return err
+		}
+
+		return tx.Projects().CreateSuggestion(ctx, suggestion)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &suggestion, nil
+}
+
+func (r *queryResolver) ProjectSecrets(ctx context.Context, projectLabel models.Label) ([]*model.Secret, error) {
+	project, err := r.Database.Projects().Get(ctx, projectLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := r.Database.Secrets().List(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Versions are listed in order so the last version seen is the latest
+	var secrets []*model.Secret
+	byName := map[string]*model.Secret{}
+	for _, s := range stored {
+		secret, ok := byName[s.Name]
+		if !ok {
+			secret = &model.Secret{Name: s.Name}
+			byName[s.Name] = secret
+			secrets = append(secrets, secret)
+		}
+
+		secret.Type = s.Type
+		secret.Versions = append(secret.Versions, s.Version)
+	}
+
+	for _, secret := range secrets {
+		refs, err := r.Database.Secrets().ListReferences(ctx, project.ID, secret.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		secret.References = make([]*models.SecretReference, len(refs))
+		for i, ref := range refs {
+			ref := ref
+			secret.References[i] = &ref
+		}
+	}
+
+	return secrets, nil
+}
+
+func (r *queryResolver) RevealSecret(ctx context.Context, projectLabel models.Label, name string, version *int) (string, error) {
+	project, err := r.Database.Projects().Get(ctx, projectLabel)
+	if err != nil {
+		return "", err
+	}
+
+	var v int
+	if version != nil {
+		v = *version
+	} else {
+		versions, err := r.secretVersions(ctx, project, name)
+		if err != nil {
+			return "", err
+		}
+
+		v = versions[len(versions)-1].Version
+	}
+
+	secret, err := r.Database.Secrets().Get(ctx, project.ID, name, v)
+	if err == db.ErrCannotFindSecret {
+		return "", errs.New(SecretNotFoundCause, "Version %d of secret %s does not exist in project %s", v, name, projectLabel)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return secret.Value.String(), nil
+}
+
+func (r *secretReferenceResolver) Current(ctx context.Context, obj *models.SecretReference) (bool, error) {
+	project, err := r.Database.Projects().GetByID(ctx, obj.ProjectID)
+	if err != nil {
+		return false, err
+	}
+
+	return project.CurrentSpecID == obj.SpecID, nil
+}
+
+// SecretReference returns generated.SecretReferenceResolver implementation.
+func (r *Resolver) SecretReference() generated.SecretReferenceResolver {
+	return &secretReferenceResolver{r}
+}
+
+type secretReferenceResolver struct{ *Resolver }
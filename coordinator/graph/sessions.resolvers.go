@@ -0,0 +1,86 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/graph/generated"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (*string, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx).With().Str("session_id", id).Logger()
+
+	session, err := r.Database.Session().Get(ctx, id)
+	if err != nil {
+		logger.Info().Err(err).Msg("Could not retrieve session")
+		return nil, errs.New(SessionNotFoundCause, "Session %s not found", id)
+	}
+
+	// Sessions belonging to other users are reported as not found so they
+	// can't be discovered through this mutation
+	if session.UserID != currSession.User.ID {
+		logger.Info().Msg("Attempted to revoke a session belonging to another user")
+		return nil, errs.New(SessionNotFoundCause, "Session %s not found", id)
+	}
+
+	err = r.Database.Session().Delete(ctx, id)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not delete session")
+		return nil, err
+	}
+
+	logger.Info().Msg("Session revoked")
+	return nil, nil
+}
+
+func (r *mutationResolver) RevokeAllSessions(ctx context.Context, userID string) (*string, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx).With().Str("user_id", userID).Logger()
+
+	if currSession.User.ID != userID && !currSession.Roles.Global.Can(models.RevokeAnySessions) {
+		return nil, errs.New(auth.AuthorizationFailure, "invalid permissions to revoke sessions")
+	}
+
+	err := r.Database.Session().DeleteByUserID(ctx, userID, currSession.Session.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not delete sessions")
+		return nil, err
+	}
+
+	logger.Info().Msg("All sessions revoked")
+	return nil, nil
+}
+
+func (r *queryResolver) MySessions(ctx context.Context) ([]*models.Session, error) {
+	currSession := fw.Session(ctx)
+
+	sessions, err := r.Database.Session().ListByUserID(ctx, currSession.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionPtrs := make([]*models.Session, len(sessions))
+	for i, session := range sessions {
+		s := session
+		sessionPtrs[i] = &s
+	}
+
+	return sessionPtrs, nil
+}
+
+func (r *sessionResolver) Current(ctx context.Context, obj *models.Session) (bool, error) {
+	currSession := fw.Session(ctx)
+	return currSession.Session.ID == obj.ID, nil
+}
+
+// Session returns generated.SessionResolver implementation.
+func (r *Resolver) Session() generated.SessionResolver { return &sessionResolver{r} }
+
+type sessionResolver struct{ *Resolver }
@@ -0,0 +1,43 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+// getTeam returns the team with the given label, wrapping a missing team in
+// an error that can be shown to the caller
+func (r *Resolver) getTeam(ctx context.Context, label models.Label) (*models.Team, error) {
+	team, err := r.Database.Teams().Get(ctx, label)
+	if err == db.ErrCannotFindTeam {
+		return nil, errs.New(TeamNotFoundCause, "Team %s does not exist", label)
+	}
+
+	return team, err
+}
+
+// canManageMembers checks that the caller can change the members of a team,
+// either because their org role lets them manage every team or because
+// they're a maintainer of this one
+func (r *Resolver) canManageMembers(ctx context.Context, team *models.Team) error {
+	currSession := fw.Session(ctx)
+	if currSession.Roles.Global.Can(models.ManageTeams) {
+		return nil
+	}
+
+	member, err := r.Database.Teams().GetMember(ctx, team.ID, currSession.User.ID)
+	if err != nil && err != db.ErrCannotFindTeamMember {
+		return err
+	}
+
+	if member == nil || !member.Maintainer {
+		return errs.New(auth.AuthorizationFailure, "invalid permissions: you are not a maintainer of team %s", team.Label)
+	}
+
+	return nil
+}
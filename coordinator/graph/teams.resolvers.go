@@ -0,0 +1,237 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/graph/generated"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
+)
+
+func (r *mutationResolver) CreateTeam(ctx context.Context, label models.Label, name string) (*models.Team, error) {
+	logger := fw.Logger(ctx)
+
+	team := models.NewTeam(label, name)
+	err := team.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.Teams().Create(ctx, team)
+	if err == db.ErrDuplicateKey {
+		return nil, errs.New(TeamExistsCause, "Team %s already exists", label)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("team_label", label.String()).Msg("Team created")
+	return &team, nil
+}
+
+func (r *mutationResolver) DeleteTeam(ctx context.Context, label models.Label) (*string, error) {
+	logger := fw.Logger(ctx)
+
+	status, err := r.Database.Teams().Delete(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == db.DeleteStatusDoesNotExist {
+		return nil, errs.New(TeamNotFoundCause, "Team %s does not exist", label)
+	}
+
+	logger.Info().Str("team_label", label.String()).Msg("Team deleted")
+	return nil, nil
+}
+
+func (r *mutationResolver) AddTeamMember(ctx context.Context, teamLabel models.Label, userEmail models.Email, maintainer *bool) (*models.TeamMember, error) {
+	logger := fw.Logger(ctx)
+
+	team, err := r.getTeam(ctx, teamLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.canManageMembers(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.Database.Users().Get(ctx, userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	member := models.NewTeamMember(team.ID, user.ID, maintainer != nil && *maintainer)
+	err = r.Database.Teams().AddMember(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("team_label", teamLabel.String()).Str("user_id", user.ID).Msg("Team member added")
+	return r.Database.Teams().GetMember(ctx, team.ID, user.ID)
+}
+
+func (r *mutationResolver) RemoveTeamMember(ctx context.Context, teamLabel models.Label, userEmail models.Email) (*string, error) {
+	logger := fw.Logger(ctx)
+
+	team, err := r.getTeam(ctx, teamLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.canManageMembers(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.Database.Users().Get(ctx, userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.Teams().RemoveMember(ctx, team.ID, user.ID)
+	if err == db.ErrCannotFindTeamMember {
+		return nil, errs.New(TeamMemberNotFoundCause, "%s is not a member of team %s", userEmail, teamLabel)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("team_label", teamLabel.String()).Str("user_id", user.ID).Msg("Team member removed")
+	return nil, nil
+}
+
+func (r *mutationResolver) SetTeamProjectRole(ctx context.Context, teamLabel models.Label, projectLabel models.Label, roleLabel models.Label) (*models.TeamProject, error) {
+	logger := fw.Logger(ctx)
+
+	role, err := r.assignableRole(ctx, roleLabel, models.ProjectRoleKind)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := r.getTeam(ctx, teamLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := r.Database.Projects().Get(ctx, projectLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := models.NewTeamProject(team.ID, project.ID, role.ID)
+	err = r.Database.Teams().SetProjectRole(ctx, tp)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().
+		Str("team_label", teamLabel.String()).
+		Str("project_label", projectLabel.String()).
+		Str("role_label", roleLabel.String()).
+		Msg("Team project role set")
+	return &tp, nil
+}
+
+func (r *mutationResolver) RemoveTeamProject(ctx context.Context, teamLabel models.Label, projectLabel models.Label) (*string, error) {
+	logger := fw.Logger(ctx)
+
+	team, err := r.getTeam(ctx, teamLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := r.Database.Projects().Get(ctx, projectLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Database.Teams().RemoveProject(ctx, team.ID, project.ID)
+	if err == db.ErrCannotFindTeamProject {
+		return nil, errs.New(TeamProjectNotFoundCause, "Team %s is not a contributor to project %s", teamLabel, projectLabel)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info().Str("team_label", teamLabel.String()).Str("project_label", projectLabel.String()).Msg("Team removed from project")
+	return nil, nil
+}
+
+func (r *queryResolver) Teams(ctx context.Context) ([]*models.Team, error) {
+	teams, err := r.Database.Teams().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.Team, len(teams))
+	for i := range teams {
+		res[i] = &teams[i]
+	}
+
+	return res, nil
+}
+
+func (r *queryResolver) Team(ctx context.Context, label models.Label) (*models.Team, error) {
+	return r.getTeam(ctx, label)
+}
+
+func (r *teamResolver) Members(ctx context.Context, obj *models.Team) ([]*models.TeamMember, error) {
+	members, err := r.Database.Teams().Members(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.TeamMember, len(members))
+	for i := range members {
+		res[i] = &members[i]
+	}
+
+	return res, nil
+}
+
+func (r *teamResolver) Projects(ctx context.Context, obj *models.Team) ([]*models.TeamProject, error) {
+	projects, err := r.Database.Teams().Projects(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.TeamProject, len(projects))
+	for i := range projects {
+		res[i] = &projects[i]
+	}
+
+	return res, nil
+}
+
+func (r *teamMemberResolver) User(ctx context.Context, obj *models.TeamMember) (*models.User, error) {
+	return r.Database.Users().GetByID(ctx, obj.UserID)
+}
+
+func (r *teamProjectResolver) Project(ctx context.Context, obj *models.TeamProject) (*models.Project, error) {
+	return r.Database.Projects().GetByID(ctx, obj.ProjectID)
+}
+
+func (r *teamProjectResolver) Role(ctx context.Context, obj *models.TeamProject) (*models.Role, error) {
+	return r.Database.Roles().GetByID(ctx, obj.RoleID)
+}
+
+// Team returns generated.TeamResolver implementation.
+func (r *Resolver) Team() generated.TeamResolver { return &teamResolver{r} }
+
+// TeamMember returns generated.TeamMemberResolver implementation.
+func (r *Resolver) TeamMember() generated.TeamMemberResolver { return &teamMemberResolver{r} }
+
+// TeamProject returns generated.TeamProjectResolver implementation.
+func (r *Resolver) TeamProject() generated.TeamProjectResolver { return &teamProjectResolver{r} }
+
+type teamResolver struct{ *Resolver }
+type teamMemberResolver struct{ *Resolver }
+type teamProjectResolver struct{ *Resolver }
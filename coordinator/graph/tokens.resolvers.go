@@ -37,6 +37,17 @@ func (r *mutationResolver) CreateToken(ctx context.Context, input model.CreateTo
 		Alg:    creds.Alg,
 	})
 
+	if input.Name != nil {
+		token.Name = *input.Name
+	}
+	token.ExpiresAt = input.ExpiresAt
+	token.Scope = input.Scope
+
+	err = token.Validate()
+	if err != nil {
+		return nil, errs.New(InvalidTokenCause, err.Error())
+	}
+
 	err = r.Database.Tokens().Create(ctx, token)
 	if err != nil {
 		return nil, err
@@ -63,7 +74,7 @@ func (r *mutationResolver) RemoveToken(ctx context.Context, id string) (string,
 	return id, err
 }
 
-func (r *queryResolver) Tokens(ctx context.Context, userID string) ([]string, error) {
+func (r *queryResolver) Tokens(ctx context.Context, userID string) ([]*models.Token, error) {
 	currSession := fw.Session(ctx)
 
 	if currSession.User.ID != userID && !currSession.Roles.Global.Can(models.ListAnyTokens) {
@@ -77,10 +88,11 @@ func (r *queryResolver) Tokens(ctx context.Context, userID string) ([]string, er
 		return nil, err
 	}
 
-	tokenIDs := make([]string, len(tokens))
-	for i, t := range tokens {
-		tokenIDs[i] = t.ID
+	tokenPtrs := make([]*models.Token, len(tokens))
+	for i, token := range tokens {
+		t := token
+		tokenPtrs[i] = &t
 	}
 
-	return tokenIDs, nil
+	return tokenPtrs, nil
 }
@@ -16,17 +16,29 @@ type testDatabase struct {
 	tokensDB tokensDB
 }
 
-func (t testDatabase) Roles() db.RoleDB               { panic("implement me") }
-func (t testDatabase) Users() db.UserDB               { panic("implement me") }
-func (t testDatabase) Projects() db.ProjectsDB        { panic("implement me") }
-func (t testDatabase) Contributors() db.ContributorDB { panic("implement me") }
-func (t testDatabase) Config() db.ConfigDB            { panic("implement me") }
-func (t testDatabase) Secrets() db.SecretDB           { panic("implement me") }
-func (t testDatabase) Session() db.SessionDB          { panic("implement me") }
-func (t testDatabase) Recoveries() db.RecoveryDB      { panic("implement me") }
+func (t testDatabase) Roles() db.RoleDB                 { panic("implement me") }
+func (t testDatabase) Users() db.UserDB                 { panic("implement me") }
+func (t testDatabase) Projects() db.ProjectsDB          { panic("implement me") }
+func (t testDatabase) Contributors() db.ContributorDB   { panic("implement me") }
+func (t testDatabase) Config() db.ConfigDB              { panic("implement me") }
+func (t testDatabase) Secrets() db.SecretDB             { panic("implement me") }
+func (t testDatabase) Session() db.SessionDB            { panic("implement me") }
+func (t testDatabase) Recoveries() db.RecoveryDB        { panic("implement me") }
+func (t testDatabase) EmailChanges() db.EmailChangeDB   { panic("implement me") }
+func (t testDatabase) Invitations() db.InvitationDB     { panic("implement me") }
+func (t testDatabase) SSOAttempts() db.SSOAttemptDB     { panic("implement me") }
+func (t testDatabase) SSOIdentities() db.SSOIdentityDB  { panic("implement me") }
+func (t testDatabase) MFA() db.MFADB                    { panic("implement me") }
+func (t testDatabase) RefreshTokens() db.RefreshTokenDB { panic("implement me") }
+func (t testDatabase) Throttles() db.ThrottleDB         { panic("implement me") }
+func (t testDatabase) Teams() db.TeamDB                 { panic("implement me") }
+func (t testDatabase) Audit() db.AuditDB                { panic("implement me") }
+func (t testDatabase) ProjectKeys() db.ProjectKeyDB     { panic("implement me") }
 
 func (t testDatabase) Tokens() db.TokensDB { return &t.tokensDB }
 
+func (t testDatabase) Tx(ctx context.Context, fn func(db.Interface) error) error { return fn(t) }
+
 type tokensDB struct {
 	// you can set return token as a default token to return in this test
 	returnToken models.Token
@@ -56,6 +68,14 @@ func (t *tokensDB) ListByUserID(ctx context.Context, s string) ([]models.Token,
 	return nil, nil
 }
 
+func (t *tokensDB) UpdateLastUsed(ctx context.Context, s string, at time.Time, ip string) error {
+	return nil
+}
+
+func (t *tokensDB) DeleteExpired(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
 func resolverContext(ctx context.Context, opts *ctxOptions) context.Context {
 	if opts == nil {
 		opts = &ctxOptions{}
@@ -84,8 +104,9 @@ func resolverContext(ctx context.Context, opts *ctxOptions) context.Context {
 		Session: session,
 		Roles: models.UserRoles{
 			Global: models.Role{
-				ID:    opts.role.String(),
-				Label: opts.role,
+				ID:     opts.role.String(),
+				Label:  opts.role,
+				System: true,
 			},
 		},
 	}
@@ -166,6 +187,37 @@ func TestTokensCreate(t *testing.T) {
 		gm.Expect(resp.Secret).ToNot(gm.Equal(""))
 	})
 
+	t.Run("token is created with a name, expiry and scope", func(t *testing.T) {
+		name := "ci"
+		expiresAt := time.Now().UTC().Add(time.Hour)
+		req := model.CreateTokenRequest{
+			UserID:    "admin",
+			Name:      &name,
+			ExpiresAt: &expiresAt,
+			Scope:     []models.Permission{models.ReadPolicy},
+		}
+
+		ctx := resolverContext(context.TODO(), nil)
+		resp, err := mutationResolver.CreateToken(ctx, req)
+
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(resp.Token.Name).To(gm.Equal("ci"))
+		gm.Expect(resp.Token.ExpiresAt).To(gm.Equal(&expiresAt))
+		gm.Expect(resp.Token.Scope).To(gm.Equal([]models.Permission{models.ReadPolicy}))
+	})
+
+	t.Run("token can't be created with an expiry in the past", func(t *testing.T) {
+		expiresAt := time.Now().UTC().Add(-time.Hour)
+		req := model.CreateTokenRequest{
+			UserID:    "admin",
+			ExpiresAt: &expiresAt,
+		}
+
+		ctx := resolverContext(context.TODO(), nil)
+		_, err := mutationResolver.CreateToken(ctx, req)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
 	t.Run("non admin cannot create a token for someone else", func(t *testing.T) {
 		req := model.CreateTokenRequest{
 			UserID: "myfriend",
@@ -5,39 +5,206 @@ package graph
 
 import (
 	"context"
+	"time"
 
+	"github.com/capeprivacy/cape/coordinator/db"
 	"github.com/capeprivacy/cape/coordinator/graph/generated"
 	"github.com/capeprivacy/cape/coordinator/graph/model"
+	"github.com/capeprivacy/cape/coordinator/throttle"
 	fw "github.com/capeprivacy/cape/framework"
 	"github.com/capeprivacy/cape/models"
+	errs "github.com/capeprivacy/cape/partyerrors"
 )
 
-func (r *mutationResolver) CreateUser(ctx context.Context, input model.CreateUserRequest) (*model.CreateUserResponse, error) {
-	logger := fw.Logger(ctx)
-	password := models.GeneratePassword()
+func (r *mutationResolver) ChangePassword(ctx context.Context, input model.ChangePasswordRequest) (*string, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx).With().Str("user_id", currSession.User.ID).Logger()
 
-	creds, err := r.CredentialProducer.Generate(password)
+	user, err := r.Database.Users().GetByID(ctx, currSession.User.ID)
 	if err != nil {
-		logger.Info().Err(err).Msg("Could not generate credentials")
+		logger.Error().Err(err).Msg("Could not retrieve user")
+		return nil, ErrChangePasswordFailed
+	}
+
+	// The attempt is counted as a failure before the password is compared
+	// and forgotten if it turns out to be right
+	key := throttle.ChangePasswordKey(user.ID)
+	_, err = r.Limiter.Reserve(ctx, key)
+	if err != nil {
+		logger.Info().Err(err).Msg("Change password attempt throttled")
+		return nil, err
+	}
+
+	err = r.CredentialProducer.Compare(input.CurrentPassword, &user.Credentials)
+	if err != nil {
+		logger.Info().Err(err).Msg("Invalid current password provided")
+		return nil, ErrChangePasswordFailed
+	}
+
+	err = r.Limiter.Reset(ctx, key)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not reset failed change password attempts")
+	}
+
+	err = r.setPassword(user, input.NewPassword)
+	if err != nil {
+		logger.Info().Err(err).Msg("Could not set new password")
+		return nil, err
+	}
+
+	err = r.Database.Users().Update(ctx, user.ID, *user)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not update user with new password")
+		return nil, err
+	}
+
+	// Anyone else holding a session for this account may have been using the
+	// old password so we kick them out, leaving only the caller logged in.
+	err = r.Database.Session().DeleteByUserID(ctx, user.ID, currSession.Session.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not invalidate other sessions")
+		return nil, err
+	}
+
+	logger.Info().Msg("Successfully changed password")
+	return nil, nil
+}
+
+func (r *mutationResolver) UpdateMe(ctx context.Context, input model.UpdateMeRequest) (*models.User, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx).With().Str("user_id", currSession.User.ID).Logger()
+
+	user, err := r.Database.Users().GetByID(ctx, currSession.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil && *input.Name != user.Name {
+		user.Name = *input.Name
+		user.UpdatedAt = time.Now().UTC()
+		err = r.Database.Users().Update(ctx, user.ID, *user)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not update user name")
+			return nil, err
+		}
+	}
+
+	if input.Email == nil || *input.Email == user.Email {
+		return user, nil
+	}
+
+	_, err = r.Database.Users().Get(ctx, *input.Email)
+	if err == nil {
+		return nil, errs.New(EmailInUseCause, "Email %s is already in use", *input.Email)
+	}
+	if err != db.ErrCannotFindUser {
+		return nil, err
+	}
+
+	secret := models.GeneratePassword()
+	creds, err := r.CredentialProducer.Generate(secret)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not generate credentials")
+		return nil, err
+	}
+
+	change := models.NewEmailChange(user.ID, *input.Email, creds)
+	err = r.Database.EmailChanges().Create(ctx, change)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not insert email change into database")
 		return nil, err
 	}
 
-	user := models.NewUser(input.Name, input.Email, *creds)
+	err = r.Mailer.SendEmailChangeConfirmation(ctx, *user, change, secret)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not send email change confirmation")
+		return nil, err
+	}
+
+	logger.Info().Msgf("Email change created with id %s", change.ID)
+	return user, nil
+}
+
+func (r *mutationResolver) ConfirmEmailChange(ctx context.Context, input model.ConfirmEmailChangeRequest) (*models.User, error) {
+	currSession := fw.Session(ctx)
+	logger := fw.Logger(ctx).With().Str("email_change_id", input.ID).Logger()
+
+	change, err := r.Database.EmailChanges().Get(ctx, input.ID)
+	if err == db.ErrCannotFindEmailChange {
+		logger.Info().Err(err).Msg("Could not find email change")
+		return nil, ErrEmailChangeFailed
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not retrieve email change")
+		return nil, err
+	}
+
+	if change.UserID != currSession.User.ID {
+		logger.Info().Msg("Email change belongs to another user")
+		return nil, ErrEmailChangeFailed
+	}
+
+	if change.Expired() {
+		logger.Info().Msg("Email change has expired")
+		return nil, ErrEmailChangeFailed
+	}
+
+	err = r.CredentialProducer.Compare(input.Secret, change.Credentials)
+	if err != nil {
+		logger.Info().Err(err).Msg("Invalid credentials provided")
+		return nil, ErrEmailChangeFailed
+	}
+
+	user, err := r.Database.Users().GetByID(ctx, change.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The email may have been taken since the change was requested
+	_, err = r.Database.Users().Get(ctx, change.Email)
+	if err == nil {
+		return nil, errs.New(EmailInUseCause, "Email %s is already in use", change.Email)
+	}
+	if err != db.ErrCannotFindUser {
+		return nil, err
+	}
+
+	user.Email = change.Email
+	user.UpdatedAt = time.Now().UTC()
+	err = r.Database.Users().Update(ctx, user.ID, *user)
+	if err == db.ErrDuplicateKey {
+		return nil, errs.New(EmailInUseCause, "Email %s is already in use", change.Email)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not update user with new email")
+		return nil, err
+	}
+
+	err = r.Database.EmailChanges().Delete(ctx, change.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not delete email change")
+		return nil, err
+	}
+
+	logger.Info().Msg("Successfully changed email")
+	return user, nil
+}
+
+func (r *mutationResolver) UnlockUser(ctx context.Context, userID string) (*string, error) {
+	logger := fw.Logger(ctx).With().Str("unlock_user_id", userID).Logger()
 
-	err = r.Database.Users().Create(ctx, user)
+	user, err := r.Database.Users().GetByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = r.Database.Roles().SetOrgRole(ctx, user.Email, models.UserRole)
+	err = r.Limiter.Reset(ctx, throttle.AccountKey(user.Email.String()))
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.CreateUserResponse{
-		Password: password,
-		User:     &user,
-	}, nil
+	logger.Info().Msg("User unlocked")
+	return nil, nil
 }
 
 func (r *queryResolver) User(ctx context.Context, id string) (*models.User, error) {
@@ -2,10 +2,15 @@ package coordinator
 
 import (
 	"context"
-	"github.com/capeprivacy/cape/coordinator/db"
+	"net"
 	"net/http"
+	"time"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/throttle"
 
 	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/audit"
 	fw "github.com/capeprivacy/cape/framework"
 	"github.com/capeprivacy/cape/models"
 	errors "github.com/capeprivacy/cape/partyerrors"
@@ -32,10 +37,22 @@ func VersionHandler(instanceID string) http.HandlerFunc {
 	}
 }
 
+// JWKSHandler serves the public keys session tokens can be verified with as
+// a JSON Web Key Set so services other than the coordinator can verify them
+func JWKSHandler(coordinator *Coordinator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		respondWithJSON(w, http.StatusOK, coordinator.tokenAuth.PublicKeys())
+	}
+}
+
 type LoginRequest struct {
 	Email   *models.Email   `json:"email"`
 	TokenID *string         `json:"token_id"`
 	Secret  models.Password `json:"secret"`
+
+	// OTP is a one-time code, or recovery code, required when logging in as
+	// a user who has enrolled in multi-factor authentication
+	OTP string `json:"otp,omitempty"`
 }
 
 func LoginHandler(coordinator *Coordinator) http.HandlerFunc {
@@ -44,7 +61,6 @@ func LoginHandler(coordinator *Coordinator) http.HandlerFunc {
 
 		capedb := coordinator.db
 		cp := coordinator.credentialProducer
-		ta := coordinator.tokenAuth
 
 		err := fw.DecodeJSONBody(w, r, &input)
 		if err != nil {
@@ -63,9 +79,22 @@ func LoginHandler(coordinator *Coordinator) http.HandlerFunc {
 			return
 		}
 
+		// The attempt is counted as a failure before the secret is compared
+		// and refunded if it turns out to be right
+		keys := []throttle.Key{throttle.IPKey("login", remoteIP(r)), loginAccountKey(input)}
+		reservation, err := coordinator.limiter.Reserve(r.Context(), keys...)
+		if err != nil {
+			logger.Info().Err(err).Msg("Login attempt throttled")
+			coordinator.auditLogin(r.Context(), input, nil, err)
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
 		provider, err := getCredentialProvider(r.Context(), capedb, input)
 		if err != nil {
 			logger.Info().Err(err).Msgf("Could not retrieve user for create session request, email: %s token_id: %v", input.Email, input.TokenID)
+			coordinator.loginFailed(r.Context(), reservation, nil)
+			coordinator.auditLogin(r.Context(), input, nil, err)
 			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
 			return
 		}
@@ -77,41 +106,92 @@ func LoginHandler(coordinator *Coordinator) http.HandlerFunc {
 			return
 		}
 
-		err = cp.Compare(input.Secret, &models.Credentials{
-			Secret: creds.Secret,
-			Salt:   creds.Salt,
-			Alg:    creds.Alg,
-		})
+		err = cp.Compare(input.Secret, creds)
 		if err != nil {
 			logger.Info().Err(err).Msgf("Invalid credentials provided")
+			coordinator.loginFailed(r.Context(), reservation, provider)
+			coordinator.auditLogin(r.Context(), input, provider, err)
 			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
 			return
 		}
 
+		if token, ok := provider.(*models.Token); ok {
+			if token.Expired() {
+				logger.Info().Str("token_id", token.ID).Msg("Attempted to login with an expired token")
+				coordinator.auditLogin(r.Context(), input, provider, auth.ErrAuthentication)
+				respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+				return
+			}
+
+			// Failing to record usage shouldn't prevent the login
+			err = capedb.Tokens().UpdateLastUsed(r.Context(), token.ID, time.Now().UTC(), remoteIP(r))
+			if err != nil {
+				logger.Error().Err(err).Str("token_id", token.ID).Msg("Could not record token usage")
+			}
+		}
+
+		// API tokens are a credential in their own right so multi-factor
+		// authentication only applies to users logging in with a password
+		mfaVerified := false
+		if input.Email != nil {
+			mfaVerified, err = verifyMFA(r.Context(), capedb, provider.GetUserID(), input.OTP)
+			if err != nil {
+				logger.Info().Err(err).Msg("Could not verify one-time code")
+
+				// Asking for a code isn't a failure, a wrong code is
+				if err == ErrMFARequired {
+					coordinator.refundLogin(r.Context(), reservation, keys...)
+				} else {
+					coordinator.loginFailed(r.Context(), reservation, provider)
+					coordinator.auditLogin(r.Context(), input, provider, err)
+				}
+
+				respondWithError(w, r.URL.Path, err)
+				return
+			}
+		}
+
+		// Only the account's failures are forgotten, the ip address could
+		// be guessing at other accounts so it's just refunded this attempt
+		err = coordinator.limiter.Reset(r.Context(), keys[1])
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not reset failed login attempts")
+		}
+
+		coordinator.refundLogin(r.Context(), reservation, keys[0])
+
+		if user, ok := provider.(*models.User); ok && cp.NeedsRehash(creds) {
+			coordinator.rehashPassword(r.Context(), user, input.Secret)
+		}
+
 		session := models.NewSession(provider)
-		token, expiresAt, err := ta.Generate(session.ID)
+		session.MFAVerified = mfaVerified
+		session.IPAddress = remoteIP(r)
+		session.UserAgent = r.UserAgent()
+
+		err = coordinator.startSession(r.Context(), &session)
 		if err != nil {
-			logger.Info().Err(err).Msg("Failed to generate auth token")
+			logger.Error().Err(err).Msg("Failed to create session")
 			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
 			return
 		}
 
-		session.SetToken(token, expiresAt)
-		err = capedb.Session().Create(r.Context(), session)
+		err = coordinator.issueRefreshToken(r.Context(), &session)
 		if err != nil {
-			logger.Error().Err(err).Msg("Failed to create session in database")
+			logger.Error().Err(err).Msg("Failed to issue refresh token")
 			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
 			return
 		}
 
 		cookie := &http.Cookie{
 			Name:     "token",
-			Value:    token.String(),
+			Value:    session.Token.String(),
 			Secure:   false,
 			HttpOnly: true,
 		}
 		http.SetCookie(w, cookie)
 
+		coordinator.auditLogin(r.Context(), input, provider, nil)
 		respondWithJSON(w, http.StatusOK, session)
 	}
 }
@@ -186,3 +266,109 @@ func getCredentialProvider(ctx context.Context, db db.Interface, input LoginRequ
 
 	return db.Tokens().Get(ctx, *input.TokenID)
 }
+
+// loginAccountKey returns the key failed logins to the email or token id
+// being logged in with are counted against
+func loginAccountKey(input LoginRequest) throttle.Key {
+	if input.Email != nil {
+		return throttle.AccountKey(input.Email.String())
+	}
+
+	return throttle.AccountKey("token:" + *input.TokenID)
+}
+
+// refundLogin takes back the failure reserved against the given keys for a
+// login that didn't fail
+func (c *Coordinator) refundLogin(ctx context.Context, reservation *throttle.Reservation, keys ...throttle.Key) {
+	logger := fw.Logger(ctx)
+
+	err := reservation.Refund(ctx, keys...)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not refund login attempt")
+	}
+}
+
+// loginFailed handles a login that failed, its failure was already counted
+// when the attempt was reserved. If the account was locked out as a result
+// and belongs to a user they're emailed so they know someone is trying to
+// guess their password.
+func (c *Coordinator) loginFailed(ctx context.Context, reservation *throttle.Reservation, provider models.CredentialProvider) {
+	logger := fw.Logger(ctx)
+
+	lockedUntil := reservation.LockedUntil
+	if lockedUntil.IsZero() {
+		return
+	}
+
+	logger.Warn().Time("locked_until", lockedUntil).Msg("Too many failed login attempts, account locked")
+
+	user, ok := provider.(*models.User)
+	if !ok {
+		return
+	}
+
+	err := c.mailer.SendAccountLocked(ctx, *user, lockedUntil)
+	if err != nil {
+		logger.Error().Err(err).Str("user_id", user.ID).Msg("Could not send account locked email")
+	}
+}
+
+// auditLogin records a login attempt in the audit log. Only a successful
+// login proves who the actor was so failures are recorded against the
+// account being logged into.
+func (c *Coordinator) auditLogin(ctx context.Context, input LoginRequest, provider models.CredentialProvider, err error) {
+	event := models.NewAuditEvent(fw.RequestID(ctx).String(), audit.LoginAction)
+	if input.Email != nil {
+		event.Target = input.Email.String()
+	} else {
+		event.Target = "token:" + *input.TokenID
+	}
+
+	if err != nil {
+		event.Outcome = models.AuditFailure
+		event.Error = err.Error()
+	} else {
+		event.ActorID = provider.GetUserID()
+
+		// API tokens don't know the email of the user they belong to
+		user, err := c.db.Users().GetByID(ctx, event.ActorID)
+		if err == nil {
+			event.ActorEmail = user.Email
+		}
+	}
+
+	c.audit.Record(ctx, event)
+}
+
+// rehashPassword replaces the user's credentials with ones hashed using the
+// current parameters, the password must already have been verified. Failing
+// to rehash doesn't prevent the login, it's tried again the next time.
+func (c *Coordinator) rehashPassword(ctx context.Context, user *models.User, password models.Password) {
+	logger := fw.Logger(ctx).With().Str("user_id", user.ID).Logger()
+
+	creds, err := c.credentialProducer.Generate(password)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not rehash password")
+		return
+	}
+
+	user.Credentials = *creds
+	err = c.db.Users().Update(ctx, user.ID, *user)
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not store rehashed password")
+		return
+	}
+
+	logger.Info().Msg("Rehashed password with the current parameters")
+}
+
+// remoteIP returns the address of the client that made the request without
+// the port, falling back to the raw remote address if it can't be parsed
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
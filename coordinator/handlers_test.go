@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	gm "github.com/onsi/gomega"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/capeprivacy/cape/auth"
 )
 
 func TestVersionHandler(t *testing.T) {
@@ -30,3 +33,30 @@ func TestVersionHandler(t *testing.T) {
 	gm.Expect(v.Version).To(gm.Equal("0.0.0"))
 	gm.Expect(v.BuildDate).To(gm.Equal("never"))
 }
+
+func TestJWKSHandler(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	keypair, err := auth.NewKeypair()
+	gm.Expect(err).To(gm.BeNil())
+
+	tokenAuth, err := auth.NewTokenAuthority(keypair, "cape")
+	gm.Expect(err).To(gm.BeNil())
+
+	req := httptest.NewRequest("GET", "http://my.cape.com/v1/jwks", nil)
+	w := httptest.NewRecorder()
+
+	handler := JWKSHandler(&Coordinator{tokenAuth: tokenAuth})
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	gm.Expect(resp.StatusCode).To(gm.Equal(http.StatusOK))
+
+	set := jose.JSONWebKeySet{}
+	err = json.NewDecoder(resp.Body).Decode(&set)
+	gm.Expect(err).To(gm.BeNil())
+
+	gm.Expect(set.Keys).To(gm.HaveLen(1))
+	gm.Expect(set.Keys[0].Key).To(gm.Equal(keypair.PublicKey))
+	gm.Expect(set.Keys[0].Use).To(gm.Equal("sig"))
+}
@@ -3,6 +3,9 @@ package harness
 import (
 	"os"
 
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/models"
+
 	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
@@ -15,6 +18,13 @@ type Config struct {
 	dbURL             string
 	migrationsDir     string
 	migrationsTestDir string
+
+	// SSO enables single sign on for the Coordinator, the RedirectURL is
+	// filled in by the Harness once it knows where it's listening.
+	SSO *coordinator.SSOConfig
+
+	// PasswordPolicy restricts the passwords users can choose
+	PasswordPolicy models.PasswordPolicy
 }
 
 // Validate returns an error if the struct contains invalid configuration
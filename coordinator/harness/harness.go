@@ -19,8 +19,9 @@ import (
 )
 
 var (
-	TimeoutCause    = errors.NewCause(errors.RequestTimeoutCategory, "start_timeout")
-	NotStartedCause = errors.NewCause(errors.BadRequestCategory, "coordinator_not_started")
+	TimeoutCause     = errors.NewCause(errors.RequestTimeoutCategory, "start_timeout")
+	NotStartedCause  = errors.NewCause(errors.BadRequestCategory, "coordinator_not_started")
+	MissingMailCause = errors.NewCause(errors.NotFoundCategory, "missing_mail")
 )
 
 // Harness represents a http server used for testing. Its responsibility is to
@@ -139,6 +140,18 @@ func (h *Harness) Setup(ctx context.Context) error {
 		return err
 	}
 
+	// The server is created before the coordinator so we know the url it
+	// will be served from, it isn't started until the handler is ready.
+	h.server = httptest.NewUnstartedServer(nil)
+	serverURL := "http://" + h.server.Listener.Addr().String()
+
+	var ssoCfg *coordinator.SSOConfig
+	if h.cfg.SSO != nil {
+		c := *h.cfg.SSO
+		c.RedirectURL = serverURL + "/v1/sso/callback"
+		ssoCfg = &c
+	}
+
 	coordinator, err := coordinator.New(&coordinator.Config{
 		Version: 1,
 		DB: &coordinator.DBConfig{
@@ -153,9 +166,13 @@ func (h *Harness) Setup(ctx context.Context) error {
 			Email:    AdminEmail,
 			Password: AdminPassword,
 		},
+		Passwords: coordinator.PasswordConfig{
+			Policy: h.cfg.PasswordPolicy,
+		},
+		SSO: ssoCfg,
 	}, logger, h.mailer)
 	if err != nil {
-		return err
+		return cleanup(err)
 	}
 
 	handler, err := coordinator.Setup(ctx)
@@ -167,9 +184,10 @@ func (h *Harness) Setup(ctx context.Context) error {
 	h.component = coordinator
 	h.db = database
 
-	// httptest.NewServer starts listening immediately, it also picks a
-	// randomized port to listen on!
-	h.server = httptest.NewServer(handler)
+	// httptest.NewUnstartedServer picked a randomized port to listen on, now
+	// that the handler is ready we can start serving!
+	h.server.Config.Handler = handler
+	h.server.Start()
 	h.manager = &Manager{h: h}
 
 	// We try to wait for the coordinator to start for _up to_ 5 seconds! At
@@ -7,6 +7,7 @@ import (
 
 	"github.com/capeprivacy/cape/coordinator"
 	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
 const AdminEmail = models.Email("admin@cape.com")
@@ -65,6 +66,64 @@ func (m *Manager) Setup(ctx context.Context) (*coordinator.Client, error) {
 	return client, nil
 }
 
+// CreateUser invites a user with the given email using the admin client and
+// accepts the invitation on their behalf with a generated password that
+// satisfies the harness' password policy. The
+// invitation email is read from the harness' test mailer.
+//
+// Setup must be called before creating users.
+func (m *Manager) CreateUser(ctx context.Context, name models.Name, email models.Email) (*models.User, models.Password, error) {
+	if m.Admin == nil {
+		return nil, "", errors.New(NotStartedCause, "Setup must be called before creating users")
+	}
+
+	invitation, err := m.Admin.Client.CreateInvitation(ctx, email, models.UserRole, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var secret models.Password
+	for _, mail := range m.h.Mails() {
+		inv, ok := mail.Arguments["invitation"].(models.Invitation)
+		if ok && inv.ID == invitation.ID {
+			secret = mail.Arguments["secret"].(models.Password)
+		}
+	}
+
+	if secret == "" {
+		return nil, "", errors.New(MissingMailCause, "Could not find invitation email for %s", email)
+	}
+
+	client, err := m.h.Client()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Generated passwords are random so keep trying until one satisfies the
+	// configured password policy
+	password := models.GeneratePassword()
+	for m.h.cfg.PasswordPolicy.Check(password) != nil {
+		password = models.GeneratePassword()
+	}
+
+	err = client.AcceptInvitation(ctx, invitation.ID, secret, name, password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = client.EmailLogin(ctx, email, password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := client.Me(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return user, password, nil
+}
+
 // URL returns the url of the coordinator
 func (m *Manager) URL() (*models.URL, error) {
 	return m.h.URL()
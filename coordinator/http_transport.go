@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/http/cookiejar"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/machinebox/graphql"
@@ -24,13 +25,29 @@ import (
 
 // HTTPTransport is a ClientTransport that interacts with the Coordinator via
 // GraphQL over HTTP.
+//
+// When the transport has a refresh token it renews the session shortly
+// before it expires.
 type HTTPTransport struct {
 	client     *graphql.Client
 	authToken  *base64.Value
 	url        *models.URL
 	httpClient *http.Client
+
+	// refreshLock ensures only one refresh happens at a time, a refresh
+	// token can only be used once
+	refreshLock  sync.Mutex
+	refreshToken *base64.Value
+	refreshAt    time.Time
+	expiresAt    time.Time
+	onRefresh    func(*models.Session)
 }
 
+// RefreshWindow is how long before a session expires that the HTTPTransport
+// renews it. Sessions are renewed after half their lifetime if it is shorter
+// than twice the window.
+var RefreshWindow = 5 * time.Minute
+
 // NewHTTPTransport returns a ClientTransport configured to make requests via
 // GraphQL over HTTP
 func NewHTTPTransport(coordinatorURL *models.URL, authToken *base64.Value, certFile string) ClientTransport {
@@ -65,13 +82,18 @@ func (c *HTTPTransport) URL() *models.URL {
 // Raw wraps the NewRequest and does common req changes like adding authorization
 // headers. It calls Run passing the object to be filled with the request data.
 func (c *HTTPTransport) Raw(ctx context.Context, query string, variables map[string]interface{}, resp interface{}) error {
+	err := c.maybeRefresh(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := graphql.NewRequest(query)
 
 	for key, val := range variables {
 		req.Var(key, val)
 	}
 
-	err := c.client.Run(ctx, req, resp)
+	err = c.client.Run(ctx, req, resp)
 	if err != nil {
 		if nerr, ok := err.(net.Error); ok {
 			return errors.New(NetworkCause, "Could not contact coordinator: %s", nerr.Error())
@@ -93,7 +115,7 @@ func (c *HTTPTransport) TokenLogin(ctx context.Context, apiToken *auth.APIToken)
 
 // EmailLogin starts step 1 of the login flow using an email & password
 func (c *HTTPTransport) EmailLogin(ctx context.Context, email models.Email, password models.Password) (*models.Session, error) {
-	return emailLogin(ctx, c, email, password)
+	return emailLogin(ctx, c, email, password, "")
 }
 
 // Logout of the active session
@@ -107,9 +129,13 @@ func (c *HTTPTransport) Authenticated() bool {
 	return c.authToken != nil
 }
 
-// SetToken enables a caller to set the auth token used by the transport
+// SetToken enables a caller to set the auth token used by the transport. The
+// token can't be refreshed, use SetSession for that.
 func (c *HTTPTransport) SetToken(value *base64.Value) {
 	c.authToken = value
+	c.refreshToken = nil
+	c.refreshAt = time.Time{}
+	c.expiresAt = time.Time{}
 
 	if c.authToken != nil {
 		cookie := &http.Cookie{
@@ -120,6 +146,83 @@ func (c *HTTPTransport) SetToken(value *base64.Value) {
 	}
 }
 
+// SetSession sets the auth token from the session along with the refresh
+// token, if it has one, used to renew the session before it expires
+func (c *HTTPTransport) SetSession(session *models.Session) {
+	c.SetToken(session.Token)
+	if session.RefreshToken == nil || session.ExpiresAt.IsZero() {
+		return
+	}
+
+	window := RefreshWindow
+	if !session.CreatedAt.IsZero() {
+		if half := session.ExpiresAt.Sub(session.CreatedAt) / 2; half < window {
+			window = half
+		}
+	}
+
+	c.refreshToken = session.RefreshToken
+	c.expiresAt = session.ExpiresAt
+	c.refreshAt = session.ExpiresAt.Add(-window)
+}
+
+// OnRefresh registers a function that is called with the new session each
+// time the transport refreshes its session, for example to save it
+func (c *HTTPTransport) OnRefresh(fn func(*models.Session)) {
+	c.onRefresh = fn
+}
+
+// Refresh exchanges the refresh token for a new session
+func (c *HTTPTransport) Refresh(ctx context.Context) (*models.Session, error) {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	return c.refresh(ctx)
+}
+
+func (c *HTTPTransport) refresh(ctx context.Context) (*models.Session, error) {
+	session, err := refresh(ctx, c, c.refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.onRefresh != nil {
+		c.onRefresh(session)
+	}
+
+	return session, nil
+}
+
+// maybeRefresh refreshes the session if it's about to expire. A failure is
+// only returned if the session has already expired, otherwise the current
+// session is used until the next attempt.
+func (c *HTTPTransport) maybeRefresh(ctx context.Context) error {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	if c.refreshToken == nil || time.Now().Before(c.refreshAt) {
+		return nil
+	}
+
+	expiresAt := c.expiresAt
+	_, err := c.refresh(ctx)
+	if err == nil {
+		return nil
+	}
+
+	// The session was revoked or can no longer be refreshed so don't try
+	// again
+	if errors.FromCause(err, auth.AuthenticationFailure) {
+		c.refreshToken = nil
+	}
+
+	if time.Now().Before(expiresAt) {
+		return nil
+	}
+
+	return err
+}
+
 // Token enables a caller to retrieve the current auth token used by the
 // transport
 func (c *HTTPTransport) Token() *base64.Value {
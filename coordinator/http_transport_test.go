@@ -10,7 +10,10 @@ import (
 	"net/http/cookiejar"
 	"net/http/httptest"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/machinebox/graphql"
 	"github.com/manifoldco/go-base64"
@@ -194,3 +197,120 @@ type ErrorRoundTripper struct {
 func (e ErrorRoundTripper) RoundTrip(_ *http.Request) (*http.Response, error) {
 	return nil, e.err
 }
+
+func TestHTTPTransportRefresh(t *testing.T) {
+	refreshes := int32(0)
+	fail := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/refresh" {
+			_ = json.NewEncoder(w).Encode(&gqlResponse{Data: "blahblah"})
+			return
+		}
+
+		atomic.AddInt32(&refreshes, 1)
+		if fail {
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		now := time.Now().UTC()
+		respondWithJSON(w, http.StatusOK, &models.Session{
+			Token:        base64.New([]byte("refreshed")),
+			RefreshToken: base64.New([]byte("next-refresh-token")),
+			CreatedAt:    now,
+			ExpiresAt:    now.Add(time.Hour),
+		})
+	}))
+	defer ts.Close()
+
+	clientURL, err := models.NewURL(ts.URL)
+	if err != nil {
+		t.Fatalf("error setting up test")
+	}
+
+	session := func(expiresIn time.Duration) *models.Session {
+		now := time.Now().UTC()
+		return &models.Session{
+			Token:        base64.New([]byte("faketoken")),
+			RefreshToken: base64.New([]byte("refresh-token")),
+			CreatedAt:    now.Add(-time.Hour),
+			ExpiresAt:    now.Add(expiresIn),
+		}
+	}
+
+	t.Run("doesn't refresh a session that isn't about to expire", func(t *testing.T) {
+		atomic.StoreInt32(&refreshes, 0)
+		ct := createHTTPTransport(ts, clientURL, nil)
+		ct.SetSession(session(time.Hour))
+
+		err := ct.Raw(context.TODO(), "fakequery", nil, nil)
+		if err != nil {
+			t.Fatalf("failed call to Raw with error %v", err)
+		}
+
+		if refreshes != 0 {
+			t.Errorf("expected no refreshes, got %d", refreshes)
+		}
+	})
+
+	t.Run("refreshes a session that is about to expire once", func(t *testing.T) {
+		atomic.StoreInt32(&refreshes, 0)
+		ct := createHTTPTransport(ts, clientURL, nil)
+		ct.SetSession(session(time.Minute))
+
+		var refreshed *models.Session
+		ct.OnRefresh(func(s *models.Session) {
+			refreshed = s
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := ct.Raw(context.TODO(), "fakequery", nil, nil)
+				if err != nil {
+					t.Errorf("failed call to Raw with error %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if refreshes != 1 {
+			t.Errorf("expected one refresh, got %d", refreshes)
+		}
+
+		if ct.Token().String() != base64.New([]byte("refreshed")).String() {
+			t.Errorf("expected the refreshed token to be used")
+		}
+
+		if refreshed == nil {
+			t.Errorf("expected OnRefresh to be called")
+		}
+	})
+
+	t.Run("keeps using a valid session if refreshing fails", func(t *testing.T) {
+		atomic.StoreInt32(&refreshes, 0)
+		fail = true
+		defer func() { fail = false }()
+
+		ct := createHTTPTransport(ts, clientURL, nil)
+		ct.SetSession(session(time.Minute))
+
+		for i := 0; i < 2; i++ {
+			err := ct.Raw(context.TODO(), "fakequery", nil, nil)
+			if err != nil {
+				t.Fatalf("failed call to Raw with error %v", err)
+			}
+		}
+
+		if refreshes != 1 {
+			t.Errorf("expected one refresh attempt, got %d", refreshes)
+		}
+
+		_, err := ct.Refresh(context.TODO())
+		if !errors.FromCause(err, NoRefreshTokenCause) {
+			t.Errorf("expected no refresh token error, got %v", err)
+		}
+	})
+}
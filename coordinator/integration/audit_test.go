@@ -0,0 +1,121 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/audit"
+	"github.com/capeprivacy/cape/coordinator/graph/model"
+	"github.com/capeprivacy/cape/coordinator/harness"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestAudit(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	client, err := m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	admin := m.Admin.User.Email
+
+	user, pw, err := m.CreateUser(ctx, "Audited User", "audited@person.com")
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("Mutations are recorded", func(t *testing.T) {
+		err := client.SetOrgRole(ctx, user.Email, models.AdminRole)
+		gm.Expect(err).To(gm.BeNil())
+
+		action := "setOrgRole"
+		events, err := client.ListAuditEvents(ctx, model.AuditEventFilter{Action: &action}, 10)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(events)).To(gm.Equal(1))
+
+		event := events[0]
+		gm.Expect(event.ActorEmail).To(gm.Equal(admin))
+		gm.Expect(event.Target).To(gm.Equal(user.Email.String()))
+		gm.Expect(event.Outcome).To(gm.Equal(models.AuditSuccess))
+		gm.Expect(event.Before).To(gm.Equal(`{"role":"user"}`))
+		gm.Expect(event.RequestID).ToNot(gm.BeEmpty())
+	})
+
+	t.Run("Logins are recorded", func(t *testing.T) {
+		_, err := client.EmailLogin(ctx, user.Email, models.Password("wrong-password"))
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		action := "login"
+		target := user.Email.String()
+		events, err := client.ListAuditEvents(ctx, model.AuditEventFilter{Action: &action, Target: &target}, 10)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(events)).To(gm.Equal(1))
+		gm.Expect(events[0].Outcome).To(gm.Equal(models.AuditFailure))
+		gm.Expect(events[0].ActorEmail).To(gm.BeEmpty())
+	})
+
+	t.Run("Secrets aren't recorded", func(t *testing.T) {
+		_, err := client.EmailLogin(ctx, user.Email, pw)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.ChangePassword(ctx, pw, models.Password("a-much-better-password"))
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		action := "changePassword"
+		events, err := client.ListAuditEvents(ctx, model.AuditEventFilter{Action: &action}, 1)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(events)).To(gm.Equal(1))
+		gm.Expect(events[0].ActorEmail).To(gm.Equal(user.Email))
+		gm.Expect(events[0].After).ToNot(gm.ContainSubstring("a-much-better-password"))
+	})
+
+	t.Run("Exported events form an intact hash chain", func(t *testing.T) {
+		export, err := client.ExportAudit(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(export.Events)).ToNot(gm.Equal(0))
+
+		// The chain hasn't been checkpointed yet so there are no signatures
+		// to verify
+		gm.Expect(export.Checkpoints).To(gm.BeEmpty())
+
+		res := audit.Verify(*export, nil)
+		gm.Expect(res.Break).To(gm.BeNil())
+		gm.Expect(res.Events).To(gm.Equal(len(export.Events)))
+
+		export.Events[0].Target = "someone-else"
+		res = audit.Verify(*export, nil)
+		gm.Expect(res.Break).ToNot(gm.BeNil())
+		gm.Expect(res.Break.Sequence).To(gm.Equal(int64(1)))
+	})
+
+	t.Run("Only admins can read the audit log", func(t *testing.T) {
+		err := client.SetOrgRole(ctx, user.Email, models.UserRole)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, user.Email, models.Password("a-much-better-password"))
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.ListAuditEvents(ctx, model.AuditEventFilter{}, 10)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
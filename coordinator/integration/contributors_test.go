@@ -34,7 +34,7 @@ func TestContributors(t *testing.T) {
 
 	t.Run("Add a contributor", func(t *testing.T) {
 		// Our admin is already the project owner, so we make a new user to test adding contributors
-		user, _, err := client.CreateUser(ctx, "Noname Mcgee", "dont@me.com")
+		user, _, err := m.CreateUser(ctx, "Noname Mcgee", "dont@me.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		contributor, err := client.AddContributor(ctx, *project, user.Email, models.ProjectContributorRole)
@@ -43,7 +43,7 @@ func TestContributors(t *testing.T) {
 	})
 
 	t.Run("Can't add a contributor twice", func(t *testing.T) {
-		user, _, err := client.CreateUser(ctx, "Double Derry", "dd@cape.com")
+		user, _, err := m.CreateUser(ctx, "Double Derry", "dd@cape.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		_, err = client.AddContributor(ctx, *project, user.Email, models.ProjectContributorRole)
@@ -75,7 +75,7 @@ func TestContributors(t *testing.T) {
 		project, err := client.CreateProject(ctx, "Unique Project", nil, "This project does great things")
 		gm.Expect(err).To(gm.BeNil())
 
-		user, _, err := client.CreateUser(ctx, "Remove McMee", "rm@cape.com")
+		user, _, err := m.CreateUser(ctx, "Remove McMee", "rm@cape.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		_, err = client.AddContributor(ctx, *project, user.Email, models.ProjectContributorRole)
@@ -0,0 +1,137 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/harness"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestInvitations(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	client, err := m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	project, err := client.CreateProject(ctx, "My Project", nil, "This project does great things")
+	gm.Expect(err).To(gm.BeNil())
+
+	email := models.Email("invited@cape.com")
+	name := models.Name("Invited Person")
+	password := models.Password("mychosenpassword")
+
+	t.Run("can invite a user who sets their own password", func(t *testing.T) {
+		invitation, err := client.CreateInvitation(ctx, email, models.UserRole, []models.InvitationProject{
+			{Label: project.Label, Role: models.ProjectContributorRole},
+		})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(invitation.Email).To(gm.Equal(email))
+
+		invitations, err := client.Invitations(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(invitations)).To(gm.Equal(1))
+		gm.Expect(invitations[0].InvitedBy.ID).To(gm.Equal(m.Admin.User.ID))
+
+		mail := h.Mails()
+		gm.Expect(len(mail)).To(gm.Equal(1))
+		gm.Expect(mail[0].To).To(gm.Equal(email))
+
+		inv := mail[0].Arguments["invitation"].(models.Invitation)
+		secret := mail[0].Arguments["secret"].(models.Password)
+
+		// The invitee is not logged in when accepting the invitation
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		err = userClient.AcceptInvitation(ctx, inv.ID, secret, name, password)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.EmailLogin(ctx, email, password)
+		gm.Expect(err).To(gm.BeNil())
+
+		role, err := userClient.MyProjectRole(ctx, project.Label)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Label).To(gm.Equal(models.ProjectContributorRole))
+
+		invitations, err = client.Invitations(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(invitations)).To(gm.Equal(0))
+
+		// The invitation is single use
+		err = userClient.AcceptInvitation(ctx, inv.ID, secret, name, password)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("resending invalidates the previous secret", func(t *testing.T) {
+		_, err := client.CreateInvitation(ctx, "resend@cape.com", models.UserRole, nil)
+		gm.Expect(err).To(gm.BeNil())
+
+		mail := h.Mails()
+		inv := mail[len(mail)-1].Arguments["invitation"].(models.Invitation)
+		oldSecret := mail[len(mail)-1].Arguments["secret"].(models.Password)
+
+		_, err = client.ResendInvitation(ctx, inv.ID)
+		gm.Expect(err).To(gm.BeNil())
+
+		mail = h.Mails()
+		newSecret := mail[len(mail)-1].Arguments["secret"].(models.Password)
+
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		err = userClient.AcceptInvitation(ctx, inv.ID, oldSecret, name, password)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		err = userClient.AcceptInvitation(ctx, inv.ID, newSecret, name, password)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("can revoke an invitation", func(t *testing.T) {
+		inv, err := client.CreateInvitation(ctx, "revoke@cape.com", models.UserRole, nil)
+		gm.Expect(err).To(gm.BeNil())
+
+		mail := h.Mails()
+		secret := mail[len(mail)-1].Arguments["secret"].(models.Password)
+
+		err = client.RevokeInvitation(ctx, inv.ID)
+		gm.Expect(err).To(gm.BeNil())
+
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		err = userClient.AcceptInvitation(ctx, inv.ID, secret, name, password)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("cannot invite an existing user", func(t *testing.T) {
+		_, err := client.CreateInvitation(ctx, m.Admin.User.Email, models.UserRole, nil)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("unauthenticated clients can only call public mutations", func(t *testing.T) {
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.Invitations(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.Equal("unknown_cause: Failed to authenticate"))
+	})
+}
@@ -0,0 +1,138 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"encoding/base32"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/harness"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestMFA(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	client, err := m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	var totp *auth.TOTP
+	var recoveryCodes []string
+
+	t.Run("can't require admin mfa without enrolling", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		err := client.SetAdminMFARequired(ctx, true)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("enroll and confirm", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		enrollment, err := client.EnrollMFA(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+		gm.Expect(err).To(gm.BeNil())
+		totp = &auth.TOTP{Secret: secret}
+
+		_, err = client.ConfirmMFA(ctx, "000000")
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		recoveryCodes, err = client.ConfirmMFA(ctx, totp.Code(time.Now()))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(recoveryCodes).ToNot(gm.BeEmpty())
+
+		status, err := client.MFAStatus(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(status.Enrolled).To(gm.BeTrue())
+		gm.Expect(status.RecoveryCodesRemaining).To(gm.Equal(len(recoveryCodes)))
+	})
+
+	t.Run("login requires a one-time code", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		c, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = c.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(errors.FromCause(err, coordinator.MFARequiredCause)).To(gm.BeTrue())
+
+		_, err = c.MFALogin(ctx, m.Admin.User.Email, m.Admin.Password, "000000")
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		// The code for the current step was used to confirm the enrollment
+		// so the next one is used to avoid tripping replay protection
+		session, err := c.MFALogin(ctx, m.Admin.User.Email, m.Admin.Password, totp.Code(time.Now().Add(auth.TOTPPeriod)))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(session.MFAVerified).To(gm.BeTrue())
+
+		client = c
+	})
+
+	t.Run("recovery codes can only be used once", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		c, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = c.MFALogin(ctx, m.Admin.User.Email, m.Admin.Password, recoveryCodes[0])
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = c.MFALogin(ctx, m.Admin.User.Email, m.Admin.Password, recoveryCodes[0])
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("admins without mfa lose admin permissions when required", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		err := client.SetAdminMFARequired(ctx, true)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.Recoveries(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.DisableMFA(ctx, recoveryCodes[1])
+		gm.Expect(err).To(gm.BeNil())
+
+		c, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		session, err := c.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(session.MFAVerified).To(gm.BeFalse())
+
+		_, err = c.Recoveries(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		// A token would let them sign in as an admin without a code
+		_, _, err = c.CreateToken(ctx, &m.Admin.User, nil)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		// The mfa verified session keeps its admin permissions
+		err = client.SetAdminMFARequired(ctx, false)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = c.Recoveries(ctx)
+		gm.Expect(err).To(gm.BeNil())
+	})
+}
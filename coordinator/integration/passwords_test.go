@@ -0,0 +1,84 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/harness"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	cfg.PasswordPolicy = models.PasswordPolicy{
+		RequireDigit: true,
+		History:      2,
+	}
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	_, err = m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	user, password, err := m.CreateUser(ctx, "Policy Person", "policy@cape.com")
+	gm.Expect(err).To(gm.BeNil())
+
+	userClient, err := h.Client()
+	gm.Expect(err).To(gm.BeNil())
+
+	_, err = userClient.EmailLogin(ctx, user.Email, password)
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("rejects passwords that break the policy", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		err := userClient.ChangePassword(ctx, password, "nodigitsinthisone")
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("invalid_password"))
+
+		err = userClient.ChangePassword(ctx, password, "password123")
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("too common"))
+	})
+
+	t.Run("rejects recently used passwords", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		first := models.Password("firstpassword1")
+		second := models.Password("secondpassword2")
+		third := models.Password("thirdpassword3")
+
+		err := userClient.ChangePassword(ctx, password, first)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = userClient.ChangePassword(ctx, first, password)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("password_reused"))
+
+		err = userClient.ChangePassword(ctx, first, second)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = userClient.ChangePassword(ctx, second, third)
+		gm.Expect(err).To(gm.BeNil())
+
+		// only the last two passwords are remembered
+		err = userClient.ChangePassword(ctx, third, password)
+		gm.Expect(err).To(gm.BeNil())
+	})
+}
@@ -275,4 +275,74 @@ func TestProjectSpecCreate(t *testing.T) {
 		gm.Expect(resp.State).To(gm.Equal(models.SuggestionPending))
 		gm.Expect(len(resp.Policy.Rules) > 0).To(gm.BeTrue())
 	})
+
+	t.Run("Destroying the project key shreds its secrets", func(t *testing.T) {
+		p, err := client.CreateProject(ctx, "shred-me", nil, "This is my project")
+		gm.Expect(err).To(gm.BeNil())
+
+		f, err := ioutil.ReadFile("./testdata/policy.yaml")
+		gm.Expect(err).To(gm.BeNil())
+
+		secretSpec, err := models.ParseProjectSpecFile(f)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, _, err = client.UpdateProjectSpec(ctx, p.Label, secretSpec)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.GetProject(ctx, "", &p.Label)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.DestroyProjectKey(ctx, p.Label)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.GetProject(ctx, "", &p.Label)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = client.DestroyProjectKey(ctx, p.Label)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Rotating a secret suggests using its new version", func(t *testing.T) {
+		p, err := client.CreateProject(ctx, "rotate-me", nil, "This is my project")
+		gm.Expect(err).To(gm.BeNil())
+
+		f, err := ioutil.ReadFile("./testdata/policy.yaml")
+		gm.Expect(err).To(gm.BeNil())
+
+		secretSpec, err := models.ParseProjectSpecFile(f)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, _, err = client.UpdateProjectSpec(ctx, p.Label, secretSpec)
+		gm.Expect(err).To(gm.BeNil())
+
+		first, err := client.RevealSecret(ctx, p.Label, "my-secret", nil)
+		gm.Expect(err).To(gm.BeNil())
+
+		suggestion, err := client.RotateSecret(ctx, p.Label, "my-secret")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(suggestion.State).To(gm.Equal(models.SuggestionPending))
+
+		secrets, err := client.ListSecrets(ctx, p.Label)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(secrets).To(gm.HaveLen(1))
+		gm.Expect(secrets[0].Versions).To(gm.Equal([]int{1, 2}))
+		gm.Expect(secrets[0].References).To(gm.HaveLen(2))
+		gm.Expect(secrets[0].References[0].Current).To(gm.BeTrue())
+		gm.Expect(secrets[0].References[1].Current).To(gm.BeFalse())
+
+		err = client.ApproveSuggestion(ctx, *suggestion)
+		gm.Expect(err).To(gm.BeNil())
+
+		second, err := client.RevealSecret(ctx, p.Label, "my-secret", nil)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(second).ToNot(gm.Equal(first))
+
+		version := models.FirstSecretVersion
+		old, err := client.RevealSecret(ctx, p.Label, "my-secret", &version)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(old).To(gm.Equal(first))
+
+		_, err = client.RotateSecret(ctx, p.Label, "not-a-secret")
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
 }
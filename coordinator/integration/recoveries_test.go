@@ -39,18 +39,19 @@ func TestRecoveries(t *testing.T) {
 	password, err := models.NewPassword("hellotestingthisout")
 	gm.Expect(err).To(gm.BeNil())
 
-	user, _, err := client.CreateUser(ctx, name, email)
+	user, _, err := m.CreateUser(ctx, name, email)
 	gm.Expect(err).To(gm.BeNil())
 
 	t.Run("can recover account successfully", func(t *testing.T) {
 		err := client.CreateRecovery(ctx, email)
 		gm.Expect(err).To(gm.BeNil())
 
+		// The first mail is the invitation used to create the user
 		mail := h.Mails()
-		gm.Expect(len(mail)).To(gm.Equal(1))
+		gm.Expect(len(mail)).To(gm.Equal(2))
 
-		recovery := mail[0].Arguments["recovery"].(models.Recovery)
-		secret := mail[0].Arguments["secret"].(models.Password)
+		recovery := mail[1].Arguments["recovery"].(models.Recovery)
+		secret := mail[1].Arguments["secret"].(models.Password)
 
 		err = client.AttemptRecovery(ctx, recovery.ID, secret, password)
 		gm.Expect(err).To(gm.BeNil())
@@ -67,7 +68,7 @@ func TestRecoveries(t *testing.T) {
 		err := client.CreateRecovery(ctx, unknownEmail)
 		gm.Expect(err).To(gm.BeNil())
 
-		gm.Expect(len(h.Mails())).To(gm.Equal(1))
+		gm.Expect(len(h.Mails())).To(gm.Equal(2))
 	})
 
 	t.Run("can't recover with wrong id", func(t *testing.T) {
@@ -75,9 +76,9 @@ func TestRecoveries(t *testing.T) {
 		gm.Expect(err).To(gm.BeNil())
 
 		mail := h.Mails()
-		gm.Expect(len(mail)).To(gm.Equal(2))
+		gm.Expect(len(mail)).To(gm.Equal(3))
 
-		secret := mail[1].Arguments["secret"].(models.Password)
+		secret := mail[2].Arguments["secret"].(models.Password)
 
 		err = client.AttemptRecovery(ctx, user.ID, secret, password)
 		gm.Expect(err).ToNot(gm.BeNil())
@@ -88,11 +89,58 @@ func TestRecoveries(t *testing.T) {
 		gm.Expect(err).To(gm.BeNil())
 
 		mail := h.Mails()
-		gm.Expect(len(mail)).To(gm.Equal(3))
+		gm.Expect(len(mail)).To(gm.Equal(4))
 
-		recovery := mail[2].Arguments["recovery"].(models.Recovery)
+		recovery := mail[3].Arguments["recovery"].(models.Recovery)
 
 		err = client.AttemptRecovery(ctx, recovery.ID, password, password)
 		gm.Expect(err).ToNot(gm.BeNil())
 	})
+
+	t.Run("can recover without being logged in", func(t *testing.T) {
+		anonClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		err = anonClient.CreateRecovery(ctx, email)
+		gm.Expect(err).To(gm.BeNil())
+
+		mail := h.Mails()
+		recovery := mail[len(mail)-1].Arguments["recovery"].(models.Recovery)
+		secret := mail[len(mail)-1].Arguments["secret"].(models.Password)
+
+		err = anonClient.AttemptRecovery(ctx, recovery.ID, secret, password)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("admin can list and delete outstanding recoveries", func(t *testing.T) {
+		recoveries, err := client.Recoveries(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		// the failed attempts above left two recoveries behind
+		gm.Expect(len(recoveries)).To(gm.Equal(2))
+		gm.Expect(recoveries[0].User.Email).To(gm.Equal(email))
+
+		ids := make([]string, len(recoveries))
+		for i, r := range recoveries {
+			ids[i] = r.ID
+		}
+
+		err = client.DeleteRecoveries(ctx, ids)
+		gm.Expect(err).To(gm.BeNil())
+
+		recoveries, err = client.Recoveries(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(recoveries)).To(gm.Equal(0))
+	})
+
+	t.Run("non admins cannot list recoveries", func(t *testing.T) {
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.EmailLogin(ctx, email, password)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.Recoveries(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
 }
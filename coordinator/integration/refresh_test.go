@@ -0,0 +1,102 @@
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/harness"
+)
+
+func TestRefreshSession(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	_, err = m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	u, err := h.URL()
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("login returns a refresh token", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		session, err := client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(session.RefreshToken).ToNot(gm.BeNil())
+		gm.Expect(session.FamilyID).To(gm.Equal(session.ID))
+	})
+
+	t.Run("refresh replaces the session", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		original, err := client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		refreshed, err := client.Refresh(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(refreshed.ID).ToNot(gm.Equal(original.ID))
+		gm.Expect(refreshed.FamilyID).To(gm.Equal(original.ID))
+		gm.Expect(refreshed.RefreshToken).ToNot(gm.Equal(original.RefreshToken))
+
+		_, err = client.Me(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		// The original session ends once it has been refreshed
+		oldClient := coordinator.NewClient(coordinator.NewHTTPTransport(u, original.Token, ""))
+		_, err = oldClient.Me(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("reusing a refresh token revokes the session family", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		original, err := client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.Refresh(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		by, err := json.Marshal(coordinator.RefreshRequest{RefreshToken: original.RefreshToken})
+		gm.Expect(err).To(gm.BeNil())
+
+		resp, err := http.Post(u.String()+"/v1/refresh", "application/json", bytes.NewBuffer(by))
+		gm.Expect(err).To(gm.BeNil())
+		resp.Body.Close()
+		gm.Expect(resp.StatusCode).To(gm.Equal(http.StatusUnauthorized))
+
+		_, err = client.Me(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = client.Refresh(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
@@ -47,7 +47,7 @@ func TestRoles(t *testing.T) {
 	})
 
 	t.Run("Can't change your own role as a member", func(t *testing.T) {
-		_, pw, err := client.CreateUser(ctx, "Cool Guy", "cool@person.com")
+		_, pw, err := m.CreateUser(ctx, "Cool Guy", "cool@person.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		_, err = client.EmailLogin(ctx, "cool@person.com", pw)
@@ -55,7 +55,7 @@ func TestRoles(t *testing.T) {
 
 		err = client.SetOrgRole(ctx, "cool@person.com", models.AdminRole)
 		gm.Expect(err).ToNot(gm.BeNil())
-		gm.Expect(err.Error()).To(gm.Equal("unknown_cause: invalid permissions to change user role"))
+		gm.Expect(err.Error()).To(gm.Equal("unknown_cause: invalid permissions: setOrgRole requires the change-role permission"))
 
 		// Return to the admin user
 		_, err = client.EmailLogin(ctx, h.Manager().Admin.User.Email, h.Manager().Admin.Password)
@@ -63,7 +63,7 @@ func TestRoles(t *testing.T) {
 	})
 
 	t.Run("Admin can change member roles", func(t *testing.T) {
-		u, _, err := client.CreateUser(ctx, "Best Friend", "bestfriend@person.com")
+		u, _, err := m.CreateUser(ctx, "Best Friend", "bestfriend@person.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		err = client.SetOrgRole(ctx, "bestfriend@person.com", models.AdminRole)
@@ -80,7 +80,7 @@ func TestRoles(t *testing.T) {
 		p, err := client.CreateProject(ctx, "Epic Project With My Friends", &label, "Who cares")
 		gm.Expect(err).To(gm.BeNil())
 
-		u, _, err := client.CreateUser(ctx, "Abc Def", "alphabet@person.com")
+		u, _, err := m.CreateUser(ctx, "Abc Def", "alphabet@person.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		_, err = client.AddContributor(ctx, *p, u.Email, models.ProjectContributorRole)
@@ -95,11 +95,94 @@ func TestRoles(t *testing.T) {
 		_, err := client.CreateProject(ctx, "Hmmmmmmm", &label, "Who cares")
 		gm.Expect(err).To(gm.BeNil())
 
-		_, _, err = client.CreateUser(ctx, "Person Person", "iexist@realperson.com")
+		_, _, err = m.CreateUser(ctx, "Person Person", "iexist@realperson.com")
 		gm.Expect(err).To(gm.BeNil())
 
 		err = client.SetProjectRole(ctx, "iexist@realperson.com", label, models.ProjectReaderRole)
 		gm.Expect(err).ToNot(gm.BeNil())
 		gm.Expect(err.Error()).To(gm.Equal("unknown_cause: provided user iexist@realperson.com not found in project epic-project-two"))
 	})
+	t.Run("Admin can create a custom project role", func(t *testing.T) {
+		role, err := client.CreateRole(ctx, "policy-reviewer", models.ProjectRoleKind,
+			[]models.Permission{models.ReadPolicy, models.AcceptPolicy})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.System).To(gm.BeFalse())
+
+		roles, err := client.ListRoles(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(roles)).To(gm.Equal(len(models.SystemRoles) + 1))
+
+		role, err = client.GetRoleByLabel(ctx, "policy-reviewer")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Kind).To(gm.Equal(models.ProjectRoleKind))
+		gm.Expect(role.Permissions).To(gm.ConsistOf(models.ReadPolicy, models.AcceptPolicy))
+	})
+
+	t.Run("Custom roles grant only their permissions", func(t *testing.T) {
+		label := models.Label("reviewed-project")
+		p, err := client.CreateProject(ctx, "Reviewed Project", &label, "Who cares")
+		gm.Expect(err).To(gm.BeNil())
+
+		u, pw, err := m.CreateUser(ctx, "Policy Reviewer", "reviewer@person.com")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.AddContributor(ctx, *p, u.Email, models.ProjectReaderRole)
+		gm.Expect(err).To(gm.BeNil())
+
+		// A project role can't be used as an org role
+		err = client.SetOrgRole(ctx, u.Email, "policy-reviewer")
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		err = client.SetProjectRole(ctx, u.Email, label, "policy-reviewer")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, u.Email, pw)
+		gm.Expect(err).To(gm.BeNil())
+
+		r, err := client.MyProjectRole(ctx, label)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(r.Label).To(gm.Equal(models.Label("policy-reviewer")))
+
+		description := models.ProjectDescription("Reviewers can't do this")
+		_, err = client.UpdateProject(ctx, "", &label, nil, &description)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		// Only admins can manage roles
+		_, err = client.CreateRole(ctx, "sneaky", models.OrgRoleKind, []models.Permission{models.ChangeRole})
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, h.Manager().Admin.User.Email, h.Manager().Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		// The role is still assigned so it can't be deleted
+		err = client.DeleteRole(ctx, "policy-reviewer")
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Admin can update and delete custom roles", func(t *testing.T) {
+		_, err := client.CreateRole(ctx, "auditor", models.OrgRoleKind, []models.Permission{models.ListRecoveries})
+		gm.Expect(err).To(gm.BeNil())
+
+		role, err := client.UpdateRole(ctx, "auditor", []models.Permission{models.ListRecoveries, models.ListAnyTokens})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Permissions).To(gm.ConsistOf(models.ListRecoveries, models.ListAnyTokens))
+
+		err = client.DeleteRole(ctx, "auditor")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.GetRoleByLabel(ctx, "auditor")
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("System roles can't be changed", func(t *testing.T) {
+		_, err := client.CreateRole(ctx, models.AdminRole, models.OrgRoleKind, []models.Permission{models.ReadPolicy})
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = client.UpdateRole(ctx, models.UserRole, []models.Permission{models.ChangeRole})
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("cannot_update_system_role"))
+
+		err = client.DeleteRole(ctx, models.ProjectReaderRole)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
 }
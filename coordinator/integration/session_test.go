@@ -8,6 +8,7 @@ import (
 
 	gm "github.com/onsi/gomega"
 
+	"github.com/capeprivacy/cape/coordinator"
 	"github.com/capeprivacy/cape/coordinator/harness"
 	"github.com/capeprivacy/cape/models"
 )
@@ -130,4 +131,65 @@ func TestSessions(t *testing.T) {
 		gm.Expect(err).ToNot(gm.BeNil())
 		gm.Expect(err.Error()).To(gm.Equal("unknown_cause: Failed to authenticate"))
 	})
+
+	t.Run("can list and revoke sessions", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		other, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		current, err := client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		otherSession, err := other.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		sessions, err := client.MySessions(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		found := map[string]coordinator.MySessionResponse{}
+		for _, s := range sessions {
+			found[s.ID] = s
+		}
+
+		gm.Expect(found).To(gm.HaveKey(current.ID))
+		gm.Expect(found).To(gm.HaveKey(otherSession.ID))
+		gm.Expect(found[current.ID].Current).To(gm.BeTrue())
+		gm.Expect(found[current.ID].Type).To(gm.Equal(models.PasswordSession))
+		gm.Expect(found[otherSession.ID].Current).To(gm.BeFalse())
+
+		err = client.RevokeSession(ctx, otherSession.ID)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = other.Me(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("can revoke all other sessions", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		other, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = other.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.RevokeAllSessions(ctx, &m.Admin.User)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = other.Me(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = client.Me(ctx)
+		gm.Expect(err).To(gm.BeNil())
+	})
 }
@@ -0,0 +1,65 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/capeprivacy/cape/coordinator/harness"
+)
+
+func TestSigningKeys(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	client, err := m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("session tokens can be verified with the published keys", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		u, err := h.URL()
+		gm.Expect(err).To(gm.BeNil())
+
+		resp, err := http.Get(u.String() + "/v1/jwks")
+		gm.Expect(err).To(gm.BeNil())
+		defer resp.Body.Close()
+
+		gm.Expect(resp.StatusCode).To(gm.Equal(http.StatusOK))
+
+		set := jose.JSONWebKeySet{}
+		err = json.NewDecoder(resp.Body).Decode(&set)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(set.Keys).To(gm.HaveLen(1))
+
+		tok, err := jwt.ParseSigned(string(*client.SessionToken()))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(tok.Headers[0].KeyID).ToNot(gm.BeEmpty())
+
+		keys := set.Key(tok.Headers[0].KeyID)
+		gm.Expect(keys).To(gm.HaveLen(1))
+
+		claims := jwt.Claims{}
+		err = tok.Claims(keys[0].Key, &claims)
+		gm.Expect(err).To(gm.BeNil())
+	})
+}
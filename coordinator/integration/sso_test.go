@@ -0,0 +1,347 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator"
+	"github.com/capeprivacy/cape/coordinator/harness"
+	"github.com/capeprivacy/cape/coordinator/oidc/oidctest"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestSSO(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+
+	verified := true
+	issuer, err := oidctest.NewIssuer(oidctest.Claims{
+		Subject:       "sso-user",
+		Email:         "sso@cape.com",
+		EmailVerified: &verified,
+		Name:          "SSO User",
+		Groups:        []string{"cape-admins"},
+	})
+	gm.Expect(err).To(gm.BeNil())
+	defer issuer.Close()
+
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	cfg.SSO = &coordinator.SSOConfig{
+		Issuer:        issuer.URL(),
+		ClientID:      oidctest.ClientID,
+		ClientSecret:  oidctest.ClientSecret,
+		AutoProvision: true,
+		GroupRoles: []coordinator.SSOGroupRole{
+			{Group: "cape-admins", Role: models.AdminRole},
+		},
+	}
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	_, err = m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	// signIn plays the part of the user's browser, following the redirects
+	// from the identity provider back to the coordinator and then entering
+	// the code to confirm the attempt
+	browser := func() *http.Client {
+		jar, err := cookiejar.New(nil)
+		gm.Expect(err).To(gm.BeNil())
+		return &http.Client{Jar: jar}
+	}
+
+	verify := func(b *http.Client, authURL string) (*url.URL, int) {
+		res, err := b.Get(authURL)
+		gm.Expect(err).To(gm.BeNil())
+		defer res.Body.Close()
+
+		return res.Request.URL, res.StatusCode
+	}
+
+	confirm := func(b *http.Client, callback *url.URL, authURL string, code string) int {
+		u, err := url.Parse(authURL)
+		gm.Expect(err).To(gm.BeNil())
+
+		confirmURL := callback.ResolveReference(&url.URL{Path: "confirm"})
+		res, err := b.PostForm(confirmURL.String(), url.Values{
+			"state": {u.Query().Get("state")},
+			"code":  {code},
+		})
+		gm.Expect(err).To(gm.BeNil())
+		defer res.Body.Close()
+
+		return res.StatusCode
+	}
+
+	signIn := func(start *coordinator.SSOStartResponse) int {
+		b := browser()
+		callback, status := verify(b, start.AuthURL)
+		if status != http.StatusOK {
+			return status
+		}
+
+		return confirm(b, callback, start.AuthURL, start.UserCode)
+	}
+
+	t.Run("provisions a new user with roles from their groups", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(errors.FromCause(err, coordinator.SSOPendingCause)).To(gm.BeTrue())
+
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusOK))
+
+		session, err := client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(session.Type).To(gm.Equal(models.SSOSession))
+
+		me, err := client.Me(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(me.Email).To(gm.Equal(models.Email("sso@cape.com")))
+
+		role, err := client.MyRole(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Label).To(gm.Equal(models.AdminRole))
+	})
+
+	t.Run("signs in an existing user", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		issuer.SetClaims(oidctest.Claims{
+			Subject:       "admin",
+			Email:         m.Admin.User.Email.String(),
+			EmailVerified: &verified,
+		})
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusOK))
+
+		session, err := client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(session.UserID).To(gm.Equal(m.Admin.User.ID))
+	})
+
+	t.Run("a session can only be collected once", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusOK))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(errors.FromCause(err, coordinator.SSOFailedCause)).To(gm.BeTrue())
+	})
+
+	t.Run("rejects unverified emails", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		unverified := false
+		issuer.SetClaims(oidctest.Claims{
+			Subject:       "unverified",
+			Email:         "unverified@cape.com",
+			EmailVerified: &unverified,
+		})
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusUnauthorized))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(errors.FromCause(err, coordinator.SSOPendingCause)).To(gm.BeTrue())
+	})
+
+	t.Run("rejects emails that aren't known to be verified", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		issuer.SetClaims(oidctest.Claims{
+			Subject: "unknown",
+			Email:   "unknown@cape.com",
+		})
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusUnauthorized))
+	})
+
+	t.Run("a session isn't released until the attempt is confirmed", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		issuer.SetClaims(oidctest.Claims{
+			Subject:       "sso-user",
+			Email:         "sso@cape.com",
+			EmailVerified: &verified,
+			Groups:        []string{"cape-admins"},
+		})
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		b := browser()
+		callback, status := verify(b, start.AuthURL)
+		gm.Expect(status).To(gm.Equal(http.StatusOK))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(errors.FromCause(err, coordinator.SSOPendingCause)).To(gm.BeTrue())
+
+		gm.Expect(confirm(b, callback, start.AuthURL, start.UserCode)).To(gm.Equal(http.StatusOK))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("an attempt can't be confirmed from another browser", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		// The requester knows the code but not the secret given to the
+		// browser that signed in
+		callback, status := verify(browser(), start.AuthURL)
+		gm.Expect(status).To(gm.Equal(http.StatusOK))
+		gm.Expect(confirm(browser(), callback, start.AuthURL, start.UserCode)).To(gm.Equal(http.StatusUnauthorized))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(errors.FromCause(err, coordinator.SSOFailedCause)).To(gm.BeTrue())
+	})
+
+	t.Run("a wrong code ends the attempt", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		b := browser()
+		callback, status := verify(b, start.AuthURL)
+		gm.Expect(status).To(gm.Equal(http.StatusOK))
+		gm.Expect(confirm(b, callback, start.AuthURL, "BCDF-GHJK")).To(gm.Equal(http.StatusUnauthorized))
+		gm.Expect(confirm(b, callback, start.AuthURL, start.UserCode)).To(gm.Equal(http.StatusUnauthorized))
+	})
+
+	t.Run("users are matched on their subject rather than email", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		// Someone else given the same email can't sign in as the user
+		issuer.SetClaims(oidctest.Claims{
+			Subject:       "someone-else",
+			Email:         "sso@cape.com",
+			EmailVerified: &verified,
+		})
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusUnauthorized))
+
+		// While the user keeps their account after their email changes
+		issuer.SetClaims(oidctest.Claims{
+			Subject:       "sso-user",
+			Email:         "renamed@cape.com",
+			EmailVerified: &verified,
+			Groups:        []string{"cape-admins"},
+		})
+
+		start, err = client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusOK))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(err).To(gm.BeNil())
+
+		me, err := client.Me(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(me.Email).To(gm.Equal(models.Email("sso@cape.com")))
+	})
+
+	t.Run("roles are removed when the user leaves a group", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		issuer.SetClaims(oidctest.Claims{
+			Subject:       "sso-user",
+			Email:         "sso@cape.com",
+			EmailVerified: &verified,
+		})
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(signIn(start)).To(gm.Equal(http.StatusOK))
+
+		_, err = client.PollSSO(ctx, start.ID, start.Secret)
+		gm.Expect(err).To(gm.BeNil())
+
+		role, err := client.MyRole(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Label).To(gm.Equal(models.UserRole))
+	})
+
+	t.Run("rejects the wrong secret", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		client, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		start, err := client.StartSSO(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.PollSSO(ctx, start.ID, models.GeneratePassword())
+		gm.Expect(errors.FromCause(err, coordinator.SSOFailedCause)).To(gm.BeTrue())
+	})
+}
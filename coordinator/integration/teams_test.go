@@ -0,0 +1,161 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/harness"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestTeams(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	client, err := m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	label := models.Label("team-project")
+	p, err := client.CreateProject(ctx, "Team Project", &label, "Who cares")
+	gm.Expect(err).To(gm.BeNil())
+
+	member, memberPw, err := m.CreateUser(ctx, "Team Member", "member@team.com")
+	gm.Expect(err).To(gm.BeNil())
+
+	maintainer, maintainerPw, err := m.CreateUser(ctx, "Team Maintainer", "maintainer@team.com")
+	gm.Expect(err).To(gm.BeNil())
+
+	other, _, err := m.CreateUser(ctx, "Someone Else", "other@team.com")
+	gm.Expect(err).To(gm.BeNil())
+
+	loginAdmin := func() {
+		_, err := client.EmailLogin(ctx, m.Admin.User.Email, m.Admin.Password)
+		gm.Expect(err).To(gm.BeNil())
+	}
+
+	t.Run("Admin can create a team", func(t *testing.T) {
+		team, err := client.CreateTeam(ctx, "data-science", "Data Science")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(team.Label).To(gm.Equal(models.Label("data-science")))
+
+		_, err = client.CreateTeam(ctx, "data-science", "Data Science")
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		teams, err := client.ListTeams(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(teams)).To(gm.Equal(1))
+	})
+
+	t.Run("Admin can add members", func(t *testing.T) {
+		err := client.AddTeamMember(ctx, "data-science", member.Email, false)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.AddTeamMember(ctx, "data-science", maintainer.Email, true)
+		gm.Expect(err).To(gm.BeNil())
+
+		team, err := client.GetTeam(ctx, "data-science")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(len(team.Members)).To(gm.Equal(2))
+	})
+
+	t.Run("Team members are granted the team's project role", func(t *testing.T) {
+		err := client.SetTeamProjectRole(ctx, "data-science", label, models.ProjectReaderRole)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, member.Email, memberPw)
+		gm.Expect(err).To(gm.BeNil())
+		defer loginAdmin()
+
+		r, err := client.MyProjectRole(ctx, label)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(r.Label).To(gm.Equal(models.ProjectReaderRole))
+	})
+
+	t.Run("A direct role stronger than the team's role is kept", func(t *testing.T) {
+		_, err := client.AddContributor(ctx, *p, member.Email, models.ProjectContributorRole)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, member.Email, memberPw)
+		gm.Expect(err).To(gm.BeNil())
+		defer loginAdmin()
+
+		r, err := client.MyProjectRole(ctx, label)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(r.Label).To(gm.Equal(models.ProjectContributorRole))
+	})
+
+	t.Run("Roles granted to a team can't be deleted", func(t *testing.T) {
+		_, err := client.CreateRole(ctx, "team-reader", models.ProjectRoleKind, []models.Permission{models.ReadPolicy})
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.SetTeamProjectRole(ctx, "data-science", label, "team-reader")
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.DeleteRole(ctx, "team-reader")
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		err = client.SetTeamProjectRole(ctx, "data-science", label, models.ProjectReaderRole)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.DeleteRole(ctx, "team-reader")
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("Maintainers can manage members", func(t *testing.T) {
+		_, err := client.EmailLogin(ctx, maintainer.Email, maintainerPw)
+		gm.Expect(err).To(gm.BeNil())
+		defer loginAdmin()
+
+		err = client.AddTeamMember(ctx, "data-science", other.Email, false)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = client.RemoveTeamMember(ctx, "data-science", other.Email)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("Members can't manage members", func(t *testing.T) {
+		_, err := client.EmailLogin(ctx, member.Email, memberPw)
+		gm.Expect(err).To(gm.BeNil())
+		defer loginAdmin()
+
+		err = client.AddTeamMember(ctx, "data-science", other.Email, false)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.Equal("unknown_cause: invalid permissions: you are not a maintainer of team data-science"))
+	})
+
+	t.Run("Removing a team from a project removes the members' role", func(t *testing.T) {
+		err := client.RemoveTeamProject(ctx, "data-science", label)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.EmailLogin(ctx, maintainer.Email, maintainerPw)
+		gm.Expect(err).To(gm.BeNil())
+		defer loginAdmin()
+
+		_, err = client.MyProjectRole(ctx, label)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("Admin can delete a team", func(t *testing.T) {
+		err := client.DeleteTeam(ctx, "data-science")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = client.GetTeam(ctx, "data-science")
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
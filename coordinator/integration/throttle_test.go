@@ -0,0 +1,155 @@
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/harness"
+	"github.com/capeprivacy/cape/coordinator/throttle"
+	"github.com/capeprivacy/cape/models"
+)
+
+func TestLoginThrottling(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	client, err := m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	policy := throttle.AccountPolicy
+	defer func() { throttle.AccountPolicy = policy }()
+
+	wrongPassword := models.Password("notmypasswordatall")
+
+	t.Run("locks the account after repeated failures", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		throttle.AccountPolicy = throttle.Policy{
+			FreeAttempts:     3,
+			LockoutThreshold: 3,
+			LockoutDuration:  time.Hour,
+			Window:           time.Hour,
+		}
+
+		user, password, err := m.CreateUser(ctx, "Locked Out", "locked@cape.com")
+		gm.Expect(err).To(gm.BeNil())
+
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		for i := 0; i < 3; i++ {
+			_, err = userClient.EmailLogin(ctx, user.Email, wrongPassword)
+			gm.Expect(err.Error()).To(gm.Equal("authentication_failure: Failed to authenticate"))
+		}
+
+		// the right password doesn't help once the account is locked
+		_, err = userClient.EmailLogin(ctx, user.Email, password)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("account_locked"))
+
+		mails := h.Mails()
+		gm.Expect(mails[len(mails)-1].Type).To(gm.Equal("account_locked"))
+		gm.Expect(mails[len(mails)-1].To).To(gm.Equal(user.Email))
+
+		err = client.UnlockUser(ctx, user)
+		gm.Expect(err).To(gm.BeNil())
+
+		session, err := userClient.EmailLogin(ctx, user.Email, password)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(session).ToNot(gm.BeNil())
+	})
+
+	t.Run("delays attempts once free attempts are used", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		throttle.AccountPolicy = throttle.Policy{
+			FreeAttempts: 1,
+			BaseDelay:    time.Hour,
+			MaxDelay:     time.Hour,
+			Window:       time.Hour,
+		}
+
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		email := models.Email("nobody@cape.com")
+		for i := 0; i < 2; i++ {
+			_, err = userClient.EmailLogin(ctx, email, wrongPassword)
+			gm.Expect(err.Error()).To(gm.Equal("authentication_failure: Failed to authenticate"))
+		}
+
+		_, err = userClient.EmailLogin(ctx, email, wrongPassword)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("throttled"))
+	})
+
+	t.Run("concurrent attempts can't slip past the delay", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		throttle.AccountPolicy = throttle.Policy{
+			FreeAttempts: 1,
+			BaseDelay:    time.Hour,
+			MaxDelay:     time.Hour,
+			Window:       time.Hour,
+		}
+
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		email := models.Email("concurrent@cape.com")
+		errs := make(chan error, 10)
+		for i := 0; i < cap(errs); i++ {
+			go func() {
+				_, err := userClient.EmailLogin(ctx, email, wrongPassword)
+				errs <- err
+			}()
+		}
+
+		attempted := 0
+		for i := 0; i < cap(errs); i++ {
+			err := <-errs
+			gm.Expect(err).ToNot(gm.BeNil())
+			if !strings.Contains(err.Error(), "throttled") {
+				attempted++
+			}
+		}
+
+		gm.Expect(attempted).To(gm.Equal(2))
+	})
+
+	t.Run("non admins cannot unlock users", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		throttle.AccountPolicy = policy
+
+		user, password, err := m.CreateUser(ctx, "Not Admin", "notadmin@cape.com")
+		gm.Expect(err).To(gm.BeNil())
+
+		userClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.EmailLogin(ctx, user.Email, password)
+		gm.Expect(err).To(gm.BeNil())
+
+		err = userClient.UnlockUser(ctx, user)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+}
@@ -5,9 +5,11 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	gm "github.com/onsi/gomega"
 
+	"github.com/capeprivacy/cape/coordinator"
 	"github.com/capeprivacy/cape/coordinator/harness"
 	"github.com/capeprivacy/cape/models"
 )
@@ -35,7 +37,7 @@ func TestTokens(t *testing.T) {
 
 	name := models.Name("HEY")
 
-	user, password, err := client.CreateUser(ctx, name, email)
+	user, password, err := m.CreateUser(ctx, name, email)
 	gm.Expect(err).To(gm.BeNil())
 
 	userClient, err := h.Client()
@@ -48,7 +50,7 @@ func TestTokens(t *testing.T) {
 		t.Run("Create a token", func(t *testing.T) {
 			gm.RegisterTestingT(t)
 
-			apiToken, token, err := client.CreateToken(ctx, &m.Admin.User)
+			apiToken, token, err := client.CreateToken(ctx, &m.Admin.User, nil)
 			gm.Expect(err).To(gm.BeNil())
 			gm.Expect(token).ToNot(gm.BeNil())
 			gm.Expect(apiToken).ToNot(gm.BeNil())
@@ -63,7 +65,7 @@ func TestTokens(t *testing.T) {
 		t.Run("Can login with a token", func(t *testing.T) {
 			gm.RegisterTestingT(t)
 
-			apiToken, _, err := client.CreateToken(ctx, &m.Admin.User)
+			apiToken, _, err := client.CreateToken(ctx, &m.Admin.User, nil)
 			gm.Expect(err).To(gm.BeNil())
 
 			err = client.Logout(ctx, m.Admin.Token)
@@ -76,7 +78,7 @@ func TestTokens(t *testing.T) {
 		t.Run("Can remove a token", func(t *testing.T) {
 			gm.RegisterTestingT(t)
 
-			apiToken, _, err := client.CreateToken(ctx, &m.Admin.User)
+			apiToken, _, err := client.CreateToken(ctx, &m.Admin.User, nil)
 			gm.Expect(err).To(gm.BeNil())
 
 			err = client.RemoveToken(ctx, apiToken.TokenID)
@@ -87,7 +89,7 @@ func TestTokens(t *testing.T) {
 			gm.RegisterTestingT(t)
 
 			for i := 0; i < 10; i++ {
-				_, _, err := client.CreateToken(ctx, &m.Admin.User)
+				_, _, err := client.CreateToken(ctx, &m.Admin.User, nil)
 				gm.Expect(err).To(gm.BeNil())
 			}
 
@@ -109,7 +111,7 @@ func TestTokens(t *testing.T) {
 		})
 
 		t.Run("can't remove token you don't own", func(t *testing.T) {
-			apiToken, _, err := client.CreateToken(ctx, &m.Admin.User)
+			apiToken, _, err := client.CreateToken(ctx, &m.Admin.User, nil)
 			gm.Expect(err).To(gm.BeNil())
 
 			err = userClient.RemoveToken(ctx, apiToken.TokenID)
@@ -117,7 +119,7 @@ func TestTokens(t *testing.T) {
 		})
 
 		t.Run("admin can remove your token though", func(t *testing.T) {
-			apiToken, _, err := userClient.CreateToken(ctx, user)
+			apiToken, _, err := userClient.CreateToken(ctx, user, nil)
 			gm.Expect(err).To(gm.BeNil())
 
 			err = client.RemoveToken(ctx, apiToken.TokenID)
@@ -125,7 +127,7 @@ func TestTokens(t *testing.T) {
 		})
 
 		t.Run("admin can list your tokens", func(t *testing.T) {
-			_, _, err := userClient.CreateToken(ctx, user)
+			_, _, err := userClient.CreateToken(ctx, user, nil)
 			gm.Expect(err).To(gm.BeNil())
 
 			tokenIDS, err := client.ListTokens(ctx, user)
@@ -135,9 +137,106 @@ func TestTokens(t *testing.T) {
 		})
 
 		t.Run("user can't create token for admin", func(t *testing.T) {
-			_, _, err := userClient.CreateToken(ctx, &m.Admin.User)
+			_, _, err := userClient.CreateToken(ctx, &m.Admin.User, nil)
 			gm.Expect(err).NotTo(gm.BeNil())
 			gm.Expect(err.Error()).To(gm.Equal("unknown_cause: invalid permissions to create a token"))
 		})
 	})
+
+	t.Run("scoped and expiring tokens", func(t *testing.T) {
+		t.Run("records when a token was last used", func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			apiToken, _, err := client.CreateToken(ctx, user, &coordinator.TokenOptions{Name: "ci"})
+			gm.Expect(err).To(gm.BeNil())
+
+			tokenClient, err := h.Client()
+			gm.Expect(err).To(gm.BeNil())
+
+			_, err = tokenClient.TokenLogin(ctx, apiToken)
+			gm.Expect(err).To(gm.BeNil())
+
+			tokens, err := client.ListTokens(ctx, user)
+			gm.Expect(err).To(gm.BeNil())
+
+			var found *models.Token
+			for _, token := range tokens {
+				if token.ID == apiToken.TokenID {
+					found = token
+				}
+			}
+
+			gm.Expect(found).ToNot(gm.BeNil())
+			gm.Expect(found.Name).To(gm.Equal("ci"))
+			gm.Expect(found.LastUsedAt).ToNot(gm.BeNil())
+			gm.Expect(found.LastUsedIP).ToNot(gm.BeEmpty())
+		})
+
+		t.Run("scope limits the session's permissions", func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			apiToken, token, err := client.CreateToken(ctx, &m.Admin.User, &coordinator.TokenOptions{
+				Scope: []models.Permission{models.ListOwnTokens},
+			})
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(token.Scope).To(gm.Equal([]models.Permission{models.ListOwnTokens}))
+
+			tokenClient, err := h.Client()
+			gm.Expect(err).To(gm.BeNil())
+
+			_, err = tokenClient.TokenLogin(ctx, apiToken)
+			gm.Expect(err).To(gm.BeNil())
+
+			_, err = tokenClient.ListTokens(ctx, &m.Admin.User)
+			gm.Expect(err).To(gm.BeNil())
+
+			_, _, err = tokenClient.CreateToken(ctx, &m.Admin.User, nil)
+			gm.Expect(err).ToNot(gm.BeNil())
+
+			_, err = tokenClient.Recoveries(ctx)
+			gm.Expect(err).ToNot(gm.BeNil())
+		})
+
+		t.Run("scope can't grant more than the user's role", func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			apiToken, _, err := userClient.CreateToken(ctx, user, &coordinator.TokenOptions{
+				Scope: []models.Permission{models.ListRecoveries},
+			})
+			gm.Expect(err).To(gm.BeNil())
+
+			tokenClient, err := h.Client()
+			gm.Expect(err).To(gm.BeNil())
+
+			_, err = tokenClient.TokenLogin(ctx, apiToken)
+			gm.Expect(err).To(gm.BeNil())
+
+			_, err = tokenClient.Recoveries(ctx)
+			gm.Expect(err).ToNot(gm.BeNil())
+		})
+
+		t.Run("can't login with an expired token", func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			expiresAt := time.Now().UTC().Add(time.Second)
+			apiToken, _, err := client.CreateToken(ctx, user, &coordinator.TokenOptions{ExpiresAt: &expiresAt})
+			gm.Expect(err).To(gm.BeNil())
+
+			time.Sleep(2 * time.Second)
+
+			tokenClient, err := h.Client()
+			gm.Expect(err).To(gm.BeNil())
+
+			_, err = tokenClient.TokenLogin(ctx, apiToken)
+			gm.Expect(err).ToNot(gm.BeNil())
+		})
+
+		t.Run("can't create a token that has already expired", func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			expiresAt := time.Now().UTC().Add(-time.Hour)
+			_, _, err := client.CreateToken(ctx, user, &coordinator.TokenOptions{ExpiresAt: &expiresAt})
+			gm.Expect(err).ToNot(gm.BeNil())
+		})
+	})
 }
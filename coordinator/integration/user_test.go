@@ -35,7 +35,7 @@ func TestUsers(t *testing.T) {
 		email := models.Email("jerry@jerry.berry")
 		name := models.Name("Jerry Berry")
 
-		result, _, err := client.CreateUser(ctx, name, email)
+		result, _, err := m.CreateUser(ctx, name, email)
 		gm.Expect(err).To(gm.BeNil())
 
 		gm.Expect(name).To(gm.Equal(result.Name))
@@ -50,13 +50,13 @@ func TestUsers(t *testing.T) {
 		n := models.Name("Lenny Bonedog")
 		e := models.Email("lenny@bonedog.com")
 
-		user, _, err := client.CreateUser(ctx, n, e)
+		user, _, err := m.CreateUser(ctx, n, e)
 		gm.Expect(err).To(gm.BeNil())
 		gm.Expect(user).ToNot(gm.BeNil())
 
 		nTwo := models.Name("Julio Tails")
 
-		secondUser, _, err := client.CreateUser(ctx, nTwo, e)
+		secondUser, _, err := m.CreateUser(ctx, nTwo, e)
 		gm.Expect(err).ToNot(gm.BeNil())
 		gm.Expect(secondUser).To(gm.BeNil())
 	})
@@ -91,14 +91,14 @@ func TestListUsers(t *testing.T) {
 	n := models.Name("Lenny Bonedog")
 	e := models.Email("lenny@bonedog.com")
 
-	user, _, err := client.CreateUser(ctx, n, e)
+	user, _, err := m.CreateUser(ctx, n, e)
 	gm.Expect(err).To(gm.BeNil())
 	gm.Expect(user).ToNot(gm.BeNil())
 
 	nTwo := models.Name("Julio Tails")
 	e2 := models.Email("bone2@bonedog.com")
 
-	_, _, err = client.CreateUser(ctx, nTwo, e2)
+	_, _, err = m.CreateUser(ctx, nTwo, e2)
 	gm.Expect(err).To(gm.BeNil())
 
 	users, err := client.ListUsers(ctx)
@@ -107,3 +107,127 @@ func TestListUsers(t *testing.T) {
 	// created two here plus admin
 	gm.Expect(len(users)).To(gm.Equal(3))
 }
+
+func TestUpdateMe(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	cfg, err := harness.NewConfig()
+	gm.Expect(err).To(gm.BeNil())
+
+	h, err := harness.NewHarness(cfg)
+	gm.Expect(err).To(gm.BeNil())
+
+	err = h.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	defer h.Teardown(ctx) // nolint: errcheck
+
+	m := h.Manager()
+	_, err = m.Setup(ctx)
+	gm.Expect(err).To(gm.BeNil())
+
+	email := models.Email("lenny@bonedog.com")
+	_, password, err := m.CreateUser(ctx, models.Name("Lenny Bonedog"), email)
+	gm.Expect(err).To(gm.BeNil())
+
+	userClient, err := h.Client()
+	gm.Expect(err).To(gm.BeNil())
+
+	_, err = userClient.EmailLogin(ctx, email, password)
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("change password invalidates other sessions", func(t *testing.T) {
+		otherClient, err := h.Client()
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = otherClient.EmailLogin(ctx, email, password)
+		gm.Expect(err).To(gm.BeNil())
+
+		newPassword := models.Password("anewandbetterpassword")
+		err = userClient.ChangePassword(ctx, password, newPassword)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.Me(ctx)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = otherClient.Me(ctx)
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		_, err = otherClient.EmailLogin(ctx, email, newPassword)
+		gm.Expect(err).To(gm.BeNil())
+
+		password = newPassword
+	})
+
+	t.Run("cannot change password with wrong current password", func(t *testing.T) {
+		err := userClient.ChangePassword(ctx, models.Password("notmypassword"), models.Password("anotherpassword"))
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("update name", func(t *testing.T) {
+		name := models.Name("Lenny Dogbone")
+		user, err := userClient.UpdateMe(ctx, &name, nil)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(user.Name).To(gm.Equal(name))
+	})
+
+	t.Run("update email requires confirmation", func(t *testing.T) {
+		newEmail := models.Email("dogbone@bonedog.com")
+		user, err := userClient.UpdateMe(ctx, nil, &newEmail)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(user.Email).To(gm.Equal(email))
+
+		// The first mail is the invitation used to create the user
+		mail := h.Mails()
+		gm.Expect(len(mail)).To(gm.Equal(2))
+		gm.Expect(mail[1].To).To(gm.Equal(newEmail))
+
+		change := mail[1].Arguments["change"].(models.EmailChange)
+		secret := mail[1].Arguments["secret"].(models.Password)
+
+		_, err = userClient.ConfirmEmailChange(ctx, change.ID, models.Password("wrongsecretvalue"))
+		gm.Expect(err).ToNot(gm.BeNil())
+
+		user, err = userClient.ConfirmEmailChange(ctx, change.ID, secret)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(user.Email).To(gm.Equal(newEmail))
+
+		me, err := userClient.Me(ctx)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(me.Email).To(gm.Equal(newEmail))
+	})
+
+	t.Run("cannot change email to one in use", func(t *testing.T) {
+		adminEmail := m.Admin.User.Email
+		_, err := userClient.UpdateMe(ctx, nil, &adminEmail)
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("cannot confirm a change to an email taken since it was requested", func(t *testing.T) {
+		taken := models.Email("taken@bonedog.com")
+		_, err := userClient.UpdateMe(ctx, nil, &taken)
+		gm.Expect(err).To(gm.BeNil())
+
+		mail := h.Mails()
+		change := mail[len(mail)-1].Arguments["change"].(models.EmailChange)
+		secret := mail[len(mail)-1].Arguments["secret"].(models.Password)
+
+		_, _, err = m.CreateUser(ctx, models.Name("Taken Bonedog"), taken)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = userClient.ConfirmEmailChange(ctx, change.ID, secret)
+		gm.Expect(err).ToNot(gm.BeNil())
+		gm.Expect(err.Error()).To(gm.ContainSubstring("email_in_use"))
+	})
+
+	t.Run("change password attempts are throttled", func(t *testing.T) {
+		var err error
+		for i := 0; i < 5; i++ {
+			err = userClient.ChangePassword(ctx, models.Password("notmypassword"), models.Password("anotherpassword"))
+			gm.Expect(err).ToNot(gm.BeNil())
+		}
+
+		gm.Expect(err.Error()).To(gm.ContainSubstring("throttled"))
+	})
+}
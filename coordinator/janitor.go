@@ -0,0 +1,162 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	capepg "github.com/capeprivacy/cape/coordinator/db/postgres"
+)
+
+// janitorLockKey identifies the postgres advisory lock held while the
+// janitor is running so only one coordinator replica does the work at a time.
+const janitorLockKey int64 = 0x63617065_6a616e69 // "capejani"
+
+// DefaultJanitorInterval is how often the janitor runs if no interval has
+// been configured
+var DefaultJanitorInterval = 10 * time.Minute
+
+// Janitor periodically purges expired sessions, refresh tokens, recoveries,
+// email changes, sso attempts, throttles, invitations and api tokens from the
+// database.
+//
+// Many coordinators can share a database, the janitor uses a postgres
+// advisory lock to ensure that only one of them runs at a time.
+type Janitor struct {
+	pool     capepg.TxPool
+	interval time.Duration
+	logger   *zerolog.Logger
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewJanitor returns a Janitor that runs every interval once started
+func NewJanitor(pool capepg.TxPool, interval time.Duration, logger *zerolog.Logger) *Janitor {
+	return &Janitor{
+		pool:     pool,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+	}
+}
+
+// JanitorResult contains the number of rows removed by a janitor run
+type JanitorResult struct {
+	Sessions      int64
+	RefreshTokens int64
+	Recoveries    int64
+	EmailChanges  int64
+	SSOAttempts   int64
+	Throttles     int64
+	Invitations   int64
+	Tokens        int64
+}
+
+// Start runs the janitor in the background until Stop is called
+func (j *Janitor) Start() {
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run()
+
+			select {
+			case <-ticker.C:
+			case <-j.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts the janitor, waiting for any in-progress run to finish
+func (j *Janitor) Stop() {
+	close(j.stop)
+	j.wg.Wait()
+}
+
+func (j *Janitor) run() {
+	start := time.Now()
+	res, ran, err := j.Run(context.Background())
+	if err != nil {
+		j.logger.Error().Err(err).Msg("Janitor failed to purge expired entities")
+		return
+	}
+
+	if !ran {
+		j.logger.Debug().Msg("Janitor skipped, another coordinator holds the lock")
+		return
+	}
+
+	j.logger.Info().
+		Int64("sessions", res.Sessions).
+		Int64("refresh_tokens", res.RefreshTokens).
+		Int64("recoveries", res.Recoveries).
+		Int64("email_changes", res.EmailChanges).
+		Int64("sso_attempts", res.SSOAttempts).
+		Int64("throttles", res.Throttles).
+		Int64("invitations", res.Invitations).
+		Int64("tokens", res.Tokens).
+		Dur("duration", time.Since(start)).
+		Msg("Janitor purged expired entities")
+}
+
+// Run performs a single pass of the janitor. It returns false if another
+// coordinator currently holds the janitor lock, in which case nothing is
+// removed.
+func (j *Janitor) Run(ctx context.Context) (*JanitorResult, bool, error) {
+	res := &JanitorResult{}
+	ran, err := capepg.WithAdvisoryLock(ctx, j.pool, janitorLockKey, func(capedb db.Interface) error {
+		var err error
+		res.Sessions, err = capedb.Session().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.RefreshTokens, err = capedb.RefreshTokens().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.Recoveries, err = capedb.Recoveries().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.EmailChanges, err = capedb.EmailChanges().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.SSOAttempts, err = capedb.SSOAttempts().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.Throttles, err = capedb.Throttles().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.Invitations, err = capedb.Invitations().DeleteExpired(ctx)
+		if err != nil {
+			return err
+		}
+
+		res.Tokens, err = capedb.Tokens().DeleteExpired(ctx)
+		return err
+	})
+	if err != nil || !ran {
+		return nil, ran, err
+	}
+
+	return res, true, nil
+}
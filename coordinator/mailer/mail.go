@@ -2,10 +2,14 @@ package mailer
 
 import (
 	"context"
+	"time"
 
 	"github.com/capeprivacy/cape/models"
 )
 
 type Mailer interface {
 	SendAccountRecovery(context.Context, models.User, models.Recovery, models.Password) error
+	SendEmailChangeConfirmation(context.Context, models.User, models.EmailChange, models.Password) error
+	SendInvitation(context.Context, models.Invitation, models.Password) error
+	SendAccountLocked(context.Context, models.User, time.Time) error
 }
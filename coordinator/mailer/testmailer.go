@@ -2,6 +2,7 @@ package mailer
 
 import (
 	"context"
+	"time"
 
 	"github.com/capeprivacy/cape/models"
 )
@@ -30,3 +31,44 @@ func (tm *TestMailer) SendAccountRecovery(
 
 	return nil
 }
+
+func (tm *TestMailer) SendEmailChangeConfirmation(
+	ctx context.Context, user models.User, change models.EmailChange, secret models.Password) error {
+	tm.Mails = append(tm.Mails, &TestMail{
+		To:   change.Email,
+		Type: "email_change_confirmation",
+		Arguments: map[string]interface{}{
+			"user":   user,
+			"change": change,
+			"secret": secret,
+		},
+	})
+
+	return nil
+}
+
+func (tm *TestMailer) SendInvitation(ctx context.Context, invitation models.Invitation, secret models.Password) error {
+	tm.Mails = append(tm.Mails, &TestMail{
+		To:   invitation.Email,
+		Type: "invitation",
+		Arguments: map[string]interface{}{
+			"invitation": invitation,
+			"secret":     secret,
+		},
+	})
+
+	return nil
+}
+
+func (tm *TestMailer) SendAccountLocked(ctx context.Context, user models.User, until time.Time) error {
+	tm.Mails = append(tm.Mails, &TestMail{
+		To:   user.Email,
+		Type: "account_locked",
+		Arguments: map[string]interface{}{
+			"user":  user,
+			"until": until,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+// verifyMFA checks the one-time code provided at login against the user's
+// confirmed enrollment, returning whether the code was verified. Users that
+// haven't enrolled don't need to provide a code.
+func verifyMFA(ctx context.Context, backend db.Interface, userID string, code string) (bool, error) {
+	enrollment, err := backend.MFA().Get(ctx, userID)
+	if err == db.ErrCannotFindMFAEnrollment {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !enrollment.Confirmed {
+		return false, nil
+	}
+
+	if code == "" {
+		return false, ErrMFARequired
+	}
+
+	if !auth.VerifyMFA(enrollment, code, true) {
+		return false, auth.ErrAuthentication
+	}
+
+	err = backend.MFA().Update(ctx, *enrollment)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// enforceAdminMFA downgrades the global role of admins who signed in with
+// only their password to the user role when the org requires admins to use
+// multi-factor authentication. They keep enough access to enroll and then
+// sign in again with a one-time code, but can't create API tokens as those
+// would let them sign in again with their admin role.
+//
+// A role counts as an admin role when it grants any permission the user role
+// doesn't, so custom org roles are enforced too. Sessions from API tokens and
+// single sign on aren't affected as neither is protected by Cape's one-time
+// codes.
+func enforceAdminMFA(ctx context.Context, backend db.Interface, session *models.Session, roles *models.UserRoles) error {
+	if roles.Global.Rules()&^models.DefaultPermissions[models.UserRole] == 0 {
+		return nil
+	}
+
+	if session.Type != models.PasswordSession || session.MFAVerified {
+		return nil
+	}
+
+	cfg, err := backend.Config().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !cfg.RequireAdminMFA {
+		return nil
+	}
+
+	role, err := backend.Roles().Get(ctx, models.UserRole)
+	if err != nil {
+		return err
+	}
+
+	scope := role.Rules() &^ (models.CreateOwnToken | models.CreateAnyToken)
+	role.Scope = &scope
+
+	roles.Global = *role
+	return nil
+}
@@ -0,0 +1,78 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+)
+
+type mfaTestDB struct {
+	db.Interface
+	config mfaTestConfig
+	roles  mfaTestRoles
+}
+
+func (m *mfaTestDB) Config() db.ConfigDB { return &m.config }
+func (m *mfaTestDB) Roles() db.RoleDB    { return &m.roles }
+
+type mfaTestConfig struct {
+	db.ConfigDB
+	requireAdminMFA bool
+}
+
+func (m *mfaTestConfig) Get(context.Context) (*models.Config, error) {
+	return &models.Config{RequireAdminMFA: m.requireAdminMFA}, nil
+}
+
+type mfaTestRoles struct {
+	db.RoleDB
+}
+
+func (m *mfaTestRoles) Get(_ context.Context, label models.Label) (*models.Role, error) {
+	role := models.NewRole(label, true)
+	return &role, nil
+}
+
+func TestEnforceAdminMFA(t *testing.T) {
+	ctx := context.Background()
+	backend := &mfaTestDB{config: mfaTestConfig{requireAdminMFA: true}}
+
+	custom := models.NewCustomRole("auditor", models.OrgRoleKind, []models.Permission{models.ReadAuditLog})
+
+	tests := []struct {
+		name       string
+		role       models.Role
+		session    models.Session
+		downgraded bool
+	}{
+		{"admin without mfa", models.NewRole(models.AdminRole, true), models.Session{Type: models.PasswordSession}, true},
+		{"admin with mfa", models.NewRole(models.AdminRole, true), models.Session{Type: models.PasswordSession, MFAVerified: true}, false},
+		{"admin using a token", models.NewRole(models.AdminRole, true), models.Session{Type: models.TokenSession}, false},
+		{"custom role granting admin permissions", custom, models.Session{Type: models.PasswordSession}, true},
+		{"user", models.NewRole(models.UserRole, true), models.Session{Type: models.PasswordSession}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			roles := &models.UserRoles{Global: tc.role}
+			err := enforceAdminMFA(ctx, backend, &tc.session, roles)
+			gm.Expect(err).To(gm.BeNil())
+
+			if !tc.downgraded {
+				gm.Expect(roles.Global.Label).To(gm.Equal(tc.role.Label))
+				return
+			}
+
+			gm.Expect(roles.Global.Label).To(gm.Equal(models.UserRole))
+			gm.Expect(roles.Global.Can(models.ReadAuditLog)).To(gm.BeFalse())
+			gm.Expect(roles.Global.Can(models.CreateOwnToken)).To(gm.BeFalse())
+			gm.Expect(roles.Global.Can(models.CreateProject)).To(gm.BeTrue())
+		})
+	}
+}
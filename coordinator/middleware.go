@@ -15,6 +15,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
 	fw "github.com/capeprivacy/cape/framework"
 	errors "github.com/capeprivacy/cape/partyerrors"
 )
@@ -51,6 +52,16 @@ func LogMiddleware(log *zerolog.Logger) func(http.Handler) http.Handler {
 	}
 }
 
+// RemoteIPMiddleware sets the ip address the request came from on the
+// request context so it's available to graphql resolvers
+func RemoteIPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		ctx := context.WithValue(req.Context(), fw.RemoteIPContextKey, remoteIP(req))
+		req = req.WithContext(ctx)
+		next.ServeHTTP(rw, req)
+	})
+}
+
 // AuthTokenMiddleware sets the session ID on the request context for us in
 // graphql handlers and elsewhere
 func AuthTokenMiddleware(next http.Handler) http.Handler {
@@ -163,62 +174,129 @@ func respondWithJSON(rw http.ResponseWriter, code int, out interface{}) {
 func IsAuthenticatedMiddleware(coordinator *Coordinator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-			ctx := req.Context()
-
-			ta := coordinator.tokenAuth
-			capedb := coordinator.db
-
-			logger := fw.Logger(ctx)
-			token := fw.AuthToken(ctx)
-
-			if token == nil {
-				logger.Info().Msg("Could not authenticate. Token missing")
-				respondWithError(rw, req.URL.Path, auth.ErrAuthentication)
-				return
-			}
-
-			id, err := ta.Verify(token)
+			ctx, err := authenticate(req.Context(), coordinator)
 			if err != nil {
-				msg := "Could not authenticate. Unable to verify auth token"
-				logger.Info().Err(err).Msg(msg)
-				respondWithError(rw, req.URL.Path, auth.ErrAuthentication)
+				respondWithError(rw, req.URL.Path, err)
 				return
 			}
 
-			session, err := capedb.Session().Get(ctx, id)
-			if err != nil {
-				msg := "Could not authenticate. Unable to find session"
-				logger.Info().Err(err).Msg(msg)
-				respondWithError(rw, req.URL.Path, auth.ErrAuthentication)
-				return
-			}
+			next.ServeHTTP(rw, req.WithContext(ctx))
+		})
+	}
+}
 
-			user, err := capedb.Users().GetByID(ctx, session.UserID)
-			if err != nil {
-				respondWithError(rw, req.URL.Path, err)
+// MaybeAuthenticatedMiddleware attempts to authenticate the request but
+// allows it to continue unauthenticated if the token is missing, invalid or
+// has no session. Any other error is returned to the caller. It's up to the
+// downstream handler to reject anything that requires a session, see
+// PublicFieldMiddleware.
+func MaybeAuthenticatedMiddleware(coordinator *Coordinator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			ctx, err := authenticate(req.Context(), coordinator)
+			if err == auth.ErrAuthentication {
+				next.ServeHTTP(rw, req)
 				return
 			}
-
-			roles, err := capedb.Roles().GetAll(ctx, session.UserID)
 			if err != nil {
 				respondWithError(rw, req.URL.Path, err)
 				return
 			}
 
-			aSession, err := auth.NewSession(user, session, *roles)
-			if err != nil {
-				respondWithError(rw, req.URL.Path, err)
-				return
-			}
+			next.ServeHTTP(rw, req.WithContext(ctx))
+		})
+	}
+}
 
-			logger = logger.With().Str("user_id", aSession.GetID()).Logger()
+// PublicFieldMiddleware rejects any top level query or mutation that has not
+// been marked with the @public directive if the request is not
+// authenticated.
+func PublicFieldMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc.Object != "Query" && fc.Object != "Mutation" {
+		return next(ctx)
+	}
 
-			ctx = context.WithValue(ctx, fw.LoggerContextKey, logger)
-			ctx = context.WithValue(ctx, fw.SessionContextKey, aSession)
+	if fw.Authenticated(ctx) {
+		return next(ctx)
+	}
 
-			req = req.WithContext(ctx)
+	if fc.Field.Definition != nil && fc.Field.Definition.Directives.ForName("public") != nil {
+		return next(ctx)
+	}
 
-			next.ServeHTTP(rw, req)
-		})
+	logger := fw.Logger(ctx)
+	logger.Info().Str("field", fc.Field.Name).Msg("Could not authenticate. Field is not public")
+	return nil, auth.ErrAuthentication
+}
+
+// PublicDirective implements the @public schema directive. The directive only
+// exists to mark fields for the PublicFieldMiddleware so there is nothing to
+// do here other than resolve the field.
+func PublicDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	return next(ctx)
+}
+
+// authenticate looks up the session for the auth token stored on the context
+// and returns a new context containing the session.
+func authenticate(ctx context.Context, coordinator *Coordinator) (context.Context, error) {
+	ta := coordinator.tokenAuth
+	capedb := coordinator.db
+
+	logger := fw.Logger(ctx)
+	token := fw.AuthToken(ctx)
+
+	if token == nil {
+		logger.Info().Msg("Could not authenticate. Token missing")
+		return nil, auth.ErrAuthentication
+	}
+
+	id, err := ta.Verify(token)
+	if err != nil {
+		msg := "Could not authenticate. Unable to verify auth token"
+		logger.Info().Err(err).Msg(msg)
+		return nil, auth.ErrAuthentication
 	}
+
+	session, err := capedb.Session().Get(ctx, id)
+	if err == db.ErrCannotFindSession {
+		msg := "Could not authenticate. Unable to find session"
+		logger.Info().Err(err).Msg(msg)
+		return nil, auth.ErrAuthentication
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("Could not retrieve session")
+		return nil, err
+	}
+
+	user, err := capedb.Users().GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := capedb.Roles().GetAll(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = enforceAdminMFA(ctx, capedb, session, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(session.Scope) > 0 {
+		roles.Restrict(session.Scope)
+	}
+
+	aSession, err := auth.NewSession(user, session, *roles)
+	if err != nil {
+		return nil, err
+	}
+
+	logger = logger.With().Str("user_id", aSession.GetID()).Logger()
+
+	ctx = context.WithValue(ctx, fw.LoggerContextKey, logger)
+	ctx = context.WithValue(ctx, fw.SessionContextKey, aSession)
+
+	return ctx, nil
 }
@@ -1,19 +1,25 @@
 package coordinator
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/gofrs/uuid"
 	"github.com/justinas/alice"
 	"github.com/manifoldco/go-base64"
 	gm "github.com/onsi/gomega"
 	"github.com/rs/zerolog"
+	"github.com/vektah/gqlparser/v2/ast"
 
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
 	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
 	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
@@ -166,3 +172,131 @@ func TestAuthTokenMiddleware(t *testing.T) {
 		gm.Expect(errors.CausedBy(gResp, errors.UnsupportedErrorCause)).To(gm.BeTrue())
 	})
 }
+
+func TestPublicFieldMiddleware(t *testing.T) {
+	public := &ast.FieldDefinition{
+		Name:       "acceptInvitation",
+		Directives: ast.DirectiveList{{Name: "public"}},
+	}
+	private := &ast.FieldDefinition{Name: "invitations"}
+
+	fieldCtx := func(object string, def *ast.FieldDefinition, session *auth.Session) context.Context {
+		ctx := context.WithValue(context.Background(), fw.LoggerContextKey, *logger)
+		if session != nil {
+			ctx = context.WithValue(ctx, fw.SessionContextKey, session)
+		}
+
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object: object,
+			Field: graphql.CollectedField{
+				Field: &ast.Field{Name: def.Name, Definition: def},
+			},
+		})
+	}
+
+	next := func(ctx context.Context) (interface{}, error) {
+		return "resolved", nil
+	}
+
+	tests := []struct {
+		name     string
+		object   string
+		def      *ast.FieldDefinition
+		session  *auth.Session
+		expected error
+	}{
+		{"public field without a session", "Mutation", public, nil, nil},
+		{"private field without a session", "Query", private, nil, auth.ErrAuthentication},
+		{"private field with a session", "Query", private, &auth.Session{}, nil},
+		{"nested field without a session", "Invitation", private, nil, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			res, err := PublicFieldMiddleware(fieldCtx(tc.object, tc.def, tc.session), next)
+			if tc.expected != nil {
+				gm.Expect(err).To(gm.Equal(tc.expected))
+				gm.Expect(res).To(gm.BeNil())
+				return
+			}
+
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(res).To(gm.Equal("resolved"))
+		})
+	}
+}
+
+type sessionTestDB struct {
+	db.Interface
+	sessions sessionTestSessions
+}
+
+func (s *sessionTestDB) Session() db.SessionDB { return &s.sessions }
+
+type sessionTestSessions struct {
+	db.SessionDB
+	err error
+}
+
+func (s *sessionTestSessions) Get(context.Context, string) (*models.Session, error) {
+	return nil, s.err
+}
+
+func TestMaybeAuthenticatedMiddleware(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	keypair, err := auth.NewKeypair()
+	gm.Expect(err).To(gm.BeNil())
+
+	tokenAuth, err := auth.NewTokenAuthority(keypair, "cape")
+	gm.Expect(err).To(gm.BeNil())
+
+	token, _, err := tokenAuth.Generate("session-id")
+	gm.Expect(err).To(gm.BeNil())
+
+	tests := []struct {
+		name       string
+		token      *base64.Value
+		sessionErr error
+		continues  bool
+	}{
+		{"continues without a token", nil, nil, true},
+		{"continues with an invalid token", base64.New([]byte("not-a-token")), nil, true},
+		{"continues without a session", token, db.ErrCannotFindSession, true},
+		{"responds with database errors", token, errors.New(errors.UnknownCause, "connection refused"), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gm.RegisterTestingT(t)
+
+			c := &Coordinator{
+				tokenAuth: tokenAuth,
+				db:        &sessionTestDB{sessions: sessionTestSessions{err: tc.sessionErr}},
+			}
+
+			wasCalled := false
+			next := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				wasCalled = true
+				gm.Expect(fw.Authenticated(r.Context())).To(gm.BeFalse())
+			})
+
+			ctx := context.WithValue(context.Background(), fw.LoggerContextKey, *logger)
+			if tc.token != nil {
+				ctx = context.WithValue(ctx, fw.AuthTokenContextKey, tc.token)
+			}
+
+			req := httptest.NewRequest("GET", "http://my.capeprivacy.com", nil).WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			MaybeAuthenticatedMiddleware(c)(next).ServeHTTP(w, req)
+
+			gm.Expect(wasCalled).To(gm.Equal(tc.continues))
+			if !tc.continues {
+				gm.Expect(w.Result().StatusCode).To(gm.Equal(http.StatusInternalServerError))
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE email_changes (
+  id char(29) primary key not null,
+  user_id char(29) references users(id) on delete cascade not null,
+  data jsonb not null,
+  CONSTRAINT email_changes_id_check CHECK (data::jsonb#>>'{id}' = id),
+  CONSTRAINT email_changes_user_id_check CHECK (data::jsonb#>>'{user_id}' = user_id)
+);
+
+CREATE TRIGGER email_changes_hoist_tgr
+  BEFORE INSERT ON email_changes
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'user_id');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE email_changes;
+COMMIT;
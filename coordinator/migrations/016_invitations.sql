@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE invitations (
+  id char(29) primary key not null,
+  data jsonb not null,
+  CONSTRAINT invitations_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE UNIQUE INDEX invitations_email_idx ON invitations((data::jsonb#>>'{email}'));
+
+CREATE TRIGGER invitations_hoist_tgr
+  BEFORE INSERT ON invitations
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE invitations;
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE sso_attempts (
+  id char(29) primary key not null,
+  data jsonb not null,
+  CONSTRAINT sso_attempts_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE UNIQUE INDEX sso_attempts_state_idx ON sso_attempts((data::jsonb#>>'{state}'));
+
+CREATE TRIGGER sso_attempts_hoist_tgr
+  BEFORE INSERT ON sso_attempts
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE sso_attempts;
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE mfa_enrollments (
+  id char(29) primary key not null,
+  user_id char(29) references users(id) on delete cascade unique not null,
+  data jsonb not null,
+  CONSTRAINT mfa_enrollments_id_check CHECK (data::jsonb#>>'{id}' = id),
+  CONSTRAINT mfa_enrollments_user_id_check CHECK (data::jsonb#>>'{user_id}' = user_id)
+);
+
+CREATE TRIGGER mfa_enrollments_hoist_tgr
+  BEFORE INSERT ON mfa_enrollments
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'user_id');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE mfa_enrollments;
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE refresh_tokens (
+  id char(29) primary key not null,
+  user_id char(29) references users(id) on delete cascade not null,
+  data jsonb not null,
+  CONSTRAINT refresh_tokens_id_check CHECK (data::jsonb#>>'{id}' = id),
+  CONSTRAINT refresh_tokens_user_id_check CHECK (data::jsonb#>>'{user_id}' = user_id)
+);
+
+CREATE UNIQUE INDEX refresh_tokens_hash_idx ON refresh_tokens((data::jsonb#>>'{hash}'));
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens((data::jsonb#>>'{family_id}'));
+CREATE INDEX sessions_family_id_idx ON sessions((data::jsonb#>>'{family_id}'));
+
+CREATE TRIGGER refresh_tokens_hoist_tgr
+  BEFORE INSERT ON refresh_tokens
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'user_id');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP INDEX sessions_family_id_idx;
+DROP TABLE refresh_tokens;
+COMMIT;
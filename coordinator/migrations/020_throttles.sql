@@ -0,0 +1,24 @@
+BEGIN;
+
+-- Throttles count failed attempts to guess a secret, such as a password or
+-- recovery secret, against an account or ip address. They're counters
+-- updated in place by every coordinator so they use columns rather than
+-- a jsonb document.
+CREATE TABLE throttles (
+  key text primary key not null,
+  failures integer not null default 0,
+  last_failure_at timestamptz not null,
+  blocked_until timestamptz,
+  locked_until timestamptz,
+  expires_at timestamptz not null
+);
+
+CREATE INDEX throttles_expires_at_idx ON throttles(expires_at);
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE throttles;
+COMMIT;
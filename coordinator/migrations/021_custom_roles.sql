@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Roles now record whether they're assigned within the org or a project so
+-- custom roles can be created alongside the builtin ones.
+UPDATE roles SET data = data || '{"kind": "org"}'::jsonb
+  WHERE data::jsonb#>>'{label}' IN ('admin', 'user');
+
+UPDATE roles SET data = data || '{"kind": "project"}'::jsonb
+  WHERE data::jsonb#>>'{label}' IN ('project-owner', 'project-contributor', 'project-reader');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DELETE FROM roles WHERE NOT coalesce((data::jsonb#>>'{system}')::boolean, false);
+UPDATE roles SET data = data - 'kind' - 'permissions';
+COMMIT;
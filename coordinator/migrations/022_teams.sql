@@ -0,0 +1,54 @@
+BEGIN;
+
+CREATE TABLE teams (
+  id char(29) primary key not null,
+  data jsonb not null,
+  CONSTRAINT teams_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE UNIQUE INDEX teams_label_idx ON teams((data::jsonb#>>'{label}'));
+
+CREATE TRIGGER teams_hoist_tgr
+  BEFORE INSERT ON teams
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id');
+
+CREATE TABLE team_members (
+  id char(29) primary key not null,
+  team_id char(29) references teams(id) on delete cascade not null,
+  user_id char(29) references users(id) on delete cascade not null,
+  data jsonb not null,
+  CONSTRAINT team_members_id_check CHECK (data::jsonb#>>'{id}' = id),
+  CONSTRAINT team_member unique (team_id, user_id)
+);
+
+CREATE INDEX team_members_user_id_idx ON team_members(user_id);
+
+CREATE TRIGGER team_members_hoist_tgr
+  BEFORE INSERT ON team_members
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'team_id', 'user_id');
+
+-- Team projects grant every member of a team a role within a project. A
+-- role can't be deleted while it's granted to a team.
+CREATE TABLE team_projects (
+  id char(29) primary key not null,
+  team_id char(29) references teams(id) on delete cascade not null,
+  project_id char(29) references projects(id) on delete cascade not null,
+  role_id char(29) references roles(id) not null,
+  data jsonb not null,
+  CONSTRAINT team_projects_id_check CHECK (data::jsonb#>>'{id}' = id),
+  CONSTRAINT team_project unique (team_id, project_id)
+);
+
+CREATE TRIGGER team_projects_hoist_tgr
+  BEFORE INSERT ON team_projects
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'team_id', 'project_id', 'role_id');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE team_projects;
+DROP TABLE team_members;
+DROP TABLE teams;
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+CREATE TABLE audit_events (
+  id char(29) primary key not null,
+  data jsonb not null,
+  created_at timestamptz not null default now(),
+  CONSTRAINT audit_events_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events(created_at);
+CREATE INDEX audit_events_actor_idx ON audit_events((data::jsonb#>>'{actor_email}'));
+CREATE INDEX audit_events_action_idx ON audit_events((data::jsonb#>>'{action}'));
+
+CREATE TRIGGER audit_events_hoist_tgr
+  BEFORE INSERT ON audit_events
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'created_at');
+
+-- The audit log is append-only, events can never be changed or removed
+-- once they've been recorded
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+  BEGIN
+    RAISE EXCEPTION 'audit events cannot be modified';
+  END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only_tgr
+  BEFORE UPDATE OR DELETE ON audit_events
+  FOR EACH ROW EXECUTE PROCEDURE audit_events_append_only();
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE audit_events;
+DROP FUNCTION audit_events_append_only();
+COMMIT;
@@ -0,0 +1,60 @@
+BEGIN;
+
+-- Events are hash chained in the order they're appended, the sequence
+-- records each event's position in the chain
+ALTER TABLE audit_events ADD COLUMN sequence bigint;
+
+-- Events recorded before chaining was introduced are numbered in the order
+-- they were created, they don't have a hash so the chain starts after them
+ALTER TABLE audit_events DISABLE TRIGGER audit_events_append_only_tgr;
+
+UPDATE audit_events SET
+  sequence = ordered.sequence,
+  data = data || jsonb_build_object('sequence', ordered.sequence)
+FROM (
+  SELECT id, row_number() OVER (ORDER BY created_at, id) AS sequence FROM audit_events
+) ordered
+WHERE audit_events.id = ordered.id;
+
+ALTER TABLE audit_events ENABLE TRIGGER audit_events_append_only_tgr;
+
+ALTER TABLE audit_events ALTER COLUMN sequence SET NOT NULL;
+CREATE UNIQUE INDEX audit_events_sequence_idx ON audit_events(sequence);
+
+DROP TRIGGER audit_events_hoist_tgr ON audit_events;
+CREATE TRIGGER audit_events_hoist_tgr
+  BEFORE INSERT ON audit_events
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'created_at', 'sequence');
+
+CREATE TABLE audit_checkpoints (
+  id char(29) primary key not null,
+  data jsonb not null,
+  sequence bigint not null,
+  created_at timestamptz not null default now(),
+  CONSTRAINT audit_checkpoints_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE UNIQUE INDEX audit_checkpoints_sequence_idx ON audit_checkpoints(sequence);
+
+CREATE TRIGGER audit_checkpoints_hoist_tgr
+  BEFORE INSERT ON audit_checkpoints
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'sequence', 'created_at');
+
+CREATE TRIGGER audit_checkpoints_append_only_tgr
+  BEFORE UPDATE OR DELETE ON audit_checkpoints
+  FOR EACH ROW EXECUTE PROCEDURE audit_events_append_only();
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE audit_checkpoints;
+
+DROP TRIGGER audit_events_hoist_tgr ON audit_events;
+CREATE TRIGGER audit_events_hoist_tgr
+  BEFORE INSERT ON audit_events
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'created_at');
+
+ALTER TABLE audit_events DROP COLUMN sequence;
+COMMIT;
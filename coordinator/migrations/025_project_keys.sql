@@ -0,0 +1,38 @@
+BEGIN;
+
+-- Each project's secrets are encrypted with the project's own data key,
+-- deleting the key crypto-shreds the project's secrets
+CREATE TABLE project_keys (
+  id char(29) primary key not null,
+  project_id char(29) references projects(id) on delete cascade not null,
+  data jsonb not null,
+  CONSTRAINT project_keys_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE UNIQUE INDEX project_keys_project_id_idx ON project_keys(project_id);
+
+CREATE TRIGGER project_keys_hoist_tgr
+  BEFORE INSERT ON project_keys
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'project_id');
+
+-- Secrets are named within their project. Secrets created before projects
+-- had keys don't belong to a project and stay encrypted with the data key.
+ALTER TABLE secrets ADD COLUMN project_id char(29) references projects(id) on delete cascade;
+ALTER TABLE secrets DROP CONSTRAINT secrets_pkey;
+
+CREATE UNIQUE INDEX secrets_project_name_idx ON secrets((coalesce(project_id, '')), name);
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+
+DROP INDEX secrets_project_name_idx;
+DELETE FROM secrets WHERE project_id IS NOT NULL;
+ALTER TABLE secrets DROP COLUMN project_id;
+ALTER TABLE secrets ADD PRIMARY KEY (name);
+
+DROP TABLE project_keys;
+
+COMMIT;
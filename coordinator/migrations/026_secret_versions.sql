@@ -0,0 +1,56 @@
+BEGIN;
+
+-- Rotating a secret creates a new version of it, specs keep using the
+-- version they were written with until they're updated
+ALTER TABLE secrets ADD COLUMN version integer;
+UPDATE secrets SET version = 1, data = data || '{"version": 1}'::jsonb;
+ALTER TABLE secrets ALTER COLUMN version SET NOT NULL;
+ALTER TABLE secrets ADD CONSTRAINT secret_version_check CHECK ((data::jsonb#>>'{version}')::integer = version);
+
+DROP INDEX secrets_project_name_idx;
+CREATE UNIQUE INDEX secrets_project_name_version_idx ON secrets((coalesce(project_id, '')), name, version);
+
+DROP TRIGGER secrets_hoist_tgr ON secrets;
+CREATE TRIGGER secrets_hoist_tgr
+  BEFORE INSERT ON secrets
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('name', 'version');
+
+-- Specs are encrypted so the secrets each spec uses are recorded alongside
+-- it, letting the specs that depend on a secret be found
+CREATE TABLE secret_references (
+  id char(29) primary key not null,
+  project_id char(29) references projects(id) on delete cascade not null,
+  spec_id char(29) references project_specs(id) on delete cascade not null,
+  name text not null,
+  version integer not null,
+  data jsonb not null,
+  CONSTRAINT secret_references_id_check CHECK (data::jsonb#>>'{id}' = id)
+);
+
+CREATE UNIQUE INDEX secret_references_spec_idx ON secret_references(spec_id, name, version);
+CREATE INDEX secret_references_project_name_idx ON secret_references(project_id, name);
+
+CREATE TRIGGER secret_references_hoist_tgr
+  BEFORE INSERT ON secret_references
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'project_id', 'spec_id', 'name', 'version');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+
+DROP TABLE secret_references;
+
+DROP TRIGGER secrets_hoist_tgr ON secrets;
+CREATE TRIGGER secrets_hoist_tgr
+  BEFORE INSERT ON secrets
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('name');
+
+DROP INDEX secrets_project_name_version_idx;
+DELETE FROM secrets WHERE version > 1;
+ALTER TABLE secrets DROP COLUMN version;
+UPDATE secrets SET data = data - 'version';
+CREATE UNIQUE INDEX secrets_project_name_idx ON secrets((coalesce(project_id, '')), name);
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+-- Users signing in through the identity provider are matched on the issuer
+-- and subject of their identity, which are never reassigned, rather than on
+-- their email
+CREATE TABLE sso_identities (
+  id char(29) primary key not null,
+  user_id char(29) references users(id) on delete cascade not null,
+  issuer text not null,
+  subject text not null,
+  data jsonb not null,
+  CONSTRAINT sso_identities_id_check CHECK (data::jsonb#>>'{id}' = id),
+  CONSTRAINT sso_identity unique (issuer, subject),
+  CONSTRAINT sso_identity_user unique (user_id, issuer)
+);
+
+CREATE TRIGGER sso_identities_hoist_tgr
+  BEFORE INSERT ON sso_identities
+  FOR EACH ROW EXECUTE PROCEDURE hoist_values('id', 'user_id', 'issuer', 'subject');
+
+COMMIT;
+
+---- create above / drop below ----
+
+BEGIN;
+DROP TABLE sso_identities;
+COMMIT;
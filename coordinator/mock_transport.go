@@ -31,6 +31,8 @@ type MockClientTransport struct {
 	Responses []*MockResponse
 	Counter   int
 	token     *base64.Value
+
+	refreshToken *base64.Value
 }
 
 func NewMockClientTransport(url *models.URL, responses []*MockResponse) (*MockClientTransport, error) {
@@ -84,12 +86,21 @@ func (m *MockClientTransport) Token() *base64.Value {
 	return m.token
 }
 
+func (m *MockClientTransport) SetSession(session *models.Session) {
+	m.token = session.Token
+	m.refreshToken = session.RefreshToken
+}
+
+func (m *MockClientTransport) Refresh(ctx context.Context) (*models.Session, error) {
+	return refresh(ctx, m, m.refreshToken)
+}
+
 func (m *MockClientTransport) TokenLogin(ctx context.Context, apiToken *auth.APIToken) (*models.Session, error) {
 	return tokenLogin(ctx, m, apiToken)
 }
 
 func (m *MockClientTransport) EmailLogin(ctx context.Context, email models.Email, password models.Password) (*models.Session, error) {
-	return emailLogin(ctx, m, email, password)
+	return emailLogin(ctx, m, email, password, "")
 }
 
 func (m *MockClientTransport) Logout(ctx context.Context, authToken *base64.Value) error {
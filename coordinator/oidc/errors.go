@@ -0,0 +1,12 @@
+package oidc
+
+import (
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+var (
+	InvalidConfigCause   = errors.NewCause(errors.BadRequestCategory, "invalid_oidc_config")
+	DiscoveryFailedCause = errors.NewCause(errors.InternalServerErrorCategory, "oidc_discovery_failed")
+	ExchangeFailedCause  = errors.NewCause(errors.UnauthorizedCategory, "oidc_exchange_failed")
+	InvalidTokenCause    = errors.NewCause(errors.UnauthorizedCategory, "invalid_id_token")
+)
@@ -0,0 +1,323 @@
+// Package oidc implements the parts of OpenID Connect required for the
+// coordinator to act as a relying party using the authorization code flow
+// with PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// DefaultScopes are requested from the identity provider if none are
+// configured
+var DefaultScopes = []string{"openid", "email", "profile"}
+
+// DefaultGroupsClaim is the name of the claim containing the groups the
+// identity belongs to if none is configured
+const DefaultGroupsClaim = "groups"
+
+// clockSkew is the leeway allowed when validating the time based claims of
+// an ID token
+const clockSkew = time.Minute
+
+// Config contains the information required to talk to an identity provider
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	GroupsClaim  string
+
+	// HTTPClient is used to talk to the identity provider, if not set the
+	// http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// Identity is the identity asserted by the identity provider in a verified
+// ID token
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified *bool
+	Name          string
+	Groups        []string
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect identity provider. The provider metadata
+// and signing keys are fetched lazily and cached.
+type Provider struct {
+	cfg Config
+
+	mu   sync.Mutex
+	meta *discovery
+	keys *jose.JSONWebKeySet
+}
+
+// NewProvider returns a Provider for the given configuration
+func NewProvider(cfg Config) (*Provider, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New(InvalidConfigCause, "An issuer must be provided")
+	}
+
+	if cfg.ClientID == "" {
+		return nil, errors.New(InvalidConfigCause, "A client id must be provided")
+	}
+
+	if cfg.RedirectURL == "" {
+		return nil, errors.New(InvalidConfigCause, "A redirect url must be provided")
+	}
+
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = DefaultScopes
+	}
+
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = DefaultGroupsClaim
+	}
+
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	return &Provider{cfg: cfg}, nil
+}
+
+// AuthCodeURL returns the url the user must visit to authenticate with the
+// identity provider. The verifier is used to derive the PKCE code challenge.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	oc, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return oc.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.SetAuthURLParam("code_challenge", Challenge(verifier)),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	), nil
+}
+
+// Exchange trades the authorization code returned by the identity provider
+// for an ID token, verifies it, and returns the identity it asserts.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	oc, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.cfg.HTTPClient)
+	token, err := oc.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		return nil, errors.New(ExchangeFailedCause, "Could not exchange code: %s", err)
+	}
+
+	raw, ok := token.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return nil, errors.New(ExchangeFailedCause, "Token response did not contain an id_token")
+	}
+
+	return p.Verify(ctx, raw, nonce)
+}
+
+// Verify checks the signature and claims of the given ID token, returning
+// the identity it asserts.
+func (p *Provider) Verify(ctx context.Context, raw, nonce string) (*Identity, error) {
+	tok, err := jwt.ParseSigned(raw)
+	if err != nil {
+		return nil, errors.New(InvalidTokenCause, "Could not parse id token: %s", err)
+	}
+
+	if len(tok.Headers) != 1 {
+		return nil, errors.New(InvalidTokenCause, "Expected a single signature on the id token")
+	}
+
+	key, err := p.key(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var std jwt.Claims
+	claims := map[string]interface{}{}
+	err = tok.Claims(key, &std, &claims)
+	if err != nil {
+		return nil, errors.New(InvalidTokenCause, "Could not verify id token: %s", err)
+	}
+
+	err = std.ValidateWithLeeway(jwt.Expected{
+		Issuer:   p.cfg.Issuer,
+		Audience: jwt.Audience{p.cfg.ClientID},
+		Time:     time.Now(),
+	}, clockSkew)
+	if err != nil {
+		return nil, errors.New(InvalidTokenCause, "Invalid id token: %s", err)
+	}
+
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, errors.New(InvalidTokenCause, "Invalid id token: nonce does not match")
+	}
+
+	identity := &Identity{
+		Issuer:  std.Issuer,
+		Subject: std.Subject,
+	}
+
+	identity.Email, _ = claims["email"].(string)
+	identity.Name, _ = claims["name"].(string)
+	if verified, ok := claims["email_verified"].(bool); ok {
+		identity.EmailVerified = &verified
+	}
+
+	switch groups := claims[p.cfg.GroupsClaim].(type) {
+	case []interface{}:
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				identity.Groups = append(identity.Groups, s)
+			}
+		}
+	case string:
+		identity.Groups = []string{groups}
+	}
+
+	return identity, nil
+}
+
+func (p *Provider) oauth2Config(ctx context.Context) (*oauth2.Config, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       p.cfg.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	meta := &discovery{}
+	u := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	err := p.getJSON(ctx, u, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if meta.Issuer != p.cfg.Issuer {
+		return nil, errors.New(DiscoveryFailedCause, "Issuer %s does not match configured issuer %s",
+			meta.Issuer, p.cfg.Issuer)
+	}
+
+	p.meta = meta
+	return meta, nil
+}
+
+// key returns the signing key with the given id, refreshing the cached key
+// set if the key is unknown as the provider may have rotated its keys.
+func (p *Provider) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.keys != nil {
+		if keys := p.keys.Key(kid); len(keys) > 0 {
+			return &keys[0], nil
+		}
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	err = p.getJSON(ctx, meta.JWKSURI, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	p.keys = keys
+	if found := keys.Key(kid); len(found) > 0 {
+		return &found[0], nil
+	}
+
+	return nil, errors.New(InvalidTokenCause, "Unknown signing key %s", kid)
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := p.cfg.HTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.New(DiscoveryFailedCause, "Could not contact identity provider: %s", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.New(DiscoveryFailedCause, "Unexpected status %d from %s", res.StatusCode, u)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// NewVerifier returns a random PKCE code verifier
+func NewVerifier() (string, error) {
+	return randomString(32)
+}
+
+// NewNonce returns a random value suitable for use as a nonce or state
+func NewNonce() (string, error) {
+	return randomString(16)
+}
+
+// Challenge returns the S256 PKCE code challenge for the given verifier
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("could not generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,129 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/oidc"
+	"github.com/capeprivacy/cape/coordinator/oidc/oidctest"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// authorize visits the auth url returning the code the issuer redirected
+// back with
+func authorize(authURL string) (code string, state string, err error) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Get(authURL)
+	if err != nil {
+		return "", "", err
+	}
+	defer res.Body.Close()
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	if err != nil {
+		return "", "", err
+	}
+
+	return loc.Query().Get("code"), loc.Query().Get("state"), nil
+}
+
+func TestProvider(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	verified := true
+	issuer, err := oidctest.NewIssuer(oidctest.Claims{
+		Subject:       "bob",
+		Email:         "bob@cape.com",
+		EmailVerified: &verified,
+		Name:          "Bob",
+		Groups:        []string{"engineering", "admins"},
+	})
+	gm.Expect(err).To(gm.BeNil())
+	defer issuer.Close()
+
+	provider, err := oidc.NewProvider(issuer.Config("http://localhost/v1/sso/callback"))
+	gm.Expect(err).To(gm.BeNil())
+
+	t.Run("completes the authorization code flow", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		verifier, err := oidc.NewVerifier()
+		gm.Expect(err).To(gm.BeNil())
+
+		authURL, err := provider.AuthCodeURL(ctx, "my-state", "my-nonce", verifier)
+		gm.Expect(err).To(gm.BeNil())
+
+		code, state, err := authorize(authURL)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(state).To(gm.Equal("my-state"))
+
+		identity, err := provider.Exchange(ctx, code, verifier, "my-nonce")
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(identity.Issuer).To(gm.Equal(issuer.URL()))
+		gm.Expect(identity.Subject).To(gm.Equal("bob"))
+		gm.Expect(identity.Email).To(gm.Equal("bob@cape.com"))
+		gm.Expect(*identity.EmailVerified).To(gm.BeTrue())
+		gm.Expect(identity.Groups).To(gm.Equal([]string{"engineering", "admins"}))
+	})
+
+	t.Run("rejects the wrong code verifier", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		verifier, err := oidc.NewVerifier()
+		gm.Expect(err).To(gm.BeNil())
+
+		authURL, err := provider.AuthCodeURL(ctx, "my-state", "my-nonce", verifier)
+		gm.Expect(err).To(gm.BeNil())
+
+		code, _, err := authorize(authURL)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = provider.Exchange(ctx, code, "not-the-verifier", "my-nonce")
+		gm.Expect(errors.FromCause(err, oidc.ExchangeFailedCause)).To(gm.BeTrue())
+	})
+
+	t.Run("rejects the wrong nonce", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		raw, err := issuer.Sign(oidctest.Claims{Subject: "bob"}, "my-nonce", oidctest.ClientID)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = provider.Verify(ctx, raw, "another-nonce")
+		gm.Expect(errors.FromCause(err, oidc.InvalidTokenCause)).To(gm.BeTrue())
+	})
+
+	t.Run("rejects tokens for another audience", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		raw, err := issuer.Sign(oidctest.Claims{Subject: "bob"}, "my-nonce", "someone-else")
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = provider.Verify(ctx, raw, "my-nonce")
+		gm.Expect(errors.FromCause(err, oidc.InvalidTokenCause)).To(gm.BeTrue())
+	})
+
+	t.Run("rejects tokens signed by another issuer", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		other, err := oidctest.NewIssuer(oidctest.Claims{Subject: "bob"})
+		gm.Expect(err).To(gm.BeNil())
+		defer other.Close()
+
+		raw, err := other.Sign(oidctest.Claims{Subject: "bob"}, "my-nonce", oidctest.ClientID)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = provider.Verify(ctx, raw, "my-nonce")
+		gm.Expect(errors.FromCause(err, oidc.InvalidTokenCause)).To(gm.BeTrue())
+	})
+}
@@ -0,0 +1,245 @@
+// Package oidctest provides a stand-in OpenID Connect issuer for use in
+// tests. The issuer approves every authorization request without any user
+// interaction, asserting whichever identity has been configured.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/capeprivacy/cape/coordinator/oidc"
+)
+
+const (
+	ClientID     = "cape-coordinator"
+	ClientSecret = "cape-coordinator-secret"
+
+	keyID = "oidctest"
+)
+
+// Claims are the claims asserted about the user in the ID tokens issued by
+// the Issuer
+type Claims struct {
+	Subject       string   `json:"sub"`
+	Email         string   `json:"email,omitempty"`
+	EmailVerified *bool    `json:"email_verified,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Groups        []string `json:"groups,omitempty"`
+}
+
+type grant struct {
+	clientID    string
+	redirectURI string
+	challenge   string
+	nonce       string
+	claims      Claims
+}
+
+// Issuer is a minimal OpenID Connect identity provider
+type Issuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu     sync.Mutex
+	claims Claims
+	grants map[string]grant
+}
+
+// NewIssuer starts a new Issuer which will assert the given claims. Close
+// must be called once the Issuer is no longer needed.
+func NewIssuer(claims Claims) (*Issuer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &Issuer{
+		key:    key,
+		claims: claims,
+		grants: map[string]grant{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", i.handleDiscovery)
+	mux.HandleFunc("/jwks", i.handleJWKS)
+	mux.HandleFunc("/authorize", i.handleAuthorize)
+	mux.HandleFunc("/token", i.handleToken)
+
+	i.server = httptest.NewServer(mux)
+	return i, nil
+}
+
+// URL returns the issuer identifier
+func (i *Issuer) URL() string {
+	return i.server.URL
+}
+
+// Close shuts down the Issuer
+func (i *Issuer) Close() {
+	i.server.Close()
+}
+
+// SetClaims changes the claims asserted in subsequent ID tokens
+func (i *Issuer) SetClaims(claims Claims) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.claims = claims
+}
+
+// Config returns an oidc.Config for a relying party of this Issuer
+func (i *Issuer) Config(redirectURL string) oidc.Config {
+	return oidc.Config{
+		Issuer:       i.URL(),
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		RedirectURL:  redirectURL,
+	}
+}
+
+// Sign returns an ID token for the given claims signed by this Issuer
+func (i *Issuer) Sign(claims Claims, nonce string, audience string) (string, error) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: i.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyID))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	std := jwt.Claims{
+		Issuer:   i.URL(),
+		Subject:  claims.Subject,
+		Audience: jwt.Audience{audience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}
+
+	return jwt.Signed(signer).Claims(std).Claims(claims).Claims(map[string]interface{}{
+		"nonce": nonce,
+	}).CompactSerialize()
+}
+
+func (i *Issuer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{
+		"issuer":                 i.URL(),
+		"authorization_endpoint": i.URL() + "/authorize",
+		"token_endpoint":         i.URL() + "/token",
+		"jwks_uri":               i.URL() + "/jwks",
+	})
+}
+
+func (i *Issuer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{
+			Key:       &i.key.PublicKey,
+			KeyID:     keyID,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}},
+	})
+}
+
+func (i *Issuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("response_type") != "code" || q.Get("client_id") != ClientID {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "pkce is required", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code, err := oidc.NewNonce()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	i.mu.Lock()
+	i.grants[code] = grant{
+		clientID:    q.Get("client_id"),
+		redirectURI: q.Get("redirect_uri"),
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+		claims:      i.claims,
+	}
+	i.mu.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (i *Issuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request")
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	if clientID != ClientID || clientSecret != ClientSecret {
+		tokenError(w, "invalid_client")
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	i.mu.Lock()
+	g, ok := i.grants[code]
+	delete(i.grants, code)
+	i.mu.Unlock()
+
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" ||
+		g.redirectURI != r.PostForm.Get("redirect_uri") ||
+		g.challenge != oidc.Challenge(r.PostForm.Get("code_verifier")) {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	idToken, err := i.Sign(g.claims, g.nonce, g.clientID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": fmt.Sprintf("access-%s", code),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v) // nolint: errcheck
+}
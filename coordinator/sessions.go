@@ -0,0 +1,181 @@
+package coordinator
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+
+	"github.com/capeprivacy/cape/auth"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// RefreshRequest exchanges a refresh token for a new session
+type RefreshRequest struct {
+	RefreshToken *base64.Value `json:"refresh_token"`
+}
+
+// startSession signs a token for the session that lasts for the configured
+// session lifetime and stores the session
+func (c *Coordinator) startSession(ctx context.Context, session *models.Session) error {
+	expiresAt := c.cfg.Sessions.ExpiresAt(session.GetAuthenticatedAt())
+	token, err := c.tokenAuth.GenerateUntil(session.ID, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	session.SetToken(token, expiresAt)
+	return c.db.Session().Create(ctx, *session)
+}
+
+// issueRefreshToken creates a refresh token that can be exchanged for a new
+// session continuing the given one and sets it on the session
+func (c *Coordinator) issueRefreshToken(ctx context.Context, session *models.Session) error {
+	expiresAt := c.cfg.Sessions.RefreshExpiresAt(session.GetAuthenticatedAt())
+	token, secret, err := models.NewRefreshToken(*session, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.RefreshTokens().Create(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	session.RefreshToken = secret
+	return nil
+}
+
+// revokeSessionFamily removes every session, and refresh token, created from
+// the same login
+func (c *Coordinator) revokeSessionFamily(ctx context.Context, familyID string) error {
+	err := c.db.Session().DeleteByFamilyID(ctx, familyID)
+	if err != nil {
+		return err
+	}
+
+	return c.db.RefreshTokens().DeleteByFamilyID(ctx, familyID)
+}
+
+// RefreshHandler exchanges a refresh token for a new session, the session
+// the token was issued with is ended.
+//
+// Refresh tokens can only be used once. If a used token is presented again
+// it has been stolen, or the client is misbehaving, so every session created
+// from the same login is revoked.
+func RefreshHandler(coordinator *Coordinator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := fw.Logger(ctx)
+		capedb := coordinator.db
+
+		var input RefreshRequest
+		err := fw.DecodeJSONBody(w, r, &input)
+		if err != nil {
+			respondWithError(w, r.URL.Path, errors.Wrap(fw.BadJSONCause, err))
+			return
+		}
+
+		if input.RefreshToken == nil {
+			respondWithError(w, r.URL.Path, errors.New(fw.InvalidParametersCause, "A refresh_token must be provided"))
+			return
+		}
+
+		refreshToken, err := capedb.RefreshTokens().GetByHash(ctx, models.HashRefreshToken(input.RefreshToken))
+		if err != nil {
+			logger.Info().Err(err).Msg("Could not retrieve refresh token")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		logger = logger.With().Str("user_id", refreshToken.UserID).Str("session_family_id", refreshToken.FamilyID).Logger()
+
+		reuse := func() {
+			logger.Warn().Str("refresh_token_id", refreshToken.ID).Msg("Refresh token reused, revoking session family")
+			err := coordinator.revokeSessionFamily(ctx, refreshToken.FamilyID)
+			if err != nil {
+				logger.Error().Err(err).Msg("Could not revoke session family")
+			}
+
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+		}
+
+		if refreshToken.Used() {
+			reuse()
+			return
+		}
+
+		if refreshToken.Expired() {
+			logger.Info().Msg("Attempted to refresh with an expired refresh token")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		marked, err := capedb.RefreshTokens().MarkUsed(ctx, refreshToken.ID, time.Now().UTC())
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not mark refresh token used")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		// Someone else used the token between us reading and marking it
+		if !marked {
+			reuse()
+			return
+		}
+
+		// The session is missing if the user logged out or it was revoked
+		previous, err := capedb.Session().Get(ctx, refreshToken.SessionID)
+		if err != nil {
+			logger.Info().Err(err).Msg("Could not retrieve session to refresh")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		if previous.Type == models.TokenSession {
+			token, err := capedb.Tokens().Get(ctx, previous.OwnerID)
+			if err != nil || token.Expired() {
+				logger.Info().Err(err).Str("token_id", previous.OwnerID).Msg("Attempted to refresh a session for a deleted or expired token")
+				respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+				return
+			}
+		}
+
+		session := previous.Renew()
+		session.IPAddress = remoteIP(r)
+		session.UserAgent = r.UserAgent()
+
+		err = coordinator.startSession(ctx, &session)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to create refreshed session")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		err = capedb.Session().Delete(ctx, previous.ID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to end refreshed session")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		err = coordinator.issueRefreshToken(ctx, &session)
+		if err != nil {
+			logger.Error().Err(err).Msg("Failed to issue refresh token")
+			respondWithError(w, r.URL.Path, auth.ErrAuthentication)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     "token",
+			Value:    session.Token.String(),
+			Secure:   false,
+			HttpOnly: true,
+		})
+
+		respondWithJSON(w, http.StatusOK, session)
+	}
+}
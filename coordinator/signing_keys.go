@@ -0,0 +1,160 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+
+	"github.com/capeprivacy/cape/auth"
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/db/crypto"
+	capepg "github.com/capeprivacy/cape/coordinator/db/postgres"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// DefaultSigningKeyRetirement is how long a replaced signing key is still
+// accepted for. It covers the lifetime of the last session signed by the key
+// plus the time it takes every coordinator to pick up the new key.
+func DefaultSigningKeyRetirement(cfg *Config) time.Duration {
+	return cfg.Sessions.GetLifetime() + auth.KeyRefreshInterval
+}
+
+// signingKeySource returns a KeySource that reads the signing keys out of
+// the database config, decrypting them with the root key
+func signingKeySource(capedb db.Interface, rootKey string) (auth.KeySource, error) {
+	u, err := crypto.NewKeyURL(rootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	kms, err := crypto.LoadKMS(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() ([]*auth.SigningKey, error) {
+		ctx := context.Background()
+
+		cfg, err := capedb.Config().Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return decryptSigningKeys(ctx, kms, cfg)
+	}, nil
+}
+
+// decryptSigningKeys returns the current signing key followed by any retired
+// keys that are still accepted
+func decryptSigningKeys(ctx context.Context, kms crypto.KMS, cfg *models.Config) ([]*auth.SigningKey, error) {
+	kp, err := decryptKeypair(ctx, kms, cfg.AuthKeypair)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []*auth.SigningKey{{ID: cfg.AuthKeyID, Keypair: kp}}
+	for _, retired := range cfg.RetiredAuthKeys {
+		kp, err := decryptKeypair(ctx, kms, retired.Keypair)
+		if err != nil {
+			return nil, err
+		}
+
+		retiresAt := retired.RetiresAt
+		keys = append(keys, &auth.SigningKey{
+			ID:        retired.ID,
+			Keypair:   kp,
+			RetiresAt: &retiresAt,
+		})
+	}
+
+	return keys, nil
+}
+
+func decryptKeypair(ctx context.Context, kms crypto.KMS, encrypted *base64.Value) (*auth.Keypair, error) {
+	unencrypted, err := kms.Decrypt(ctx, *encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	kp := &auth.Keypair{}
+	err = json.Unmarshal(unencrypted, kp)
+	if err != nil {
+		return nil, err
+	}
+
+	return kp, nil
+}
+
+func encryptKeypair(ctx context.Context, kms crypto.KMS, kp *auth.Keypair) (*base64.Value, error) {
+	by, err := json.Marshal(kp)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := kms.Encrypt(ctx, by)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.New(encrypted), nil
+}
+
+// RotateSigningKey generates a new keypair for signing session tokens and
+// stores it in the database config of the coordinator described by cfg. The
+// previous key keeps verifying the tokens it signed for retireAfter.
+// Running coordinators pick up the new key within auth.KeyRefreshInterval.
+//
+// The id of the new key is returned.
+func RotateSigningKey(ctx context.Context, cfg *Config, retireAfter time.Duration) (string, error) {
+	if err := cfg.Validate(); err != nil {
+		return "", err
+	}
+
+	if retireAfter < 0 {
+		return "", errors.New(InvalidArgumentCause, "Retirement period cannot be negative")
+	}
+
+	u, err := crypto.NewKeyURL(cfg.RootKey)
+	if err != nil {
+		return "", err
+	}
+
+	kms, err := crypto.LoadKMS(u)
+	if err != nil {
+		return "", err
+	}
+
+	pool := mustPgxPool(cfg.DB.Addr.ToURL().String(), cfg.InstanceID.String())
+	defer pool.Close()
+
+	kp, err := auth.NewKeypair()
+	if err != nil {
+		return "", err
+	}
+
+	encrypted, err := encryptKeypair(ctx, kms, kp)
+	if err != nil {
+		return "", err
+	}
+
+	// The config is locked while the key is rotated in so a concurrent
+	// rotation, or any other change to the config, isn't lost
+	id := models.NewID()
+	err = capepg.New(pool).Tx(ctx, func(tx db.Interface) error {
+		config, err := tx.Config().GetForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+
+		config.RotateAuthKeypair(id, encrypted, time.Now().Add(retireAfter))
+		return tx.Config().Update(ctx, *config)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,564 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/capeprivacy/cape/coordinator/audit"
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/coordinator/oidc"
+	fw "github.com/capeprivacy/cape/framework"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// SSOStartResponse is returned when starting to sign in through the
+// identity provider. The user must visit the AuthURL, and confirm the
+// UserCode once signed in, while the requester polls for the resulting
+// session using the ID & Secret.
+type SSOStartResponse struct {
+	ID        string          `json:"id"`
+	Secret    models.Password `json:"secret"`
+	AuthURL   string          `json:"auth_url"`
+	UserCode  string          `json:"user_code"`
+	ExpiresAt time.Time       `json:"expires_at"`
+}
+
+type SSOPollRequest struct {
+	ID     string          `json:"id"`
+	Secret models.Password `json:"secret"`
+}
+
+// ssoConfirmCookie holds the secret given to the browser that signed in, it
+// must be presented to confirm the attempt
+const ssoConfirmCookie = "cape_sso_confirm"
+
+const ssoCompletePage = `<!DOCTYPE html>
+<html>
+<head><title>Cape</title></head>
+<body><p>%s</p></body>
+</html>
+`
+
+const ssoConfirmPage = `<!DOCTYPE html>
+<html>
+<head><title>Cape</title></head>
+<body>
+<p>To finish signing in to Cape, enter the code shown where you started signing in.</p>
+<p>If you didn't start signing in to Cape, close this window.</p>
+<form method="post" action="confirm">
+<input type="hidden" name="state" value="%s">
+<input type="text" name="code" autocomplete="off" autofocus>
+<button type="submit">Sign in</button>
+</form>
+</body>
+</html>
+`
+
+// SSOStartHandler begins signing in through the configured identity
+// provider
+func SSOStartHandler(coordinator *Coordinator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := fw.Logger(ctx)
+
+		state, err := oidc.NewNonce()
+		if err != nil {
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		nonce, err := oidc.NewNonce()
+		if err != nil {
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		verifier, err := oidc.NewVerifier()
+		if err != nil {
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		secret := models.GeneratePassword()
+		creds, err := coordinator.credentialProducer.Generate(secret)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not generate credentials")
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		authURL, err := coordinator.ssoProvider.AuthCodeURL(ctx, state, nonce, verifier)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not build identity provider url")
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		userCode, err := models.GenerateUserCode()
+		if err != nil {
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		attempt := models.NewSSOAttempt(state, nonce, verifier, userCode, creds)
+		err = coordinator.db.SSOAttempts().Create(ctx, attempt)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not insert sso attempt into database")
+			respondWithError(w, r.URL.Path, err)
+			return
+		}
+
+		respondWithJSON(w, http.StatusOK, SSOStartResponse{
+			ID:        attempt.ID,
+			Secret:    secret,
+			AuthURL:   authURL,
+			UserCode:  attempt.UserCode,
+			ExpiresAt: attempt.ExpiresAt,
+		})
+	}
+}
+
+// SSOCallbackHandler is where the identity provider sends the user's browser
+// once they've authenticated. It verifies their identity and then asks them
+// to confirm the attempt, see SSOConfirmHandler, giving only this browser
+// the secret needed to do so.
+func SSOCallbackHandler(coordinator *Coordinator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		q := r.URL.Query()
+
+		logger := fw.Logger(ctx)
+		fail := func(err error, msg string) {
+			logger.Info().Err(err).Msg(msg)
+			respondWithPage(w, http.StatusUnauthorized, "Could not sign in to Cape, please try again.")
+		}
+
+		if e := q.Get("error"); e != "" {
+			fail(fmt.Errorf("%s: %s", e, q.Get("error_description")), "Identity provider returned an error")
+			return
+		}
+
+		attempt, err := coordinator.db.SSOAttempts().GetByState(ctx, q.Get("state"))
+		if err != nil {
+			fail(err, "Could not retrieve sso attempt")
+			return
+		}
+
+		logger = logger.With().Str("sso_attempt_id", attempt.ID).Logger()
+		if attempt.Expired() || attempt.Verified() || attempt.Completed() {
+			fail(nil, "SSO attempt has expired or already been used")
+			return
+		}
+
+		identity, err := coordinator.ssoProvider.Exchange(ctx, q.Get("code"), attempt.CodeVerifier, attempt.Nonce)
+		if err != nil {
+			fail(err, "Could not verify identity")
+			return
+		}
+
+		logger = logger.With().Str("issuer", identity.Issuer).Str("subject", identity.Subject).Logger()
+		user, err := coordinator.ssoUser(ctx, identity)
+		if err != nil {
+			fail(err, "Could not find a user for identity")
+			return
+		}
+
+		secret, err := oidc.NewNonce()
+		if err != nil {
+			fail(err, "Could not generate confirmation secret")
+			return
+		}
+
+		attempt.Verify(user.ID, secret)
+		err = coordinator.db.SSOAttempts().Update(ctx, *attempt)
+		if err != nil {
+			fail(err, "Failed to update sso attempt")
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     ssoConfirmCookie,
+			Value:    secret,
+			Path:     "/v1/sso",
+			Expires:  attempt.ExpiresAt,
+			Secure:   r.TLS != nil,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		})
+
+		logger.Info().Str("user_id", user.ID).Msg("User verified by identity provider, waiting for confirmation")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ssoConfirmPage, html.EscapeString(attempt.State))
+	}
+}
+
+// SSOConfirmHandler creates the session for an sso attempt once the user
+// has entered the attempt's user code into the browser they signed in with.
+// A wrong code ends the attempt so the code can't be guessed.
+func SSOConfirmHandler(coordinator *Coordinator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		logger := fw.Logger(ctx)
+		fail := func(err error, msg string) {
+			logger.Info().Err(err).Msg(msg)
+			respondWithPage(w, http.StatusUnauthorized, "Could not sign in to Cape, please try again.")
+		}
+
+		if r.Method != http.MethodPost {
+			fail(nil, "SSO attempts can only be confirmed with a post")
+			return
+		}
+
+		err := r.ParseForm()
+		if err != nil {
+			fail(err, "Could not parse confirmation")
+			return
+		}
+
+		attempt, err := coordinator.db.SSOAttempts().GetByState(ctx, r.PostForm.Get("state"))
+		if err != nil {
+			fail(err, "Could not retrieve sso attempt")
+			return
+		}
+
+		logger = logger.With().Str("sso_attempt_id", attempt.ID).Logger()
+		if attempt.Expired() || !attempt.Verified() || attempt.Completed() {
+			fail(nil, "SSO attempt has expired, already been used or hasn't been verified")
+			return
+		}
+
+		var secret string
+		if cookie, err := r.Cookie(ssoConfirmCookie); err == nil {
+			secret = cookie.Value
+		}
+
+		if !attempt.Confirm(secret, r.PostForm.Get("code")) {
+			err = coordinator.db.SSOAttempts().Delete(ctx, attempt.ID)
+			if err != nil {
+				logger.Error().Err(err).Msg("Could not delete sso attempt")
+			}
+
+			fail(nil, "SSO attempt confirmed from another browser or with the wrong code")
+			return
+		}
+
+		user, err := coordinator.db.Users().GetByID(ctx, attempt.UserID)
+		if err != nil {
+			fail(err, "Could not retrieve user for sso attempt")
+			return
+		}
+
+		session := models.NewSession(user)
+		session.Type = models.SSOSession
+		session.IPAddress = remoteIP(r)
+		session.UserAgent = r.UserAgent()
+
+		err = coordinator.startSession(ctx, &session)
+		if err != nil {
+			fail(err, "Failed to create session")
+			return
+		}
+
+		attempt.SessionID = session.ID
+		attempt.UpdatedAt = time.Now()
+		err = coordinator.db.SSOAttempts().Update(ctx, *attempt)
+		if err != nil {
+			fail(err, "Failed to update sso attempt")
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     ssoConfirmCookie,
+			Path:     "/v1/sso",
+			MaxAge:   -1,
+			Secure:   r.TLS != nil,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		})
+
+		event := models.NewAuditEvent(fw.RequestID(ctx).String(), audit.LoginAction)
+		event.ActorID = user.ID
+		event.ActorEmail = user.Email
+		event.Target = user.Email.String()
+		event.After = audit.Summarize(map[string]string{"type": models.SSOSession.String()})
+		coordinator.audit.Record(ctx, event)
+
+		logger.Info().Str("user_id", user.ID).Msg("User signed in through identity provider")
+		respondWithPage(w, http.StatusOK, "You are now signed in to Cape, you can close this window.")
+	}
+}
+
+// SSOPollHandler returns the session created for an sso attempt once the
+// user has signed in. Until then an error with the SSOPendingCause is
+// returned.
+func SSOPollHandler(coordinator *Coordinator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := fw.Logger(ctx)
+
+		var input SSOPollRequest
+		err := fw.DecodeJSONBody(w, r, &input)
+		if err != nil {
+			respondWithError(w, r.URL.Path, errors.Wrap(fw.BadJSONCause, err))
+			return
+		}
+
+		logger = logger.With().Str("sso_attempt_id", input.ID).Logger()
+		attempt, err := coordinator.db.SSOAttempts().Get(ctx, input.ID)
+		if err != nil {
+			logger.Info().Err(err).Msg("Could not retrieve sso attempt")
+			respondWithError(w, r.URL.Path, ErrSSOFailed)
+			return
+		}
+
+		err = coordinator.credentialProducer.Compare(input.Secret, attempt.Credentials)
+		if err != nil {
+			logger.Info().Err(err).Msg("Invalid credentials provided")
+			respondWithError(w, r.URL.Path, ErrSSOFailed)
+			return
+		}
+
+		if !attempt.Completed() {
+			if attempt.Expired() {
+				respondWithError(w, r.URL.Path, errors.New(SSOExpiredCause, "The sign in attempt has expired"))
+				return
+			}
+
+			respondWithError(w, r.URL.Path, errors.New(SSOPendingCause, "Waiting for sign in to complete"))
+			return
+		}
+
+		session, err := coordinator.db.Session().Get(ctx, attempt.SessionID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not retrieve session for sso attempt")
+			respondWithError(w, r.URL.Path, ErrSSOFailed)
+			return
+		}
+
+		// Each attempt can only be used to collect a session once
+		err = coordinator.db.SSOAttempts().Delete(ctx, attempt.ID)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not delete sso attempt")
+			respondWithError(w, r.URL.Path, ErrSSOFailed)
+			return
+		}
+
+		err = coordinator.issueRefreshToken(ctx, session)
+		if err != nil {
+			logger.Error().Err(err).Msg("Could not issue refresh token for sso session")
+			respondWithError(w, r.URL.Path, ErrSSOFailed)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     "token",
+			Value:    session.Token.String(),
+			Secure:   false,
+			HttpOnly: true,
+		})
+
+		respondWithJSON(w, http.StatusOK, session)
+	}
+}
+
+// ssoUser returns the user linked to the identity asserted by the identity
+// provider. The first time an identity is seen it's linked to the user with
+// the same verified email, who is created if auto provisioning is enabled.
+// The user's roles are then brought in line with their groups.
+func (c *Coordinator) ssoUser(ctx context.Context, identity *oidc.Identity) (*models.User, error) {
+	if identity.Subject == "" {
+		return nil, errors.New(SSOFailedCause, "Identity provider did not supply a subject")
+	}
+
+	if identity.Email == "" {
+		return nil, errors.New(SSOFailedCause, "Identity provider did not supply an email")
+	}
+
+	if identity.EmailVerified == nil || !*identity.EmailVerified {
+		return nil, errors.New(SSOFailedCause, "Email %s has not been verified", identity.Email)
+	}
+
+	var user *models.User
+	err := c.db.Tx(ctx, func(tx db.Interface) error {
+		link, err := tx.SSOIdentities().Get(ctx, identity.Issuer, identity.Subject)
+		if err != nil && err != db.ErrCannotFindSSOIdentity {
+			return err
+		}
+
+		if link != nil {
+			user, err = tx.Users().GetByID(ctx, link.UserID)
+			if err != nil {
+				return err
+			}
+		} else {
+			user, link, err = c.linkSSOUser(ctx, tx, identity)
+			if err != nil {
+				return err
+			}
+		}
+
+		return c.syncGroupRoles(ctx, tx, user, link, identity.Groups)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// linkSSOUser links an identity seen for the first time to the user with its
+// email, or to a newly provisioned user. Users already linked to another
+// identity from the same issuer aren't linked again, their email may have
+// been given to someone else.
+func (c *Coordinator) linkSSOUser(ctx context.Context, tx db.Interface, identity *oidc.Identity) (*models.User, *models.SSOIdentity, error) {
+	email := models.Email(identity.Email)
+	user, err := tx.Users().Get(ctx, email)
+	if err != nil && err != db.ErrCannotFindUser {
+		return nil, nil, err
+	}
+
+	if err == db.ErrCannotFindUser {
+		if !c.cfg.SSO.AutoProvision {
+			return nil, nil, errors.New(SSOFailedCause, "No user exists with email %s", email)
+		}
+
+		user, err = c.provisionSSOUser(ctx, tx, identity)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		_, err = tx.SSOIdentities().GetByUser(ctx, user.ID, identity.Issuer)
+		if err == nil {
+			return nil, nil, errors.New(SSOFailedCause, "User %s is linked to a different identity", email)
+		}
+
+		if err != db.ErrCannotFindSSOIdentity {
+			return nil, nil, err
+		}
+	}
+
+	link := models.NewSSOIdentity(user.ID, identity.Issuer, identity.Subject)
+	err = tx.SSOIdentities().Create(ctx, link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, &link, nil
+}
+
+// syncGroupRoles grants the roles mapped to the user's groups and takes away
+// the roles granted through groups they've since left. A user who has left
+// the group granting their org role is given the default role.
+func (c *Coordinator) syncGroupRoles(ctx context.Context, tx db.Interface, user *models.User, link *models.SSOIdentity, groups []string) error {
+	cfg := c.cfg.SSO
+	logger := fw.Logger(ctx)
+
+	member := map[string]bool{}
+	for _, g := range groups {
+		member[g] = true
+	}
+
+	// The first matching role wins, a user can only hold one org role and
+	// one role in each project
+	var orgRole models.Label
+	projectRoles := map[models.Label]models.Label{}
+	for _, gr := range cfg.GroupRoles {
+		if !member[gr.Group] {
+			continue
+		}
+
+		if gr.Project == nil {
+			if orgRole == "" {
+				orgRole = gr.Role
+			}
+			continue
+		}
+
+		if _, ok := projectRoles[*gr.Project]; !ok {
+			projectRoles[*gr.Project] = gr.Role
+		}
+	}
+
+	for project, role := range projectRoles {
+		// Each role is set within its own savepoint so a missing project
+		// doesn't abort the rest
+		err := tx.Tx(ctx, func(tx db.Interface) error {
+			_, err := tx.Roles().SetProjectRole(ctx, user.Email, project, role)
+			return err
+		})
+		if err != nil {
+			logger.Warn().Err(err).Msgf("Could not set role on project %s", project)
+			delete(projectRoles, project)
+		}
+	}
+
+	for project := range link.ProjectRoles {
+		if _, ok := projectRoles[project]; ok {
+			continue
+		}
+
+		err := tx.Roles().DeleteProjectRole(ctx, user.Email, project)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case orgRole != "":
+		_, err := tx.Roles().SetOrgRole(ctx, user.Email, orgRole)
+		if err != nil {
+			return err
+		}
+	case link.OrgRole != "":
+		_, err := tx.Roles().SetOrgRole(ctx, user.Email, cfg.GetDefaultRole())
+		if err != nil {
+			return err
+		}
+	}
+
+	link.OrgRole = orgRole
+	link.ProjectRoles = projectRoles
+	link.UpdatedAt = time.Now()
+	return tx.SSOIdentities().Update(ctx, *link)
+}
+
+func (c *Coordinator) provisionSSOUser(ctx context.Context, tx db.Interface, identity *oidc.Identity) (*models.User, error) {
+	name := models.Name(identity.Name)
+	if name == "" {
+		name = models.Name(identity.Email)
+	}
+
+	// The user authenticates through the identity provider so they are given
+	// a password no one knows, they can recover their account to set one.
+	creds, err := c.credentialProducer.Generate(models.GeneratePassword())
+	if err != nil {
+		return nil, err
+	}
+
+	user := models.NewUser(name, models.Email(identity.Email), *creds)
+	err = tx.Users().Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Roles().SetOrgRole(ctx, user.Email, c.cfg.SSO.GetDefaultRole())
+	if err != nil {
+		return nil, err
+	}
+
+	logger := fw.Logger(ctx)
+	logger.Info().Str("user_id", user.ID).Msg("Provisioned user from identity provider")
+	return &user, nil
+}
+
+func respondWithPage(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	fmt.Fprintf(w, ssoCompletePage, msg)
+}
@@ -0,0 +1,260 @@
+// Package throttle slows down attempts to guess passwords and recovery
+// secrets.
+//
+// Failed attempts are counted against keys, such as the account being logged
+// into or the ip address a request came from. Once a key has used up its free
+// attempts each further failure doubles how long the next attempt must wait,
+// and keys with a lockout threshold are locked out entirely once it's
+// reached. Failures are counted in postgres so every coordinator sharing a
+// database sees the same counts.
+//
+// Every attempt is reserved, counting it as a failure, before it's made and
+// refunded if it succeeds.
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+var (
+	// ThrottledCause is returned when too many failed attempts have been
+	// made recently and the caller must wait before trying again
+	ThrottledCause = errors.NewCause(errors.TooManyRequestsCategory, "throttled")
+
+	// LockedCause is returned when too many failed attempts have been made
+	// against an account and it has been temporarily locked
+	LockedCause = errors.NewCause(errors.TooManyRequestsCategory, "account_locked")
+)
+
+// Policy describes how failed attempts against a key are limited
+type Policy struct {
+	// FreeAttempts is the number of failures allowed before further
+	// attempts are delayed
+	FreeAttempts int
+
+	// BaseDelay is how long the first delayed attempt must wait, each
+	// failure after that doubles the delay up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	// LockoutThreshold is the number of failures after which the key is
+	// locked for LockoutDuration. Zero means the key is never locked.
+	LockoutThreshold int
+	LockoutDuration  time.Duration
+
+	// Window is how long failures are remembered after the most recent one
+	Window time.Duration
+}
+
+// Delay returns how long must be waited after the given number of failures
+// before another attempt can be made
+func (p Policy) Delay(failures int) time.Duration {
+	if failures <= p.FreeAttempts {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := p.FreeAttempts + 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+
+	return delay
+}
+
+// Locks returns whether the given number of failures locks the key out
+func (p Policy) Locks(failures int) bool {
+	return p.LockoutThreshold > 0 && failures >= p.LockoutThreshold
+}
+
+var (
+	// AccountPolicy limits failed logins to an account, a handful of
+	// mistakes are allowed before attempts slow down and the account is
+	// locked for a while if guessing continues
+	AccountPolicy = Policy{
+		FreeAttempts:     3,
+		BaseDelay:        time.Second,
+		MaxDelay:         5 * time.Minute,
+		LockoutThreshold: 10,
+		LockoutDuration:  30 * time.Minute,
+		Window:           time.Hour,
+	}
+
+	// IPPolicy limits failed attempts from a single ip address. It's more
+	// lenient than AccountPolicy as many people can share an address and
+	// isn't locked out so one person can't lock everyone else out.
+	IPPolicy = Policy{
+		FreeAttempts: 20,
+		BaseDelay:    time.Second,
+		MaxDelay:     5 * time.Minute,
+		Window:       time.Hour,
+	}
+
+	// RecoveryPolicy limits requesting recoveries for an account and
+	// guessing the secret of a recovery. Recoveries send emails and are
+	// rarely needed so they're limited more tightly.
+	RecoveryPolicy = Policy{
+		FreeAttempts: 5,
+		BaseDelay:    time.Minute,
+		MaxDelay:     time.Hour,
+		Window:       24 * time.Hour,
+	}
+)
+
+// Key identifies what failed attempts are counted against and the policy
+// limiting them
+type Key struct {
+	Name   string
+	Policy Policy
+}
+
+// AccountKey returns the key failed logins to the account identified by the
+// given email or token id are counted against
+func AccountKey(account string) Key {
+	return Key{Name: "login:account:" + strings.ToLower(account), Policy: AccountPolicy}
+}
+
+// ChangePasswordKey returns the key attempts to change the password of the
+// user with the given id are counted against, so a stolen session can't be
+// used to guess the user's current password
+func ChangePasswordKey(userID string) Key {
+	return Key{Name: "change-password:user:" + userID, Policy: AccountPolicy}
+}
+
+// IPKey returns the key failed attempts at the given action, such as login
+// or recovery, from an ip address are counted against
+func IPKey(action string, ip string) Key {
+	return Key{Name: action + ":ip:" + ip, Policy: IPPolicy}
+}
+
+// RecoveryKey returns the key attempts to recover the account with the given
+// email, or guesses of the secret of the recovery with the given id, are
+// counted against
+func RecoveryKey(subject string) Key {
+	return Key{Name: "recovery:" + strings.ToLower(subject), Policy: RecoveryPolicy}
+}
+
+// Limiter reserves attempts against keys
+type Limiter struct {
+	db  db.Interface
+	now func() time.Time
+}
+
+// New returns a Limiter counting failures in the given database
+func New(database db.Interface) *Limiter {
+	return &Limiter{
+		db:  database,
+		now: func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Reservation is an attempt that has been counted as a failure against keys
+// before being made. If the attempt succeeds its failure can be refunded.
+type Reservation struct {
+	limiter *Limiter
+
+	// LockedUntil is when the lockout of a key caused by this attempt ends,
+	// it's the zero time if no key was locked out
+	LockedUntil time.Time
+}
+
+// Reserve counts an attempt as a failure against each of the given keys,
+// delaying or locking them according to their policies, before the attempt
+// is made. An error is returned and nothing is counted if an attempt can't
+// be made against any of the keys right now.
+//
+// Counting the attempt up front means concurrent attempts can't all slip
+// through while the first is still being checked.
+func (l *Limiter) Reserve(ctx context.Context, keys ...Key) (*Reservation, error) {
+	reservation := &Reservation{limiter: l}
+
+	now := l.now()
+	err := l.db.Tx(ctx, func(tx db.Interface) error {
+		for _, key := range keys {
+			// Recording the failure locks the throttle until the
+			// transaction ends, so the delay it results in is in place
+			// before any other attempt is counted. Its delay and lockout
+			// are left as they were by the previous attempt.
+			throttle, err := tx.Throttles().RecordFailure(ctx, key.Name, now, key.Policy.Window)
+			if err != nil {
+				return err
+			}
+
+			if throttle.Locked(now) {
+				return errors.New(LockedCause, "Too many failed attempts, locked until %s", throttle.LockedUntil.Format(time.RFC3339))
+			}
+
+			if throttle.Blocked(now) {
+				wait := throttle.RetryAt(now).Sub(now).Round(time.Second)
+				if wait < time.Second {
+					wait = time.Second
+				}
+
+				return errors.New(ThrottledCause, "Too many failed attempts, try again in %s", wait)
+			}
+
+			if key.Policy.Locks(throttle.Failures) {
+				until := now.Add(key.Policy.LockoutDuration)
+				err = tx.Throttles().Lock(ctx, key.Name, until)
+				if err != nil {
+					return err
+				}
+
+				reservation.LockedUntil = until
+				continue
+			}
+
+			delay := key.Policy.Delay(throttle.Failures)
+			if delay == 0 {
+				continue
+			}
+
+			err = tx.Throttles().Block(ctx, key.Name, now.Add(delay))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// Refund takes back the failure counted against the given keys by the
+// reservation once the attempt has succeeded
+func (r *Reservation) Refund(ctx context.Context, keys ...Key) error {
+	for _, key := range keys {
+		err := r.limiter.db.Throttles().Refund(ctx, key.Name)
+		if err != nil {
+			return fmt.Errorf("could not refund throttle %s: %w", key.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Reset forgets the failures against the given keys, lifting any delay or
+// lockout
+func (l *Limiter) Reset(ctx context.Context, keys ...Key) error {
+	for _, key := range keys {
+		err := l.db.Throttles().Delete(ctx, key.Name)
+		if err != nil {
+			return fmt.Errorf("could not reset throttle %s: %w", key.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/capeprivacy/cape/coordinator/db"
+	"github.com/capeprivacy/cape/models"
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+type memoryThrottles struct {
+	throttles map[string]*models.Throttle
+}
+
+// memoryDB is a database holding only throttles, its transactions aren't
+// rolled back
+type memoryDB struct {
+	db.Interface
+	throttles *memoryThrottles
+}
+
+func (m *memoryDB) Throttles() db.ThrottleDB { return m.throttles }
+
+func (m *memoryDB) Tx(ctx context.Context, fn func(db.Interface) error) error {
+	return fn(m)
+}
+
+func (m *memoryThrottles) Get(ctx context.Context, key string) (*models.Throttle, error) {
+	throttle, ok := m.throttles[key]
+	if !ok {
+		return nil, db.ErrCannotFindThrottle
+	}
+
+	return throttle, nil
+}
+
+func (m *memoryThrottles) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (*models.Throttle, error) {
+	throttle, ok := m.throttles[key]
+	if !ok {
+		throttle = &models.Throttle{Key: key}
+		m.throttles[key] = throttle
+	}
+
+	if throttle.LastFailureAt.Before(at.Add(-window)) {
+		throttle.Failures = 0
+	}
+
+	throttle.Failures++
+	throttle.LastFailureAt = at
+	throttle.ExpiresAt = at.Add(window)
+	return throttle, nil
+}
+
+func (m *memoryThrottles) Block(ctx context.Context, key string, until time.Time) error {
+	m.throttles[key].BlockedUntil = &until
+	return nil
+}
+
+func (m *memoryThrottles) Refund(ctx context.Context, key string) error {
+	throttle, ok := m.throttles[key]
+	if ok && throttle.Failures > 0 {
+		throttle.Failures--
+	}
+
+	return nil
+}
+
+func (m *memoryThrottles) Lock(ctx context.Context, key string, until time.Time) error {
+	throttle := m.throttles[key]
+	throttle.Failures = 0
+	throttle.BlockedUntil = nil
+	throttle.LockedUntil = &until
+	return nil
+}
+
+func (m *memoryThrottles) Delete(ctx context.Context, key string) error {
+	delete(m.throttles, key)
+	return nil
+}
+
+func (m *memoryThrottles) DeleteExpired(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func TestPolicyDelay(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	policy := Policy{FreeAttempts: 2, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{failures: 0, delay: 0},
+		{failures: 2, delay: 0},
+		{failures: 3, delay: time.Second},
+		{failures: 4, delay: 2 * time.Second},
+		{failures: 5, delay: 4 * time.Second},
+		{failures: 6, delay: 8 * time.Second},
+		{failures: 7, delay: 10 * time.Second},
+		{failures: 1000, delay: 10 * time.Second},
+	}
+
+	for _, tc := range tests {
+		gm.Expect(policy.Delay(tc.failures)).To(gm.Equal(tc.delay))
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	policy := Policy{
+		FreeAttempts:     1,
+		BaseDelay:        time.Second,
+		MaxDelay:         time.Minute,
+		LockoutThreshold: 4,
+		LockoutDuration:  time.Hour,
+		Window:           time.Hour,
+	}
+	key := Key{Name: "login:account:hi@hi.hi", Policy: policy}
+
+	setup := func() *Limiter {
+		l := New(&memoryDB{throttles: &memoryThrottles{throttles: map[string]*models.Throttle{}}})
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	t.Run("free attempts aren't delayed", func(t *testing.T) {
+		l := setup()
+
+		for i := 0; i < 2; i++ {
+			reservation, err := l.Reserve(ctx, key)
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(reservation.LockedUntil.IsZero()).To(gm.BeTrue())
+		}
+	})
+
+	t.Run("delays attempts once free attempts are used", func(t *testing.T) {
+		l := setup()
+
+		for i := 0; i < 2; i++ {
+			_, err := l.Reserve(ctx, key)
+			gm.Expect(err).To(gm.BeNil())
+		}
+
+		_, err := l.Reserve(ctx, key)
+		gm.Expect(errors.CausedBy(err, ThrottledCause)).To(gm.BeTrue())
+
+		now = now.Add(time.Second)
+		_, err = l.Reserve(ctx, key)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("locks out after the threshold", func(t *testing.T) {
+		l := setup()
+
+		var lockedUntil time.Time
+		for i := 0; i < 4; i++ {
+			reservation, err := l.Reserve(ctx, key)
+			gm.Expect(err).To(gm.BeNil())
+
+			lockedUntil = reservation.LockedUntil
+			now = now.Add(time.Minute)
+		}
+
+		gm.Expect(lockedUntil).To(gm.Equal(now.Add(-time.Minute).Add(time.Hour)))
+
+		_, err := l.Reserve(ctx, key)
+		gm.Expect(errors.CausedBy(err, LockedCause)).To(gm.BeTrue())
+	})
+
+	t.Run("refunded attempts aren't counted", func(t *testing.T) {
+		l := setup()
+
+		for i := 0; i < 4; i++ {
+			reservation, err := l.Reserve(ctx, key)
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(reservation.Refund(ctx, key)).To(gm.Succeed())
+		}
+
+		reservation, err := l.Reserve(ctx, key)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(reservation.LockedUntil.IsZero()).To(gm.BeTrue())
+	})
+
+	t.Run("reset lifts a lockout", func(t *testing.T) {
+		l := setup()
+
+		for i := 0; i < 4; i++ {
+			_, err := l.Reserve(ctx, key)
+			gm.Expect(err).To(gm.BeNil())
+			now = now.Add(time.Minute)
+		}
+
+		gm.Expect(l.Reset(ctx, key)).To(gm.Succeed())
+
+		_, err := l.Reserve(ctx, key)
+		gm.Expect(err).To(gm.BeNil())
+	})
+
+	t.Run("checks every key", func(t *testing.T) {
+		l := setup()
+
+		other := Key{Name: "login:ip:127.0.0.1", Policy: Policy{Window: time.Hour, BaseDelay: time.Second, MaxDelay: time.Second}}
+		_, err := l.Reserve(ctx, other)
+		gm.Expect(err).To(gm.BeNil())
+
+		_, err = l.Reserve(ctx, key, other)
+		gm.Expect(errors.CausedBy(err, ThrottledCause)).To(gm.BeTrue())
+	})
+}
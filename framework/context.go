@@ -24,6 +24,10 @@ const (
 
 	// SessionContextKey is the name of the session key stored on the context
 	SessionContextKey ContextKey = "session"
+
+	// RemoteIPContextKey is the name of the key for the ip address the
+	// request came from stored on the context
+	RemoteIPContextKey ContextKey = "remote-ip"
 )
 
 // RequestID returns the request id stored on a given context
@@ -46,6 +50,19 @@ func Logger(ctx context.Context) zerolog.Logger {
 	return logger.(zerolog.Logger)
 }
 
+// RemoteIP returns the ip address the request came from stored on the given
+// context.
+//
+// Returns an empty string if the address is not available on the context.
+func RemoteIP(ctx context.Context) string {
+	ip := ctx.Value(RemoteIPContextKey)
+	if ip == nil {
+		return ""
+	}
+
+	return ip.(string)
+}
+
 // AuthToken returns the auth token stored on the given context.
 //
 // Returns nil if the token is not available on the context.
@@ -67,3 +84,9 @@ func Session(ctx context.Context) *auth.Session {
 
 	return session.(*auth.Session)
 }
+
+// Authenticated returns whether or not a session is stored on the given
+// context.
+func Authenticated(ctx context.Context) bool {
+	return ctx.Value(SessionContextKey) != nil
+}
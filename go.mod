@@ -3,6 +3,7 @@ module github.com/capeprivacy/cape
 go 1.14
 
 require (
+	cloud.google.com/go v0.58.0
 	github.com/99designs/gqlgen v0.11.3
 	github.com/Masterminds/semver v1.5.0
 	github.com/Masterminds/sprig v2.22.0+incompatible
@@ -53,6 +54,10 @@ require (
 	go.uber.org/multierr v1.5.0
 	gocloud.dev v0.20.0
 	golang.org/x/crypto v0.0.0-20200709230013-948cd5f35899
+	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	google.golang.org/api v0.26.0
+	google.golang.org/genproto v0.0.0-20200608115520-7c474a2e3482
+	google.golang.org/grpc v1.29.1
 	gopkg.in/ini.v1 v1.55.0 // indirect
 	gopkg.in/square/go-jose.v2 v2.5.1
 	helm.sh/helm/v3 v3.2.0
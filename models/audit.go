@@ -0,0 +1,136 @@
+package models
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuditOutcome records whether an audited action succeeded
+type AuditOutcome string
+
+const (
+	AuditSuccess AuditOutcome = "success"
+	AuditFailure AuditOutcome = "failure"
+)
+
+func (a AuditOutcome) String() string {
+	return string(a)
+}
+
+// AuditEvent is an append-only record of a security relevant action, such
+// as a mutation or login, along with who performed it and what it changed.
+//
+// Events form a hash chain, each event's hash commits to its contents and
+// the hash of the event before it, so editing or removing an event breaks
+// every link after it.
+type AuditEvent struct {
+	ID        string `json:"id"`
+	Version   uint8  `json:"version"`
+	RequestID string `json:"request_id"`
+
+	// Sequence is the position of the event in the chain, starting from one
+	Sequence int64  `json:"sequence"`
+	PrevHash string `json:"prev_hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+
+	// ActorID and ActorEmail identify the user that performed the action,
+	// they're empty when it was performed without a session such as a
+	// failed login. The email is kept so the event still makes sense after
+	// the user is deleted or changes their email.
+	ActorID    string `json:"actor_id,omitempty"`
+	ActorEmail Email  `json:"actor_email,omitempty"`
+
+	// Action is the name of the mutation, or login for logins
+	Action string `json:"action"`
+
+	// Target identifies what the action was performed on, e.g. a project
+	// label or user email
+	Target string `json:"target,omitempty"`
+
+	Outcome AuditOutcome `json:"outcome"`
+	Error   string       `json:"error,omitempty"`
+
+	// Before and After are JSON summaries of the target before the action
+	// and of the change that was requested, secrets are never included
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+
+	IPAddress string    `json:"ip_address,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewAuditEvent returns an event recording that the action was performed
+func NewAuditEvent(requestID string, action string) AuditEvent {
+	return AuditEvent{
+		ID:        NewID(),
+		Version:   modelVersion,
+		RequestID: requestID,
+		Action:    action,
+		Outcome:   AuditSuccess,
+		CreatedAt: now(),
+	}
+}
+
+// ComputeHash returns the hex encoded sha256 hash of the event, it covers
+// every field other than the hash itself including the previous hash
+func (a AuditEvent) ComputeHash() string {
+	a.Hash = ""
+
+	// Marshalling a struct always produces the same document so the hash
+	// can be recomputed from an export
+	b, err := json.Marshal(a)
+	if err != nil {
+		panic(fmt.Sprintf("Could not marshal audit event: %s", err))
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditCheckpoint is a signature by the coordinator over the hash of an
+// event in the audit chain. Events removed from the end of the chain can't
+// be detected using the hashes alone, a checkpoint proves the chain
+// reached at least its sequence.
+type AuditCheckpoint struct {
+	ID       string `json:"id"`
+	Sequence int64  `json:"sequence"`
+	Hash     string `json:"hash"`
+
+	// KeyID and PublicKey identify the signing key. The public key is only
+	// informational, anyone able to write a checkpoint could have replaced
+	// it, so checkpoints must be verified against a trusted copy of the key.
+	KeyID     string            `json:"key_id"`
+	PublicKey ed25519.PublicKey `json:"public_key"`
+	Signature []byte            `json:"signature"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewAuditCheckpoint returns an unsigned checkpoint of the given event
+func NewAuditCheckpoint(event AuditEvent) AuditCheckpoint {
+	return AuditCheckpoint{
+		ID:        NewID(),
+		Sequence:  event.Sequence,
+		Hash:      event.Hash,
+		CreatedAt: now(),
+	}
+}
+
+// Message returns the bytes signed by the checkpoint
+func (a AuditCheckpoint) Message() []byte {
+	return []byte(fmt.Sprintf("cape-audit-checkpoint:%d:%s", a.Sequence, a.Hash))
+}
+
+// Verify returns whether the checkpoint was signed by the given key, which
+// must come from a trusted source rather than the checkpoint itself
+func (a AuditCheckpoint) Verify(key ed25519.PublicKey) bool {
+	if len(key) != ed25519.PublicKeySize {
+		return false
+	}
+
+	return ed25519.Verify(key, a.Message(), a.Signature)
+}
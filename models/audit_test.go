@@ -0,0 +1,33 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestAuditEventHash(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	event := NewAuditEvent("request-id", "createProject")
+	event.Sequence = 1
+	event.Before = `{"label":"<my-project>"}`
+	event.Hash = event.ComputeHash()
+
+	t.Run("Survives a round trip through JSON", func(t *testing.T) {
+		b, err := json.Marshal(event)
+		gm.Expect(err).To(gm.BeNil())
+
+		var decoded AuditEvent
+		err = json.Unmarshal(b, &decoded)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(decoded.ComputeHash()).To(gm.Equal(event.Hash))
+	})
+
+	t.Run("Commits to the previous hash", func(t *testing.T) {
+		linked := event
+		linked.PrevHash = "abc"
+		gm.Expect(linked.ComputeHash()).ToNot(gm.Equal(event.Hash))
+	})
+}
@@ -0,0 +1,226 @@
+package models
+
+import (
+	"strings"
+)
+
+// commonPasswords are frequently used passwords that appear near the top of
+// public password breach lists, lowercased. Only passwords long enough to
+// otherwise be accepted are included.
+var commonPasswords = newPasswordSet(`
+00000000
+000000000
+0000000000
+0123456789
+11111111
+111111111
+1111111111
+11223344
+123123123
+12341234
+123412345
+123456123
+12345678
+123456789
+1234567890
+1234567891
+12345678910
+1234abcd
+1234qwer
+1q2w3e4r
+1q2w3e4r5t
+1q2w3e4r5t6y
+1qaz2wsx
+1qaz2wsx3edc
+87654321
+88888888
+987654321
+9876543210
+a1b2c3d4
+aa123456
+aaaaaaaa
+abc12345
+abcd1234
+abcd4321
+abcdefg1
+abcdefgh
+access14
+admin123
+admin1234
+adminadmin
+administrator
+alexander
+angel123
+anything
+arsenal1
+asdf1234
+asdfasdf
+asdfghjk
+asdfghjkl
+ashley12
+autumn12
+babygirl
+babygirl1
+baseball
+baseball1
+basketball
+batman123
+benjamin
+blessed1
+blink182
+bubbles1
+butterfly
+changeme
+changeme1
+changeme123
+charlie1
+cheese123
+chelsea1
+chocolate
+computer
+cookie123
+danielle
+december
+default1
+dragon12
+elizabeth
+family12
+flower12
+football
+football1
+football123
+forever1
+fortnite
+freedom1
+friends1
+godisgood
+goodluck
+guest123
+hello123
+hello1234
+helloworld
+hockey12
+iloveyou
+iloveyou1
+iloveyou123
+iloveyou2
+internet
+internet1
+ironman1
+january1
+jennifer
+jessica1
+jesus123
+jonathan
+jordan23
+letmein1
+letmein123
+letmeinnow
+liverpool
+login123
+lovely12
+loveyou1
+manchester
+master12
+matrix123
+michael1
+michelle
+michelle1
+minecraft
+monkey12
+mustang1
+mypassword
+mypassword1
+nicholas
+nothing1
+november
+p@ssw0rd
+p@ssword
+pa$$word
+pa55word
+pakistan
+passport
+passw0rd
+password
+password!
+password01
+password1
+password12
+password123
+password1234
+pokemon1
+princess
+princess1
+princess123
+purple12
+q1w2e3r4
+q1w2e3r4t5
+qazwsxedc
+qwer1234
+qwerty12
+qwerty123
+qwerty1234
+qwerty123456
+qwertyqwerty
+qwertyui
+qwertyuiop
+rainbow1
+root1234
+rootroot
+samantha
+secret123
+security
+september
+shadow12
+soccer12
+something
+spiderman
+spring12
+starwars
+starwars1
+summer12
+summer2020
+summer2021
+summer2022
+sunflower
+sunshine
+sunshine1
+superman
+superman1
+test1234
+testing123
+testtest
+tigger12
+trustme1
+trustno1
+unknown1
+user1234
+victoria
+welcome1
+welcome123
+whatever
+whatever1
+winter12
+zaq12wsx
+zaq1zaq1
+zxcvbnm1
+zxcvbnm123
+zxcvbnma
+zzzzzzzz
+`)
+
+func newPasswordSet(list string) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, p := range strings.Fields(list) {
+		set[p] = struct{}{}
+	}
+
+	return set
+}
+
+// IsCommonPassword returns whether the password, ignoring case, is one of
+// the passwords most commonly used by people
+func IsCommonPassword(p Password) bool {
+	_, ok := commonPasswords[strings.ToLower(p.String())]
+	return ok
+}
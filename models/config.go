@@ -22,9 +22,100 @@ type Config struct {
 	// root key.
 	EncryptionKey *base64.Value `json:"encryption_key"`
 
+	// EncryptionKeyID identifies the EncryptionKey in the values it
+	// encrypts. It is empty for keys created before keys were given ids.
+	EncryptionKeyID string `json:"encryption_key_id,omitempty"`
+
+	// PreviousEncryptionKeys are data keys that have been replaced but may
+	// still have been used to encrypt rows. They're dropped once every row
+	// has been re-encrypted with the current key.
+	PreviousEncryptionKeys []EncryptionKey `json:"previous_encryption_keys,omitempty"`
+
 	// AuthKeypair is encrypted using the root key, similar, to how the
-	// EncryptionKey is encrypted.
+	// EncryptionKey is encrypted. It's the keypair used to sign session
+	// tokens.
 	AuthKeypair *base64.Value `json:"auth_keypair"`
+
+	// AuthKeyID identifies the AuthKeypair in the tokens it signs. It is
+	// empty for keypairs created before keys were given ids.
+	AuthKeyID string `json:"auth_key_id,omitempty"`
+
+	// RetiredAuthKeys are previous auth keypairs, tokens signed by them are
+	// still accepted until they retire
+	RetiredAuthKeys []SigningKey `json:"retired_auth_keys,omitempty"`
+
+	// RequireAdminMFA is an org setting that requires holders of the admin
+	// role to have signed in with a one-time code to use their admin
+	// permissions
+	RequireAdminMFA bool `json:"require_admin_mfa"`
+}
+
+// SigningKey is an auth keypair that has been replaced by a newer one
+type SigningKey struct {
+	ID        string        `json:"id"`
+	Keypair   *base64.Value `json:"keypair"`
+	RetiresAt time.Time     `json:"retires_at"`
+}
+
+// EncryptionKey is a data key that has been replaced by a newer one, it's
+// encrypted by the root key
+type EncryptionKey struct {
+	ID  string        `json:"id"`
+	Key *base64.Value `json:"key"`
+}
+
+// RotateEncryptionKey replaces the data key with the given one. The previous
+// key is kept so rows encrypted with it can be read until they've been
+// re-encrypted.
+func (c *Config) RotateEncryptionKey(id string, key *base64.Value) {
+	c.PreviousEncryptionKeys = append(c.PreviousEncryptionKeys, EncryptionKey{
+		ID:  c.EncryptionKeyID,
+		Key: c.EncryptionKey,
+	})
+
+	c.EncryptionKeyID = id
+	c.EncryptionKey = key
+	c.UpdatedAt = now()
+}
+
+// RetireEncryptionKeys drops the previous data keys, it must only be called
+// once every row has been re-encrypted with the current key
+func (c *Config) RetireEncryptionKeys() {
+	c.PreviousEncryptionKeys = nil
+	c.UpdatedAt = now()
+}
+
+// Reencrypting returns whether rows may still be encrypted with a previous
+// data key
+func (c *Config) Reencrypting() bool {
+	return len(c.PreviousEncryptionKeys) > 0
+}
+
+// RotateAuthKeypair replaces the auth keypair with the given one. The
+// previous keypair is kept until retiresAt so tokens it signed stay valid,
+// keypairs that have already retired are dropped.
+func (c *Config) RotateAuthKeypair(id string, keypair *base64.Value, retiresAt time.Time) {
+	t := now()
+
+	retired := []SigningKey{}
+	for _, key := range c.RetiredAuthKeys {
+		if key.RetiresAt.After(t) {
+			retired = append(retired, key)
+		}
+	}
+
+	if retiresAt.After(t) {
+		retired = append(retired, SigningKey{
+			ID:        c.AuthKeyID,
+			Keypair:   c.AuthKeypair,
+			RetiresAt: retiresAt,
+		})
+	}
+
+	c.AuthKeyID = id
+	c.AuthKeypair = keypair
+	c.RetiredAuthKeys = retired
+	c.UpdatedAt = t
 }
 
 func (c *Config) Validate() error {
@@ -46,11 +137,13 @@ func (c *Config) Validate() error {
 // NewConfig returns a new Config
 func NewConfig(encryptionKey *base64.Value, authKeypair *base64.Value) (*Config, error) {
 	cfg := &Config{
-		ID:            NewID(),
-		CreatedAt:     now(),
-		Setup:         true,
-		EncryptionKey: encryptionKey,
-		AuthKeypair:   authKeypair,
+		ID:              NewID(),
+		CreatedAt:       now(),
+		Setup:           true,
+		EncryptionKey:   encryptionKey,
+		EncryptionKeyID: NewID(),
+		AuthKeypair:     authKeypair,
+		AuthKeyID:       NewID(),
 	}
 
 	return cfg, cfg.Validate()
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+func TestConfigRotateAuthKeypair(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	cfg, err := NewConfig(base64.New([]byte("encryption-key")), base64.New([]byte("first")))
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(cfg.AuthKeyID).ToNot(gm.BeEmpty())
+
+	firstID := cfg.AuthKeyID
+
+	t.Run("keeps the previous keypair until it retires", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		retiresAt := time.Now().Add(time.Hour)
+		cfg.RotateAuthKeypair("second", base64.New([]byte("second")), retiresAt)
+
+		gm.Expect(cfg.AuthKeyID).To(gm.Equal("second"))
+		gm.Expect(cfg.AuthKeypair).To(gm.Equal(base64.New([]byte("second"))))
+		gm.Expect(cfg.RetiredAuthKeys).To(gm.Equal([]SigningKey{
+			{ID: firstID, Keypair: base64.New([]byte("first")), RetiresAt: retiresAt},
+		}))
+		gm.Expect(cfg.Validate()).To(gm.BeNil())
+	})
+
+	t.Run("drops retired keypairs", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		cfg.RetiredAuthKeys[0].RetiresAt = time.Now().Add(-time.Minute)
+		cfg.RotateAuthKeypair("third", base64.New([]byte("third")), time.Now())
+
+		gm.Expect(cfg.AuthKeyID).To(gm.Equal("third"))
+		gm.Expect(cfg.RetiredAuthKeys).To(gm.BeEmpty())
+	})
+}
+
+func TestConfigRotateEncryptionKey(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	cfg, err := NewConfig(base64.New([]byte("first")), base64.New([]byte("keypair")))
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(cfg.EncryptionKeyID).ToNot(gm.BeEmpty())
+	gm.Expect(cfg.Reencrypting()).To(gm.BeFalse())
+
+	firstID := cfg.EncryptionKeyID
+
+	t.Run("keeps the previous key until rows are re-encrypted", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		cfg.RotateEncryptionKey("second", base64.New([]byte("second")))
+
+		gm.Expect(cfg.EncryptionKeyID).To(gm.Equal("second"))
+		gm.Expect(cfg.EncryptionKey).To(gm.Equal(base64.New([]byte("second"))))
+		gm.Expect(cfg.PreviousEncryptionKeys).To(gm.Equal([]EncryptionKey{
+			{ID: firstID, Key: base64.New([]byte("first"))},
+		}))
+		gm.Expect(cfg.Reencrypting()).To(gm.BeTrue())
+	})
+
+	t.Run("drops previous keys once retired", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		cfg.RetireEncryptionKeys()
+		gm.Expect(cfg.PreviousEncryptionKeys).To(gm.BeEmpty())
+		gm.Expect(cfg.Reencrypting()).To(gm.BeFalse())
+	})
+}
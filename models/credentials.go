@@ -62,6 +62,19 @@ type Credentials struct {
 	Secret *base64.Value      `json:"secret"`
 	Salt   *base64.Value      `json:"salt"`
 	Alg    CredentialsAlgType `json:"alg"`
+
+	// Params are the parameters the secret was hashed with. Credentials
+	// created before parameters were recorded don't have them.
+	Params *Argon2Params `json:"params,omitempty"`
+}
+
+// Argon2Params are the cost parameters given to Argon2ID when hashing a
+// secret. Memory is in KiB.
+type Argon2Params struct {
+	Time      uint32 `json:"time"`
+	Memory    uint32 `json:"memory"`
+	Threads   uint8  `json:"threads"`
+	KeyLength uint32 `json:"key_length"`
 }
 
 // GenerateCredentials returns an instantiated Credentials for use in unit testing.
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// Duration wraps time.Duration so it can be written in configuration files
+// using the human readable form accepted by time.ParseDuration (e.g. "10m").
+type Duration struct {
+	time.Duration
+}
+
+// NewDuration returns a Duration wrapping the given time.Duration
+func NewDuration(d time.Duration) Duration {
+	return Duration{Duration: d}
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.Duration.String())), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return errors.New(InvalidConfigCause, "Durations must be provided as a string")
+	}
+
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return errors.New(InvalidConfigCause, "Invalid duration: %s", err)
+	}
+
+	d.Duration = v
+	return nil
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestDuration(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("marshal to json", func(t *testing.T) {
+		result, err := json.Marshal(NewDuration(90 * time.Second))
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(string(result)).To(gm.Equal("\"1m30s\""))
+	})
+
+	t.Run("unmarshal from json", func(t *testing.T) {
+		d := &Duration{}
+		err := json.Unmarshal([]byte("\"10m\""), d)
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(d.Duration).To(gm.Equal(10 * time.Minute))
+	})
+
+	t.Run("unmarshal rejects invalid durations", func(t *testing.T) {
+		for _, in := range []string{"\"ten minutes\"", "600"} {
+			d := &Duration{}
+			err := json.Unmarshal([]byte(in), d)
+			gm.Expect(errors.FromCause(err, InvalidConfigCause)).To(gm.BeTrue())
+		}
+	})
+}
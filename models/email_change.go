@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// EmailChangeExpiration is the amount of time a pending email change can be
+// confirmed for after it has been requested.
+var EmailChangeExpiration = 24 * time.Hour
+
+// EmailChange represents a request by a user to change their email address.
+// The change is only applied once the owner of the new address confirms it
+// using the secret sent to them.
+type EmailChange struct {
+	ID          string       `json:"id"`
+	UserID      string       `json:"user_id"`
+	Email       Email        `json:"email"`
+	Credentials *Credentials `json:"-" gqlgen:"-"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+func (e *EmailChange) Validate() error {
+	if e.ID == "" {
+		return fmt.Errorf("id must not be empty")
+	}
+
+	if e.UserID == "" {
+		return fmt.Errorf("user id must not be empty")
+	}
+
+	if e.Email == "" {
+		return fmt.Errorf("email must not be empty")
+	}
+
+	if e.Credentials == nil {
+		return fmt.Errorf("missing credentials")
+	}
+
+	if e.ExpiresAt.IsZero() {
+		return fmt.Errorf("missing expires at")
+	}
+
+	return nil
+}
+
+func (e *EmailChange) Expired() bool {
+	return time.Now().UTC().After(e.ExpiresAt)
+}
+
+func NewEmailChange(userID string, email Email, creds *Credentials) EmailChange {
+	return EmailChange{
+		ID:          NewID(),
+		UserID:      userID,
+		Email:       email,
+		Credentials: creds,
+		ExpiresAt:   time.Now().UTC().Add(EmailChangeExpiration),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestEmailChange(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	_, user := GenerateUser("hi", "hi@hi.hi")
+
+	creds := GenerateCredentials()
+	email := Email("new@hi.hi")
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			fn    func() EmailChange
+			cause string
+		}{
+			{
+				name: "valid email change",
+				fn: func() EmailChange {
+					return NewEmailChange(user.ID, email, creds)
+				},
+			},
+			{
+				name: "invalid user id",
+				fn: func() EmailChange {
+					e := NewEmailChange(user.ID, email, creds)
+					e.UserID = ""
+					return e
+				},
+				cause: "user id must not be empty",
+			},
+			{
+				name: "missing email",
+				fn: func() EmailChange {
+					return NewEmailChange(user.ID, "", creds)
+				},
+				cause: "email must not be empty",
+			},
+			{
+				name: "missing credentials",
+				fn: func() EmailChange {
+					return NewEmailChange(user.ID, email, nil)
+				},
+				cause: "missing credentials",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				e := tc.fn()
+				err := e.Validate()
+				if tc.cause != "" {
+					gm.Expect(err).ToNot(gm.BeNil())
+					gm.Expect(err.Error()).To(gm.Equal(tc.cause))
+					return
+				}
+
+				gm.Expect(err).To(gm.BeNil())
+			})
+		}
+	})
+
+	t.Run("expired returns true if expire at exceeded", func(t *testing.T) {
+		e := NewEmailChange(user.ID, email, creds)
+		e.ExpiresAt = time.Now().UTC().Add(-1 * time.Minute)
+		gm.Expect(e.Expired()).To(gm.BeTrue())
+	})
+}
@@ -24,4 +24,6 @@ var (
 	SystemErrorCause        = errors.NewCause(errors.InternalServerErrorCategory, "system_error")
 	InvalidProjectNameCause = errors.NewCause(errors.BadRequestCategory, "invalid_project_name")
 	InvalidRecoveryCause    = errors.NewCause(errors.BadRequestCategory, "invalid_recovery")
+	InvalidRoleCause        = errors.NewCause(errors.BadRequestCategory, "invalid_role")
+	InvalidTeamCause        = errors.NewCause(errors.BadRequestCategory, "invalid_team")
 )
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// InvitationExpiration is the amount of time an invitation can be accepted
+// for after it has been sent.
+var InvitationExpiration = 7 * 24 * time.Hour
+
+// InvitationProject is a project membership that will be granted to the
+// invitee once they accept their invitation.
+type InvitationProject struct {
+	Label Label `json:"label"`
+	Role  Label `json:"role"`
+}
+
+// Invitation represents an invite for someone to create an account. The
+// invitee chooses their own password when accepting the invitation.
+type Invitation struct {
+	ID          string              `json:"id"`
+	Email       Email               `json:"email"`
+	Role        Label               `json:"role"`
+	Projects    []InvitationProject `json:"projects"`
+	InvitedBy   string              `json:"invited_by"`
+	Credentials *Credentials        `json:"-" gqlgen:"-"`
+	ExpiresAt   time.Time           `json:"expires_at"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+func (i *Invitation) Validate() error {
+	if i.ID == "" {
+		return fmt.Errorf("id must not be empty")
+	}
+
+	if i.Email == "" {
+		return fmt.Errorf("email must not be empty")
+	}
+
+	if !ValidOrgRole(i.Role) {
+		return fmt.Errorf("invalid org role: %s", i.Role)
+	}
+
+	for _, p := range i.Projects {
+		if !ValidProjectRole(p.Role) {
+			return fmt.Errorf("invalid project role %s for project %s", p.Role, p.Label)
+		}
+	}
+
+	if i.Credentials == nil {
+		return fmt.Errorf("missing credentials")
+	}
+
+	if i.ExpiresAt.IsZero() {
+		return fmt.Errorf("missing expires at")
+	}
+
+	return nil
+}
+
+func (i *Invitation) Expired() bool {
+	return time.Now().UTC().After(i.ExpiresAt)
+}
+
+// Renew replaces the credentials of the invitation and resets the
+// expiration. Any previously sent secret can no longer be used.
+func (i *Invitation) Renew(creds *Credentials) {
+	i.Credentials = creds
+	i.ExpiresAt = time.Now().UTC().Add(InvitationExpiration)
+	i.UpdatedAt = time.Now()
+}
+
+func NewInvitation(email Email, role Label, projects []InvitationProject, invitedBy string, creds *Credentials) Invitation {
+	return Invitation{
+		ID:          NewID(),
+		Email:       email,
+		Role:        role,
+		Projects:    projects,
+		InvitedBy:   invitedBy,
+		Credentials: creds,
+		ExpiresAt:   time.Now().UTC().Add(InvitationExpiration),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
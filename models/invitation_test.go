@@ -0,0 +1,80 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestInvitation(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	creds := GenerateCredentials()
+	email := Email("invited@cape.com")
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			fn    func() Invitation
+			cause string
+		}{
+			{
+				name: "valid invitation",
+				fn: func() Invitation {
+					return NewInvitation(email, UserRole, []InvitationProject{
+						{Label: "my-project", Role: ProjectReaderRole},
+					}, "thisisanid", creds)
+				},
+			},
+			{
+				name: "invalid org role",
+				fn: func() Invitation {
+					return NewInvitation(email, ProjectOwnerRole, nil, "thisisanid", creds)
+				},
+				cause: "invalid org role: project-owner",
+			},
+			{
+				name: "invalid project role",
+				fn: func() Invitation {
+					return NewInvitation(email, UserRole, []InvitationProject{
+						{Label: "my-project", Role: AdminRole},
+					}, "thisisanid", creds)
+				},
+				cause: "invalid project role admin for project my-project",
+			},
+			{
+				name: "missing credentials",
+				fn: func() Invitation {
+					return NewInvitation(email, UserRole, nil, "thisisanid", nil)
+				},
+				cause: "missing credentials",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				i := tc.fn()
+				err := i.Validate()
+				if tc.cause != "" {
+					gm.Expect(err).ToNot(gm.BeNil())
+					gm.Expect(err.Error()).To(gm.Equal(tc.cause))
+					return
+				}
+
+				gm.Expect(err).To(gm.BeNil())
+			})
+		}
+	})
+
+	t.Run("renew resets the expiry", func(t *testing.T) {
+		i := NewInvitation(email, UserRole, nil, "thisisanid", creds)
+		i.ExpiresAt = time.Now().UTC().Add(-1 * time.Minute)
+		gm.Expect(i.Expired()).To(gm.BeTrue())
+
+		newCreds := GenerateCredentials()
+		i.Renew(newCreds)
+		gm.Expect(i.Expired()).To(gm.BeFalse())
+		gm.Expect(i.Credentials).To(gm.Equal(newCreds))
+	})
+}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+)
+
+// RecoveryCodeCount is the number of recovery codes issued when a user
+// confirms their MFA enrollment
+var RecoveryCodeCount = 10
+
+// MFAEnrollment holds the TOTP secret a user has enrolled for multi-factor
+// authentication along with the hashes of their unused recovery codes.
+//
+// An enrollment isn't enforced at login until it has been Confirmed by the
+// user providing a valid one-time code.
+type MFAEnrollment struct {
+	ID     string        `json:"id"`
+	UserID string        `json:"user_id"`
+	Secret *base64.Value `json:"secret"`
+
+	Confirmed bool `json:"confirmed"`
+
+	// LastUsedStep is the TOTP time step of the last accepted code, codes
+	// for this step or earlier are rejected to prevent replays
+	LastUsedStep int64 `json:"last_used_step"`
+
+	RecoveryCodes []string `json:"recovery_codes"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (m *MFAEnrollment) Validate() error {
+	if m.ID == "" {
+		return fmt.Errorf("id must not be empty")
+	}
+
+	if m.UserID == "" {
+		return fmt.Errorf("user id must not be empty")
+	}
+
+	if m.Secret == nil {
+		return fmt.Errorf("missing secret")
+	}
+
+	return nil
+}
+
+// SetRecoveryCodes replaces any existing recovery codes with the hashes of
+// the given codes
+func (m *MFAEnrollment) SetRecoveryCodes(codes []string) {
+	m.RecoveryCodes = make([]string, len(codes))
+	for i, code := range codes {
+		m.RecoveryCodes[i] = hashRecoveryCode(code)
+	}
+}
+
+// UseRecoveryCode returns whether the code matches one of the unused
+// recovery codes, removing it so it can't be used again
+func (m *MFAEnrollment) UseRecoveryCode(code string) bool {
+	hash := hashRecoveryCode(code)
+	for i, c := range m.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(c), []byte(hash)) == 1 {
+			m.RecoveryCodes = append(m.RecoveryCodes[:i], m.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewMFAEnrollment returns an unconfirmed enrollment for the given user
+func NewMFAEnrollment(userID string, secret *base64.Value) MFAEnrollment {
+	return MFAEnrollment{
+		ID:        NewID(),
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+}
+
+// GenerateRecoveryCodes returns RecoveryCodeCount random single use codes
+// of the form xxxxx-xxxxx
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, err
+		}
+
+		code := hex.EncodeToString(b)
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+
+	return codes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+func TestMFAEnrollment(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	secret := base64.New([]byte("12345678901234567890"))
+
+	t.Run("Validate", func(t *testing.T) {
+		m := NewMFAEnrollment("user", secret)
+		gm.Expect(m.Validate()).To(gm.BeNil())
+		gm.Expect(m.Confirmed).To(gm.BeFalse())
+
+		m.Secret = nil
+		gm.Expect(m.Validate()).ToNot(gm.BeNil())
+	})
+
+	t.Run("recovery codes are single use", func(t *testing.T) {
+		codes, err := GenerateRecoveryCodes()
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(codes).To(gm.HaveLen(RecoveryCodeCount))
+
+		m := NewMFAEnrollment("user", secret)
+		m.SetRecoveryCodes(codes)
+
+		for _, hash := range m.RecoveryCodes {
+			gm.Expect(codes).ToNot(gm.ContainElement(hash))
+		}
+
+		gm.Expect(m.UseRecoveryCode(strings.ToUpper(codes[3]))).To(gm.BeTrue())
+		gm.Expect(m.RecoveryCodes).To(gm.HaveLen(RecoveryCodeCount - 1))
+		gm.Expect(m.UseRecoveryCode(codes[3])).To(gm.BeFalse())
+		gm.Expect(m.UseRecoveryCode("not-a-code")).To(gm.BeFalse())
+	})
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"unicode"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// PasswordPolicy describes the passwords people can choose. The zero value
+// requires passwords to be at least MinPasswordLength long and rejects
+// common passwords.
+type PasswordPolicy struct {
+	// MinLength is the shortest password allowed, it can't be less than
+	// MinPasswordLength
+	MinLength int `json:"min_length,omitempty"`
+
+	RequireUpper  bool `json:"require_upper,omitempty"`
+	RequireLower  bool `json:"require_lower,omitempty"`
+	RequireDigit  bool `json:"require_digit,omitempty"`
+	RequireSymbol bool `json:"require_symbol,omitempty"`
+
+	// AllowCommon permits passwords from the bundled list of commonly used
+	// passwords
+	AllowCommon bool `json:"allow_common,omitempty"`
+
+	// History is the number of previous passwords that can't be reused
+	History int `json:"history,omitempty"`
+}
+
+// GetMinLength returns the configured minimum length or MinPasswordLength if
+// one was not provided
+func (p PasswordPolicy) GetMinLength() int {
+	if p.MinLength < MinPasswordLength {
+		return MinPasswordLength
+	}
+
+	return p.MinLength
+}
+
+// Validate returns an error if the policy can't be satisfied
+func (p PasswordPolicy) Validate() error {
+	if p.MinLength > MaxPasswordLength {
+		return errors.New(InvalidConfigCause, "Minimum password length cannot be more than %d", MaxPasswordLength)
+	}
+
+	if p.History < 0 {
+		return errors.New(InvalidConfigCause, "Password history cannot be negative")
+	}
+
+	return nil
+}
+
+// Check returns an error describing why the password doesn't satisfy the
+// policy
+func (p PasswordPolicy) Check(password Password) error {
+	if err := password.Validate(); err != nil {
+		return err
+	}
+
+	if len(password.String()) < p.GetMinLength() {
+		return errors.New(InvalidPasswordCause, "Passwords must be at least %d characters long", p.GetMinLength())
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password.String() {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	if p.RequireUpper && !upper {
+		return errors.New(InvalidPasswordCause, "Passwords must contain an uppercase letter")
+	}
+
+	if p.RequireLower && !lower {
+		return errors.New(InvalidPasswordCause, "Passwords must contain a lowercase letter")
+	}
+
+	if p.RequireDigit && !digit {
+		return errors.New(InvalidPasswordCause, "Passwords must contain a digit")
+	}
+
+	if p.RequireSymbol && !symbol {
+		return errors.New(InvalidPasswordCause, "Passwords must contain a symbol")
+	}
+
+	if !p.AllowCommon && IsCommonPassword(password) {
+		return errors.New(InvalidPasswordCause, "This password is too common, please choose another")
+	}
+
+	return nil
+}
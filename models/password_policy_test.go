@@ -0,0 +1,111 @@
+package models
+
+import (
+	"testing"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password Password
+		cause    string
+	}{
+		{
+			name:     "default policy accepts a long password",
+			password: Password("correcthorsebatterystaple"),
+		},
+		{
+			name:     "default policy rejects a short password",
+			password: Password("abcd"),
+			cause:    "invalid_password: Passwords must be at least 8 characters long",
+		},
+		{
+			name:     "default policy rejects common passwords ignoring case",
+			password: Password("PassWord123"),
+			cause:    "invalid_password: This password is too common, please choose another",
+		},
+		{
+			name:     "common passwords can be allowed",
+			policy:   PasswordPolicy{AllowCommon: true},
+			password: Password("password123"),
+		},
+		{
+			name:     "longer minimum length",
+			policy:   PasswordPolicy{MinLength: 12},
+			password: Password("abcdefghij"),
+			cause:    "invalid_password: Passwords must be at least 12 characters long",
+		},
+		{
+			name:     "requires uppercase",
+			policy:   PasswordPolicy{RequireUpper: true},
+			password: Password("correcthorse"),
+			cause:    "invalid_password: Passwords must contain an uppercase letter",
+		},
+		{
+			name:     "requires lowercase",
+			policy:   PasswordPolicy{RequireLower: true},
+			password: Password("CORRECTHORSE"),
+			cause:    "invalid_password: Passwords must contain a lowercase letter",
+		},
+		{
+			name:     "requires digit",
+			policy:   PasswordPolicy{RequireDigit: true},
+			password: Password("correcthorse"),
+			cause:    "invalid_password: Passwords must contain a digit",
+		},
+		{
+			name:     "requires symbol",
+			policy:   PasswordPolicy{RequireSymbol: true},
+			password: Password("correcthorse1"),
+			cause:    "invalid_password: Passwords must contain a symbol",
+		},
+		{
+			name:     "satisfies every character class",
+			policy:   PasswordPolicy{RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true},
+			password: Password("Correct-Horse-1"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Check(tc.password)
+			if tc.cause == "" {
+				gm.Expect(err).To(gm.BeNil())
+				return
+			}
+
+			gm.Expect(err).ToNot(gm.BeNil())
+			gm.Expect(err.Error()).To(gm.Equal(tc.cause))
+		})
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		gm.Expect(PasswordPolicy{}.Validate()).To(gm.BeNil())
+		gm.Expect(PasswordPolicy{MinLength: MaxPasswordLength + 1}.Validate()).ToNot(gm.BeNil())
+		gm.Expect(PasswordPolicy{History: -1}.Validate()).ToNot(gm.BeNil())
+	})
+}
+
+func TestRememberPassword(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	_, user := GenerateUser("hi", "hi@hi.hi")
+	first := user.Credentials
+
+	second := GenerateCredentials()
+	user.RememberPassword(*second, 2)
+	gm.Expect(user.Credentials).To(gm.Equal(*second))
+	gm.Expect(user.PasswordHistory).To(gm.Equal([]Credentials{first}))
+
+	third := GenerateCredentials()
+	user.RememberPassword(*third, 1)
+	gm.Expect(user.PasswordHistory).To(gm.Equal([]Credentials{*second}))
+
+	user.RememberPassword(*GenerateCredentials(), 0)
+	gm.Expect(user.PasswordHistory).To(gm.BeNil())
+}
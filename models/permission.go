@@ -0,0 +1,118 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// permissionNames are the names used to refer to a single permission when
+// scoping an API token, they're stored in the database so must not change
+var permissionNames = map[Permission]string{
+	WritePolicy:           "write-policy",
+	CreateProject:         "create-project",
+	CreateOwnToken:        "create-own-token",
+	CreateAnyToken:        "create-any-token",
+	RemoveOwnToken:        "remove-own-token",
+	RemoveAnyToken:        "remove-any-token",
+	ListOwnTokens:         "list-own-tokens",
+	ListAnyTokens:         "list-any-tokens",
+	ArchiveProject:        "archive-project",
+	UnarchiveProject:      "unarchive-project",
+	DeleteOwnedProject:    "delete-owned-project",
+	DeleteAnyProject:      "delete-any-project",
+	AddUser:               "add-user",
+	DeleteUser:            "delete-user",
+	UpdateProject:         "update-project",
+	SuggestPolicy:         "suggest-policy",
+	AcceptPolicy:          "accept-policy",
+	RejectPolicy:          "reject-policy",
+	ReadPolicy:            "read-policy",
+	ListPolicySuggestions: "list-policy-suggestions",
+	ChangeRole:            "change-role",
+	ChangeProjectRole:     "change-project-role",
+	ListRecoveries:        "list-recoveries",
+	DeleteRecoveries:      "delete-recoveries",
+	RevokeAnySessions:     "revoke-any-sessions",
+	ResetAnyMFA:           "reset-any-mfa",
+	RequireAdminMFA:       "require-admin-mfa",
+	UnlockAnyUser:         "unlock-any-user",
+	ManageRoles:           "manage-roles",
+	ListUsers:             "list-users",
+	ManageTeams:           "manage-teams",
+	ReadAuditLog:          "read-audit-log",
+	UseSecrets:            "use-secrets",
+	RevealSecrets:         "reveal-secrets",
+}
+
+// PermissionNames returns the names of every permission in alphabetical
+// order
+func PermissionNames() []string {
+	names := make([]string, 0, len(permissionNames))
+	for _, name := range permissionNames {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// ParsePermission returns the permission with the given name
+func ParsePermission(name string) (Permission, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for p, n := range permissionNames {
+		if n == name {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown permission %q", name)
+}
+
+// String returns the name of a single permission
+func (p Permission) String() string {
+	name, ok := permissionNames[p]
+	if !ok {
+		return "unknown"
+	}
+
+	return name
+}
+
+// MarshalText implements encoding.TextMarshaler so permissions are stored
+// using their names
+func (p Permission) MarshalText() ([]byte, error) {
+	if _, ok := permissionNames[p]; !ok {
+		return nil, fmt.Errorf("cannot marshal unknown permission %d", uint64(p))
+	}
+
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (p *Permission) UnmarshalText(b []byte) error {
+	perm, err := ParsePermission(string(b))
+	if err != nil {
+		return err
+	}
+
+	*p = perm
+	return nil
+}
+
+// UnmarshalGQL unmarshals a permission from its name
+func (p *Permission) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal Permission")
+	}
+
+	return p.UnmarshalText([]byte(str))
+}
+
+// MarshalGQL marshals a permission to its name
+func (p Permission) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(p.String()))
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestPermission(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("every permission has a name", func(t *testing.T) {
+		for p := WritePolicy; p <= RevealSecrets; p <<= 1 {
+			gm.Expect(p.String()).ToNot(gm.Equal("unknown"))
+
+			parsed, err := ParsePermission(p.String())
+			gm.Expect(err).To(gm.BeNil())
+			gm.Expect(parsed).To(gm.Equal(p))
+		}
+	})
+
+	t.Run("can't parse an unknown permission", func(t *testing.T) {
+		_, err := ParsePermission("launch-rockets")
+		gm.Expect(err).ToNot(gm.BeNil())
+	})
+
+	t.Run("marshals to json using names", func(t *testing.T) {
+		b, err := json.Marshal([]Permission{ReadPolicy, ListOwnTokens})
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(string(b)).To(gm.Equal(`["read-policy","list-own-tokens"]`))
+
+		var perms []Permission
+		err = json.Unmarshal(b, &perms)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(perms).To(gm.Equal([]Permission{ReadPolicy, ListOwnTokens}))
+	})
+}
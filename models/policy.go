@@ -147,10 +147,24 @@ func (n NamedTransformation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, string(json))
 }
 
+// FirstSecretVersion is the version of a secret when it's created, secret
+// arguments written before secrets were versioned refer to it
+const FirstSecretVersion = 1
+
 type SecretArg struct {
-	Type  string        `json:"type,omitempty"`
-	Name  string        `json:"name"`
-	Value *base64.Value `json:"value,omitempty"`
+	Type    string        `json:"type,omitempty"`
+	Name    string        `json:"name"`
+	Version int           `json:"version,omitempty"`
+	Value   *base64.Value `json:"value,omitempty"`
+}
+
+// SecretVersion returns the version of the secret the argument refers to
+func (s SecretArg) SecretVersion() int {
+	if s.Version == 0 {
+		return FirstSecretVersion
+	}
+
+	return s.Version
 }
 
 // findSecretArgs is used by UnmarshalGQL and UnmarshalJSON to find secret args
@@ -169,6 +183,12 @@ func findSecretArgs(args map[string]interface{}) error {
 			Name: argMap["name"].(string),
 		}
 
+		version, err := secretVersion(argMap["version"])
+		if err != nil {
+			return err
+		}
+		sec.Version = version
+
 		val, ok := argMap["value"].(string)
 		if ok {
 			bVal, err := base64.NewFromString(val)
@@ -184,6 +204,26 @@ func findSecretArgs(args map[string]interface{}) error {
 	return nil
 }
 
+// secretVersion reads the version of a secret arg, which is a float when
+// decoded from JSON and an integer or number when decoded from GraphQL
+func secretVersion(v interface{}) (int, error) {
+	switch version := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int(version), nil
+	case int:
+		return version, nil
+	case int64:
+		return int(version), nil
+	case json.Number:
+		i, err := version.Int64()
+		return int(i), err
+	default:
+		return 0, fmt.Errorf("invalid secret version %v", v)
+	}
+}
+
 func ParseProjectSpecFile(data []byte) (*PolicyFile, error) {
 	var spec PolicyFile
 	if err := yaml.Unmarshal(data, &spec); err != nil {
@@ -202,6 +242,10 @@ type Policy struct {
 	Version         uint8                  `json:"version"`
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
+
+	// Encrypted holds the transformations and rules while the policy is
+	// stored, they're empty until it's decrypted
+	Encrypted *base64.Value `json:"encrypted,omitempty"`
 }
 
 func (p *Policy) Validate() error {
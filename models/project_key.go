@@ -0,0 +1,37 @@
+package models
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+)
+
+// ProjectKeyLength is the length in bytes of a project's data key
+const ProjectKeyLength = 32
+
+// ProjectKey is the data key that encrypts a project's secrets. Every
+// project has its own key so destroying it renders the project's secrets
+// unrecoverable without affecting any other project.
+type ProjectKey struct {
+	ID        string        `json:"id"`
+	ProjectID string        `json:"project_id"`
+	Key       *base64.Value `json:"key"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// NewProjectKey generates a random data key for the project
+func NewProjectKey(projectID string) (ProjectKey, error) {
+	key := make([]byte, ProjectKeyLength)
+	_, err := rand.Read(key)
+	if err != nil {
+		return ProjectKey{}, err
+	}
+
+	return ProjectKey{
+		ID:        NewID(),
+		ProjectID: projectID,
+		Key:       base64.New(key),
+		CreatedAt: now(),
+	}, nil
+}
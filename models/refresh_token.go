@@ -0,0 +1,91 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/manifoldco/go-base64"
+)
+
+// RefreshTokenLength is the number of random bytes in a refresh token
+const RefreshTokenLength = 32
+
+// RefreshToken can be exchanged once for a new session continuing the
+// session it was issued with. Only a hash of the token is stored.
+//
+// Used refresh tokens are kept until they expire, a used token being
+// presented again means it has been stolen so the whole session family is
+// revoked.
+type RefreshToken struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	SessionID string     `json:"session_id"`
+	FamilyID  string     `json:"family_id"`
+	Hash      string     `json:"hash"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+}
+
+func (r *RefreshToken) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("id must not be empty")
+	}
+
+	if r.UserID == "" || r.SessionID == "" || r.FamilyID == "" {
+		return fmt.Errorf("user, session and family ids must not be empty")
+	}
+
+	if r.Hash == "" {
+		return fmt.Errorf("missing hash")
+	}
+
+	if r.ExpiresAt.IsZero() {
+		return fmt.Errorf("missing expires at")
+	}
+
+	return nil
+}
+
+// Expired returns whether the token can no longer be used
+func (r *RefreshToken) Expired() bool {
+	return time.Now().UTC().After(r.ExpiresAt)
+}
+
+// Used returns whether the token has already been exchanged for a session
+func (r *RefreshToken) Used() bool {
+	return r.UsedAt != nil
+}
+
+// NewRefreshToken returns a refresh token for the session along with the
+// secret value that is handed to the client
+func NewRefreshToken(session Session, expiresAt time.Time) (RefreshToken, *base64.Value, error) {
+	by := make([]byte, RefreshTokenLength)
+	_, err := rand.Read(by)
+	if err != nil {
+		return RefreshToken{}, nil, err
+	}
+
+	secret := base64.New(by)
+	token := RefreshToken{
+		ID:        NewID(),
+		UserID:    session.UserID,
+		SessionID: session.ID,
+		FamilyID:  session.GetFamilyID(),
+		Hash:      HashRefreshToken(secret),
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}
+
+	return token, secret, token.Validate()
+}
+
+// HashRefreshToken returns the hash a refresh token is stored and looked up
+// by. The token is random so a fast hash is sufficient.
+func HashRefreshToken(secret *base64.Value) string {
+	sum := sha256.Sum256(*secret)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestRefreshToken(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	_, user := GenerateUser("bob", "test@email.com")
+	session := NewSession(&user)
+
+	t.Run("new refresh token", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		token, secret, err := NewRefreshToken(session, time.Now().UTC().Add(time.Hour))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(*secret).To(gm.HaveLen(RefreshTokenLength))
+
+		gm.Expect(token.SessionID).To(gm.Equal(session.ID))
+		gm.Expect(token.FamilyID).To(gm.Equal(session.ID))
+		gm.Expect(token.UserID).To(gm.Equal(user.ID))
+		gm.Expect(token.Hash).To(gm.Equal(HashRefreshToken(secret)))
+		gm.Expect(token.Expired()).To(gm.BeFalse())
+		gm.Expect(token.Used()).To(gm.BeFalse())
+	})
+
+	t.Run("secrets are unique", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		_, a, err := NewRefreshToken(session, time.Now().UTC().Add(time.Hour))
+		gm.Expect(err).To(gm.BeNil())
+
+		_, b, err := NewRefreshToken(session, time.Now().UTC().Add(time.Hour))
+		gm.Expect(err).To(gm.BeNil())
+
+		gm.Expect(HashRefreshToken(a)).ToNot(gm.Equal(HashRefreshToken(b)))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		token, _, err := NewRefreshToken(session, time.Now().UTC().Add(-time.Minute))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(token.Expired()).To(gm.BeTrue())
+	})
+
+	t.Run("renewed sessions share a family", func(t *testing.T) {
+		gm.RegisterTestingT(t)
+
+		renewed := session.Renew()
+		gm.Expect(renewed.ID).ToNot(gm.Equal(session.ID))
+		gm.Expect(renewed.FamilyID).To(gm.Equal(session.FamilyID))
+		gm.Expect(renewed.AuthenticatedAt).To(gm.Equal(session.AuthenticatedAt))
+		gm.Expect(renewed.Token).To(gm.BeNil())
+
+		token, _, err := NewRefreshToken(renewed, time.Now().UTC().Add(time.Hour))
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(token.FamilyID).To(gm.Equal(session.ID))
+	})
+}
@@ -2,7 +2,10 @@ package models
 
 import (
 	"fmt"
+	"math/bits"
 	"time"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
 )
 
 type Permission uint64
@@ -37,6 +40,37 @@ const (
 	// Roles
 	ChangeRole
 	ChangeProjectRole
+
+	// Recoveries
+	ListRecoveries
+	DeleteRecoveries
+
+	// Sessions
+	RevokeAnySessions
+
+	// MFA
+	ResetAnyMFA
+	RequireAdminMFA
+
+	// Lockouts
+	UnlockAnyUser
+
+	// Custom roles
+	ManageRoles
+
+	// Users
+	ListUsers
+
+	// Teams
+	ManageTeams
+
+	// Audit log
+	ReadAuditLog
+
+	// Secrets, using a secret lets transformations refer to it while
+	// revealing it shows its value
+	UseSecrets
+	RevealSecrets
 )
 
 const (
@@ -58,9 +92,25 @@ var (
 		CreateOwnToken, RemoveOwnToken, ListOwnTokens,
 		CreateAnyToken, RemoveAnyToken, ListAnyTokens,
 
-		DeleteAnyProject,
+		ArchiveProject, UnarchiveProject, DeleteAnyProject,
 
 		ChangeRole,
+
+		ListRecoveries, DeleteRecoveries,
+
+		RevokeAnySessions,
+
+		ResetAnyMFA, RequireAdminMFA,
+
+		UnlockAnyUser,
+
+		ManageRoles,
+
+		ListUsers,
+
+		ManageTeams,
+
+		ReadAuditLog,
 	)
 
 	userRules = withRules(
@@ -75,10 +125,12 @@ var (
 
 	projectContributorRules = withRules(
 		projectReaderRules, UpdateProject, SuggestPolicy, ListPolicySuggestions, RejectPolicy,
+		UseSecrets,
 	)
 
 	projectOwnerRules = withRules(
-		projectContributorRules, AcceptPolicy, UnarchiveProject, DeleteOwnedProject, ChangeProjectRole,
+		projectContributorRules, AcceptPolicy, ArchiveProject, UnarchiveProject, DeleteOwnedProject, ChangeProjectRole,
+		RevealSecrets,
 	)
 
 	DefaultPermissions = map[Label]Permission{
@@ -110,6 +162,37 @@ var ProjectRoles = []Label{ProjectOwnerRole, ProjectContributorRole, ProjectRead
 // SystemRoles are all builtin roles
 var SystemRoles = append(OrgRoles, ProjectRoles...)
 
+// RoleKind is whether a role is assigned within the org or within a project
+type RoleKind string
+
+const (
+	OrgRoleKind     RoleKind = "org"
+	ProjectRoleKind RoleKind = "project"
+)
+
+// Validate checks that the kind is either org or project
+func (k RoleKind) Validate() error {
+	switch k {
+	case OrgRoleKind, ProjectRoleKind:
+		return nil
+	default:
+		return errors.New(InvalidRoleCause, "invalid role kind %q", k)
+	}
+}
+
+func (k RoleKind) String() string {
+	return string(k)
+}
+
+// SystemRoleKind returns the kind of a builtin role
+func SystemRoleKind(role Label) RoleKind {
+	if ValidProjectRole(role) {
+		return ProjectRoleKind
+	}
+
+	return OrgRoleKind
+}
+
 func ValidOrgRole(role Label) bool {
 	for _, r := range OrgRoles {
 		if role == r {
@@ -141,6 +224,26 @@ func (p ProjectRolesMap) Get(l Label) (*Role, error) {
 	return &r, nil
 }
 
+// Grant adds a role to the user's roles in a project. A user granted more
+// than one role in a project, e.g. directly and through a team, has the
+// permissions of all of them and is shown the role granting the most.
+func (p ProjectRolesMap) Grant(label Label, role Role) {
+	existing, ok := p[label]
+	if !ok {
+		p[label] = role
+		return
+	}
+
+	if bits.OnesCount64(uint64(role.Rules())) > bits.OnesCount64(uint64(existing.Rules())) {
+		role.Inherited |= existing.Rules()
+		p[label] = role
+		return
+	}
+
+	existing.Inherited |= role.Rules()
+	p[label] = existing
+}
+
 // UserRoles represents the roles assigned to a user. A user
 // can only have one global role and then one project role per project
 // that they are a member of.
@@ -153,14 +256,38 @@ type UserRoles struct {
 	Projects ProjectRolesMap
 }
 
+// Restrict limits the global and project roles to the given permissions
+func (u *UserRoles) Restrict(scope []Permission) {
+	mask := withRules(scope...)
+
+	u.Global.Scope = &mask
+	for label, role := range u.Projects {
+		role.Scope = &mask
+		u.Projects[label] = role
+	}
+}
+
 // Role in a role in the system (e.g. Admin, user, etc)
 type Role struct {
 	ID        string    `json:"id"`
 	Version   uint8     `json:"version"`
 	Label     Label     `json:"label"`
 	System    bool      `json:"system"`
+	Kind      RoleKind  `json:"kind"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// Permissions are the permissions granted by a custom role, system roles
+	// always use their DefaultPermissions
+	Permissions []Permission `json:"permissions,omitempty"`
+
+	// Inherited are permissions granted by the user's other roles in the same
+	// project, such as through a team, see ProjectRolesMap.Grant
+	Inherited Permission `json:"-"`
+
+	// Scope further restricts the permissions granted by the role, it's set
+	// when the session was created using a scoped API token
+	Scope *Permission `json:"-"`
 }
 
 // NewRole returns a mutable role struct
@@ -170,11 +297,67 @@ func NewRole(label Label, system bool) Role {
 		Version:   modelVersion,
 		Label:     label,
 		System:    system,
+		Kind:      SystemRoleKind(label),
 		CreatedAt: now(),
 	}
 }
 
+// NewCustomRole returns a role that grants the given permissions
+func NewCustomRole(label Label, kind RoleKind, perms []Permission) Role {
+	return Role{
+		ID:          NewID(),
+		Version:     modelVersion,
+		Label:       label,
+		Kind:        kind,
+		Permissions: perms,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+	}
+}
+
+// Validate checks that a custom role can be stored
+func (r *Role) Validate() error {
+	if r.Label == "" {
+		return errors.New(InvalidRoleCause, "role label must not be empty")
+	}
+
+	if r.System {
+		return errors.New(InvalidRoleCause, "system roles cannot be modified")
+	}
+
+	for _, l := range SystemRoles {
+		if r.Label == l {
+			return errors.New(InvalidRoleCause, "%s is a system role", l)
+		}
+	}
+
+	if err := r.Kind.Validate(); err != nil {
+		return err
+	}
+
+	if len(r.Permissions) == 0 {
+		return errors.New(InvalidRoleCause, "role must grant at least one permission")
+	}
+
+	return nil
+}
+
+// Rules returns every permission granted by the role before any scope is
+// applied
+func (r *Role) Rules() Permission {
+	if r.System {
+		return DefaultPermissions[r.Label] | r.Inherited
+	}
+
+	return withRules(r.Permissions...) | r.Inherited
+}
+
 // Can checks to see if a role can do an action
 func (r *Role) Can(action Permission) bool {
-	return DefaultPermissions[r.Label]&action != 0
+	perms := r.Rules()
+	if r.Scope != nil {
+		perms &= *r.Scope
+	}
+
+	return perms&action != 0
 }
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"testing"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestRole(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("system roles use the default permissions", func(t *testing.T) {
+		role := NewRole(AdminRole, true)
+		role.Permissions = []Permission{ReadPolicy}
+
+		gm.Expect(role.Kind).To(gm.Equal(OrgRoleKind))
+		gm.Expect(role.Can(ChangeRole)).To(gm.BeTrue())
+		gm.Expect(role.Can(ReadPolicy)).To(gm.BeFalse())
+
+		gm.Expect(NewRole(ProjectReaderRole, true).Kind).To(gm.Equal(ProjectRoleKind))
+	})
+
+	t.Run("custom roles use their own permissions", func(t *testing.T) {
+		role := NewCustomRole("policy-reviewer", ProjectRoleKind, []Permission{ReadPolicy, AcceptPolicy})
+
+		gm.Expect(role.Validate()).To(gm.BeNil())
+		gm.Expect(role.Can(AcceptPolicy)).To(gm.BeTrue())
+		gm.Expect(role.Can(UpdateProject)).To(gm.BeFalse())
+
+		scope := withRules(ReadPolicy)
+		role.Scope = &scope
+		gm.Expect(role.Can(AcceptPolicy)).To(gm.BeFalse())
+	})
+
+	t.Run("a label without a system flag is not a system role", func(t *testing.T) {
+		role := Role{Label: AdminRole}
+		gm.Expect(role.Can(ChangeRole)).To(gm.BeFalse())
+	})
+
+	t.Run("validates custom roles", func(t *testing.T) {
+		tests := []struct {
+			name string
+			role Role
+		}{
+			{"empty label", NewCustomRole("", OrgRoleKind, []Permission{ReadPolicy})},
+			{"system label", NewCustomRole(AdminRole, OrgRoleKind, []Permission{ReadPolicy})},
+			{"unknown kind", NewCustomRole("reviewer", "team", []Permission{ReadPolicy})},
+			{"no permissions", NewCustomRole("reviewer", OrgRoleKind, nil)},
+			{"system role", NewRole(UserRole, true)},
+		}
+
+		for _, tc := range tests {
+			gm.Expect(tc.role.Validate()).ToNot(gm.BeNil(), tc.name)
+		}
+	})
+}
+
+func TestProjectRolesGrant(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	t.Run("keeps the only role", func(t *testing.T) {
+		roles := ProjectRolesMap{}
+		roles.Grant("my-project", NewRole(ProjectReaderRole, true))
+
+		role, err := roles.Get("my-project")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Label).To(gm.Equal(ProjectReaderRole))
+		gm.Expect(role.Can(UpdateProject)).To(gm.BeFalse())
+	})
+
+	t.Run("uses the stronger role", func(t *testing.T) {
+		roles := ProjectRolesMap{}
+		roles.Grant("my-project", NewRole(ProjectReaderRole, true))
+		roles.Grant("my-project", NewRole(ProjectOwnerRole, true))
+
+		role, err := roles.Get("my-project")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Label).To(gm.Equal(ProjectOwnerRole))
+		gm.Expect(role.Can(AcceptPolicy)).To(gm.BeTrue())
+	})
+
+	t.Run("combines the permissions of every role", func(t *testing.T) {
+		roles := ProjectRolesMap{}
+		roles.Grant("my-project", NewRole(ProjectContributorRole, true))
+		roles.Grant("my-project", NewCustomRole("approver", ProjectRoleKind, []Permission{AcceptPolicy}))
+
+		role, err := roles.Get("my-project")
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(role.Label).To(gm.Equal(ProjectContributorRole))
+		gm.Expect(role.Can(UpdateProject)).To(gm.BeTrue())
+		gm.Expect(role.Can(AcceptPolicy)).To(gm.BeTrue())
+		gm.Expect(role.Can(ChangeProjectRole)).To(gm.BeFalse())
+
+		// A scope still limits the combined permissions
+		scope := withRules(ReadPolicy)
+		role.Scope = &scope
+		gm.Expect(role.Can(AcceptPolicy)).To(gm.BeFalse())
+	})
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+)
+
+// SecretReference records that a project spec uses a version of one of the
+// project's secrets. Specs are encrypted so references are kept alongside
+// them to find the specs that use a secret.
+type SecretReference struct {
+	ID        string    `json:"id"`
+	ProjectID string    `json:"project_id"`
+	SpecID    string    `json:"spec_id"`
+	Name      string    `json:"name"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewSecretReference returns a reference from the spec to the version of the
+// named secret
+func NewSecretReference(projectID, specID, name string, version int) SecretReference {
+	return SecretReference{
+		ID:        NewID(),
+		ProjectID: projectID,
+		SpecID:    specID,
+		Name:      name,
+		Version:   version,
+		CreatedAt: now(),
+	}
+}
+
+// SecretArgs returns the secret arguments of the policy's transformations
+func (p *Policy) SecretArgs() []SecretArg {
+	var secrets []SecretArg
+	for _, transform := range p.Transformations {
+		for _, arg := range transform.Args {
+			if sec, ok := arg.(SecretArg); ok {
+				secrets = append(secrets, sec)
+			}
+		}
+	}
+
+	return secrets
+}
+
+// WithSecretVersion returns a new policy, descended from this one, whose
+// transformations use the given version of the named secret. The values of
+// its secrets are removed so they aren't stored with it. False is returned
+// if none of the transformations use the secret.
+func (p *Policy) WithSecretVersion(name string, version int) (*Policy, bool) {
+	found := false
+	transforms := copyTransformations(p.Transformations, func(sec SecretArg) SecretArg {
+		sec.Value = nil
+		if sec.Name == name {
+			found = true
+			sec.Version = version
+		}
+
+		return sec
+	})
+
+	if !found {
+		return nil, false
+	}
+
+	policy := NewPolicy(p.ProjectID, &p.ID, p.Rules, transforms)
+	policy.Version = p.Version
+	policy.UpdatedAt = policy.CreatedAt
+
+	return &policy, true
+}
+
+// RedactSecrets returns a copy of the transformations with the values of
+// their secret arguments removed
+func RedactSecrets(transforms []*NamedTransformation) []*NamedTransformation {
+	return copyTransformations(transforms, func(sec SecretArg) SecretArg {
+		sec.Value = nil
+		return sec
+	})
+}
+
+func copyTransformations(transforms []*NamedTransformation, fn func(SecretArg) SecretArg) []*NamedTransformation {
+	if transforms == nil {
+		return nil
+	}
+
+	copies := make([]*NamedTransformation, len(transforms))
+	for i, transform := range transforms {
+		args := make(map[string]interface{}, len(transform.Args))
+		for key, arg := range transform.Args {
+			if sec, ok := arg.(SecretArg); ok {
+				arg = fn(sec)
+			}
+
+			args[key] = arg
+		}
+
+		copies[i] = &NamedTransformation{
+			Name: transform.Name,
+			Type: transform.Type,
+			Args: args,
+		}
+	}
+
+	return copies
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/manifoldco/go-base64"
+	gm "github.com/onsi/gomega"
+)
+
+func TestSecretArgs(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	value := base64.New([]byte("secret"))
+	transformations := []*NamedTransformation{
+		{
+			Name: "tokenize",
+			Type: "reversible-tokenizer",
+			Args: map[string]interface{}{
+				"key":  SecretArg{Type: "secret", Name: "my-key", Value: value},
+				"size": 10,
+			},
+		},
+		{
+			Name: "other",
+			Type: "reversible-tokenizer",
+			Args: map[string]interface{}{
+				"key": SecretArg{Type: "secret", Name: "other-key", Version: 3, Value: value},
+			},
+		},
+	}
+
+	t.Run("Versions default to the first", func(t *testing.T) {
+		var named NamedTransformation
+		err := json.Unmarshal([]byte(`{"name": "t", "type": "tokenizer", "key": {"name": "a", "type": "secret"}}`), &named)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(named.Args["key"].(SecretArg).SecretVersion()).To(gm.Equal(FirstSecretVersion))
+
+		err = json.Unmarshal([]byte(`{"name": "t", "type": "tokenizer", "key": {"name": "a", "type": "secret", "version": 4}}`), &named)
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(named.Args["key"].(SecretArg).SecretVersion()).To(gm.Equal(4))
+	})
+
+	t.Run("Finds secret args", func(t *testing.T) {
+		policy := NewPolicy("project-id", nil, nil, transformations)
+		gm.Expect(policy.SecretArgs()).To(gm.HaveLen(2))
+	})
+
+	t.Run("Uses a new version of a secret", func(t *testing.T) {
+		policy := NewPolicy("project-id", nil, nil, transformations)
+
+		rotated, ok := policy.WithSecretVersion("my-key", 2)
+		gm.Expect(ok).To(gm.BeTrue())
+		gm.Expect(rotated.ID).ToNot(gm.Equal(policy.ID))
+		gm.Expect(*rotated.ParentID).To(gm.Equal(policy.ID))
+
+		key := rotated.Transformations[0].Args["key"].(SecretArg)
+		gm.Expect(key.Version).To(gm.Equal(2))
+		gm.Expect(key.Value).To(gm.BeNil())
+		gm.Expect(rotated.Transformations[0].Args["size"]).To(gm.Equal(10))
+
+		// other secrets keep their version but lose their value
+		other := rotated.Transformations[1].Args["key"].(SecretArg)
+		gm.Expect(other.Version).To(gm.Equal(3))
+		gm.Expect(other.Value).To(gm.BeNil())
+
+		// the original policy is left alone
+		gm.Expect(transformations[0].Args["key"].(SecretArg).Value).To(gm.Equal(value))
+	})
+
+	t.Run("Can't use a new version of an unused secret", func(t *testing.T) {
+		policy := NewPolicy("project-id", nil, nil, transformations)
+
+		_, ok := policy.WithSecretVersion("unused", 2)
+		gm.Expect(ok).To(gm.BeFalse())
+	})
+
+	t.Run("Redacts secret values", func(t *testing.T) {
+		redacted := RedactSecrets(transformations)
+		for _, transform := range redacted {
+			gm.Expect(transform.Args["key"].(SecretArg).Value).To(gm.BeNil())
+		}
+
+		gm.Expect(transformations[1].Args["key"].(SecretArg).Value).To(gm.Equal(value))
+	})
+}
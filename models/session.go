@@ -7,14 +7,59 @@ import (
 	"github.com/manifoldco/go-base64"
 )
 
+// SessionType describes how the owner of a session authenticated
+type SessionType string
+
+const (
+	// PasswordSession is a session created by a user logging in with their
+	// email and password
+	PasswordSession SessionType = "password"
+
+	// TokenSession is a session created by logging in with an API token
+	TokenSession SessionType = "token"
+
+	// SSOSession is a session created by signing in through an identity
+	// provider
+	SSOSession SessionType = "sso"
+)
+
+func (s SessionType) String() string {
+	return string(s)
+}
+
 // Session holds all the session data required to authenticate API
 // calls with the server
 type Session struct {
 	ID        string        `json:"id"`
 	UserID    string        `json:"user_id"`
 	OwnerID   string        `json:"owner_id"`
+	Type      SessionType   `json:"type"`
+	IPAddress string        `json:"ip_address,omitempty"`
+	UserAgent string        `json:"user_agent,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
 	ExpiresAt time.Time     `json:"expires_at"`
 	Token     *base64.Value `json:"token"`
+
+	// FamilyID is the id of the session the user originally logged in with,
+	// it's shared by every session created by refreshing that session
+	FamilyID string `json:"family_id,omitempty"`
+
+	// AuthenticatedAt is when the user originally logged in, refreshing a
+	// session can't extend it past the maximum session lifetime from then
+	AuthenticatedAt time.Time `json:"authenticated_at"`
+
+	// RefreshToken is only set when returning a session to the client, it
+	// can be exchanged for a new session before this one expires. It is
+	// never stored.
+	RefreshToken *base64.Value `json:"refresh_token,omitempty"`
+
+	// MFAVerified is set when the user provided a one-time code or recovery
+	// code when creating this session
+	MFAVerified bool `json:"mfa_verified,omitempty"`
+
+	// Scope is copied from the API token used to create the session and
+	// restricts the permissions of the user's roles for this session
+	Scope []Permission `json:"scope,omitempty"`
 }
 
 func (s *Session) Validate() error {
@@ -35,13 +80,65 @@ func (s *Session) Validate() error {
 
 // NewSession returns a new Session struct
 func NewSession(cp CredentialProvider) Session {
+	sessionType := PasswordSession
+	var scope []Permission
+	if token, ok := cp.(*Token); ok {
+		sessionType = TokenSession
+		scope = token.Scope
+	}
+
+	id := NewID()
+	now := time.Now().UTC()
+
 	return Session{
-		ID:      NewID(),
-		UserID:  cp.GetUserID(),
-		OwnerID: cp.GetStringID(),
+		ID:              id,
+		UserID:          cp.GetUserID(),
+		OwnerID:         cp.GetStringID(),
+		Type:            sessionType,
+		Scope:           scope,
+		FamilyID:        id,
+		AuthenticatedAt: now,
+		CreatedAt:       now,
 	}
 }
 
+// Renew returns a new session that continues this one, it belongs to the
+// same family and keeps how and when the user authenticated
+func (s *Session) Renew() Session {
+	return Session{
+		ID:              NewID(),
+		UserID:          s.UserID,
+		OwnerID:         s.OwnerID,
+		Type:            s.Type,
+		IPAddress:       s.IPAddress,
+		UserAgent:       s.UserAgent,
+		MFAVerified:     s.MFAVerified,
+		Scope:           s.Scope,
+		FamilyID:        s.GetFamilyID(),
+		AuthenticatedAt: s.GetAuthenticatedAt(),
+		CreatedAt:       time.Now().UTC(),
+	}
+}
+
+// GetFamilyID returns the family the session belongs to. Sessions created
+// before families were introduced are their own family.
+func (s *Session) GetFamilyID() string {
+	if s.FamilyID == "" {
+		return s.ID
+	}
+
+	return s.FamilyID
+}
+
+// GetAuthenticatedAt returns when the user originally logged in
+func (s *Session) GetAuthenticatedAt() time.Time {
+	if s.AuthenticatedAt.IsZero() {
+		return s.CreatedAt
+	}
+
+	return s.AuthenticatedAt
+}
+
 func (s *Session) SetToken(token *base64.Value, expiresAt time.Time) {
 	s.Token = token
 	s.ExpiresAt = expiresAt
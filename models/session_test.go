@@ -89,5 +89,14 @@ func TestSession(t *testing.T) {
 		gm.Expect(session.Token).To(gm.Equal(sessionToken))
 		gm.Expect(session.UserID).To(gm.Equal(user.ID))
 		gm.Expect(session.OwnerID).To(gm.Equal(user.ID))
+		gm.Expect(session.Type).To(gm.Equal(PasswordSession))
+	})
+
+	t.Run("new session from token", func(t *testing.T) {
+		session := NewSession(&token)
+
+		gm.Expect(session.UserID).To(gm.Equal(user.ID))
+		gm.Expect(session.OwnerID).To(gm.Equal(token.ID))
+		gm.Expect(session.Type).To(gm.Equal(TokenSession))
 	})
 }
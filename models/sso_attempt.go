@@ -0,0 +1,149 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// SSOAttemptExpiration is the amount of time a user has to complete signing
+// in with their identity provider before the attempt is no longer valid.
+var SSOAttemptExpiration = 10 * time.Minute
+
+// userCodeAlphabet leaves out vowels and easily confused characters so user
+// codes are easy to read out and can't spell words
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+// SSOAttempt tracks a single sign on flow that is in progress.
+//
+// The State, Nonce & CodeVerifier are used when talking to the identity
+// provider while the Credentials protect the secret the requester uses to
+// collect the resulting session once the user has authenticated.
+//
+// Once the identity provider has verified the user the attempt holds their
+// UserID but no session is created until they confirm the attempt. They do
+// so from the browser they signed in with, which was given the secret hashed
+// in ConfirmHash, by entering the UserCode shown to the requester. Otherwise
+// anyone could send a user a link to sign in and collect their session.
+type SSOAttempt struct {
+	ID           string       `json:"id"`
+	State        string       `json:"state"`
+	Nonce        string       `json:"nonce"`
+	CodeVerifier string       `json:"code_verifier"`
+	UserCode     string       `json:"user_code"`
+	Credentials  *Credentials `json:"-" gqlgen:"-"`
+	UserID       string       `json:"user_id,omitempty"`
+	ConfirmHash  string       `json:"confirm_hash,omitempty"`
+	SessionID    string       `json:"session_id,omitempty"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+func (s *SSOAttempt) Validate() error {
+	if s.ID == "" {
+		return fmt.Errorf("id must not be empty")
+	}
+
+	if s.State == "" || s.Nonce == "" || s.CodeVerifier == "" {
+		return fmt.Errorf("state, nonce and code verifier must not be empty")
+	}
+
+	if s.UserCode == "" {
+		return fmt.Errorf("user code must not be empty")
+	}
+
+	if s.Credentials == nil {
+		return fmt.Errorf("missing credentials")
+	}
+
+	if s.ExpiresAt.IsZero() {
+		return fmt.Errorf("missing expires at")
+	}
+
+	return nil
+}
+
+// Expired returns whether or not the attempt can still be completed
+func (s *SSOAttempt) Expired() bool {
+	return time.Now().UTC().After(s.ExpiresAt)
+}
+
+// Completed returns whether or not the user has signed in and a session has
+// been created for this attempt
+func (s *SSOAttempt) Completed() bool {
+	return s.SessionID != ""
+}
+
+// Verified returns whether the identity provider has verified the user, who
+// must still confirm the attempt
+func (s *SSOAttempt) Verified() bool {
+	return s.UserID != ""
+}
+
+// Verify records the user the identity provider verified along with the
+// secret given to the browser they signed in with
+func (s *SSOAttempt) Verify(userID string, secret string) {
+	s.UserID = userID
+	s.ConfirmHash = hashConfirmSecret(secret)
+	s.UpdatedAt = time.Now()
+}
+
+// Confirm returns whether the secret was the one given to the browser that
+// signed in and the code is the attempt's user code
+func (s *SSOAttempt) Confirm(secret string, code string) bool {
+	if !s.Verified() || s.ConfirmHash == "" {
+		return false
+	}
+
+	secretOK := subtle.ConstantTimeCompare([]byte(hashConfirmSecret(secret)), []byte(s.ConfirmHash)) == 1
+	codeOK := subtle.ConstantTimeCompare([]byte(normalizeUserCode(code)), []byte(normalizeUserCode(s.UserCode))) == 1
+
+	return secretOK && codeOK
+}
+
+// GenerateUserCode returns a random code of the form XXXX-XXXX for the user
+// to confirm an sso attempt with
+func GenerateUserCode() (string, error) {
+	max := big.NewInt(int64(len(userCodeAlphabet)))
+	code := make([]byte, 8)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+
+		code[i] = userCodeAlphabet[n.Int64()]
+	}
+
+	return string(code[:4]) + "-" + string(code[4:]), nil
+}
+
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func hashConfirmSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewSSOAttempt(state, nonce, verifier, userCode string, creds *Credentials) SSOAttempt {
+	return SSOAttempt{
+		ID:           NewID(),
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		UserCode:     userCode,
+		Credentials:  creds,
+		ExpiresAt:    time.Now().UTC().Add(SSOAttemptExpiration),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestSSOAttempt(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	creds := GenerateCredentials()
+
+	t.Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			fn    func() SSOAttempt
+			cause string
+		}{
+			{
+				name: "valid attempt",
+				fn: func() SSOAttempt {
+					return NewSSOAttempt("state", "nonce", "verifier", "BCDF-GHJK", creds)
+				},
+			},
+			{
+				name: "invalid id",
+				fn: func() SSOAttempt {
+					s := NewSSOAttempt("state", "nonce", "verifier", "BCDF-GHJK", creds)
+					s.ID = ""
+					return s
+				},
+				cause: "id must not be empty",
+			},
+			{
+				name: "missing verifier",
+				fn: func() SSOAttempt {
+					return NewSSOAttempt("state", "nonce", "", "BCDF-GHJK", creds)
+				},
+				cause: "state, nonce and code verifier must not be empty",
+			},
+			{
+				name: "missing user code",
+				fn: func() SSOAttempt {
+					return NewSSOAttempt("state", "nonce", "verifier", "", creds)
+				},
+				cause: "user code must not be empty",
+			},
+			{
+				name: "missing credentials",
+				fn: func() SSOAttempt {
+					return NewSSOAttempt("state", "nonce", "verifier", "BCDF-GHJK", nil)
+				},
+				cause: "missing credentials",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				s := tc.fn()
+				err := s.Validate()
+				if tc.cause != "" {
+					gm.Expect(err).ToNot(gm.BeNil())
+					gm.Expect(err.Error()).To(gm.Equal(tc.cause))
+					return
+				}
+
+				gm.Expect(err).To(gm.BeNil())
+			})
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		s := NewSSOAttempt("state", "nonce", "verifier", "BCDF-GHJK", creds)
+		gm.Expect(s.Expired()).To(gm.BeFalse())
+
+		s.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+		gm.Expect(s.Expired()).To(gm.BeTrue())
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		code, err := GenerateUserCode()
+		gm.Expect(err).To(gm.BeNil())
+		gm.Expect(code).To(gm.MatchRegexp("^[A-Z]{4}-[A-Z]{4}$"))
+
+		s := NewSSOAttempt("state", "nonce", "verifier", code, creds)
+		gm.Expect(s.Confirm("secret", code)).To(gm.BeFalse())
+
+		s.Verify(NewID(), "secret")
+		gm.Expect(s.Verified()).To(gm.BeTrue())
+		gm.Expect(s.Confirm("secret", code)).To(gm.BeTrue())
+		gm.Expect(s.Confirm("secret", strings.ToLower(strings.Replace(code, "-", "", 1)))).To(gm.BeTrue())
+		gm.Expect(s.Confirm("other-secret", code)).To(gm.BeFalse())
+		gm.Expect(s.Confirm("secret", "BCDF-GHJK")).To(gm.Equal(code == "BCDF-GHJK"))
+	})
+
+	t.Run("Completed", func(t *testing.T) {
+		s := NewSSOAttempt("state", "nonce", "verifier", "BCDF-GHJK", creds)
+		gm.Expect(s.Completed()).To(gm.BeFalse())
+
+		s.SessionID = NewID()
+		gm.Expect(s.Completed()).To(gm.BeTrue())
+	})
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// SSOIdentity links a user to their identity at an identity provider. Users
+// are matched on the Issuer and Subject, which the provider never reassigns,
+// rather than their email which may later belong to someone else.
+type SSOIdentity struct {
+	ID      string `json:"id"`
+	UserID  string `json:"user_id"`
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+
+	// OrgRole and ProjectRoles are the roles granted through the user's
+	// groups when they last signed in, so they can be taken away once the
+	// user leaves those groups without touching roles granted in Cape
+	OrgRole      Label           `json:"org_role,omitempty"`
+	ProjectRoles map[Label]Label `json:"project_roles,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewSSOIdentity links the user to the identity
+func NewSSOIdentity(userID, issuer, subject string) SSOIdentity {
+	return SSOIdentity{
+		ID:        NewID(),
+		UserID:    userID,
+		Issuer:    issuer,
+		Subject:   subject,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+}
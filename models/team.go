@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+// Team is a group of users that can be made a contributor to projects.
+// Every member of the team is granted the team's role in those projects.
+type Team struct {
+	ID        string    `json:"id"`
+	Version   uint8     `json:"version"`
+	Label     Label     `json:"label"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTeam returns a team with the given label and display name
+func NewTeam(label Label, name string) Team {
+	return Team{
+		ID:        NewID(),
+		Version:   modelVersion,
+		Label:     label,
+		Name:      name,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+}
+
+func (t *Team) Validate() error {
+	if t.ID == "" {
+		return errors.New(InvalidTeamCause, "team id must not be empty")
+	}
+
+	if t.Label == "" {
+		return errors.New(InvalidTeamCause, "team label must not be empty")
+	}
+
+	return nil
+}
+
+// TeamMember is a user belonging to a team. Maintainers can add and remove
+// the other members of their team.
+type TeamMember struct {
+	ID         string    `json:"id"`
+	TeamID     string    `json:"team_id"`
+	UserID     string    `json:"user_id"`
+	Maintainer bool      `json:"maintainer"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// NewTeamMember returns a membership of the given user in the given team
+func NewTeamMember(teamID, userID string, maintainer bool) TeamMember {
+	return TeamMember{
+		ID:         NewID(),
+		TeamID:     teamID,
+		UserID:     userID,
+		Maintainer: maintainer,
+		CreatedAt:  now(),
+		UpdatedAt:  now(),
+	}
+}
+
+// TeamProject is the role a team has been granted within a project
+type TeamProject struct {
+	ID        string    `json:"id"`
+	TeamID    string    `json:"team_id"`
+	ProjectID string    `json:"project_id"`
+	RoleID    string    `json:"role_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTeamProject returns a grant of the given role to a team within a
+// project
+func NewTeamProject(teamID, projectID, roleID string) TeamProject {
+	return TeamProject{
+		ID:        NewID(),
+		TeamID:    teamID,
+		ProjectID: projectID,
+		RoleID:    roleID,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	errors "github.com/capeprivacy/cape/partyerrors"
+)
+
+func TestTeamValidate(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	team := NewTeam("data-science", "Data Science")
+	gm.Expect(team.Validate()).To(gm.BeNil())
+
+	team.Label = ""
+	err := team.Validate()
+	gm.Expect(errors.CausedBy(err, InvalidTeamCause)).To(gm.BeTrue())
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+)
+
+// Throttle counts the failed attempts made against a key, such as an account
+// or ip address, so guessing a password or recovery secret can be slowed
+// down and eventually locked out.
+//
+// Failures are forgotten once ExpiresAt has passed without another failure.
+type Throttle struct {
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+	BlockedUntil  *time.Time `json:"blocked_until,omitempty"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+}
+
+// Locked returns whether the key is locked out at the given time
+func (t *Throttle) Locked(at time.Time) bool {
+	return t.LockedUntil != nil && at.Before(*t.LockedUntil)
+}
+
+// Blocked returns whether another attempt must wait before being made at
+// the given time
+func (t *Throttle) Blocked(at time.Time) bool {
+	return t.BlockedUntil != nil && at.Before(*t.BlockedUntil)
+}
+
+// RetryAt returns when the next attempt can be made, it's the zero time if an
+// attempt can be made now
+func (t *Throttle) RetryAt(at time.Time) time.Time {
+	var retryAt time.Time
+	if t.Blocked(at) {
+		retryAt = *t.BlockedUntil
+	}
+
+	if t.Locked(at) && t.LockedUntil.After(retryAt) {
+		retryAt = *t.LockedUntil
+	}
+
+	return retryAt
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+)
+
+func TestThrottle(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	now := time.Now().UTC()
+	soon := now.Add(time.Minute)
+	later := now.Add(time.Hour)
+
+	t.Run("can attempt when not blocked or locked", func(t *testing.T) {
+		throttle := &Throttle{Key: "login:ip:127.0.0.1", Failures: 2}
+
+		gm.Expect(throttle.Blocked(now)).To(gm.BeFalse())
+		gm.Expect(throttle.Locked(now)).To(gm.BeFalse())
+		gm.Expect(throttle.RetryAt(now).IsZero()).To(gm.BeTrue())
+	})
+
+	t.Run("retry after the block", func(t *testing.T) {
+		throttle := &Throttle{BlockedUntil: &soon}
+
+		gm.Expect(throttle.Blocked(now)).To(gm.BeTrue())
+		gm.Expect(throttle.RetryAt(now)).To(gm.Equal(soon))
+		gm.Expect(throttle.Blocked(later)).To(gm.BeFalse())
+	})
+
+	t.Run("retry after the later of block and lock", func(t *testing.T) {
+		throttle := &Throttle{BlockedUntil: &soon, LockedUntil: &later}
+
+		gm.Expect(throttle.Locked(now)).To(gm.BeTrue())
+		gm.Expect(throttle.RetryAt(now)).To(gm.Equal(later))
+		gm.Expect(throttle.RetryAt(soon)).To(gm.Equal(later))
+	})
+}
@@ -2,12 +2,26 @@ package models
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/manifoldco/go-base64"
 )
 
 type Token struct {
 	ID     string `json:"id"`
 	UserID string `json:"user_id"`
+	Name   string `json:"name,omitempty"`
+
+	// Scope limits the permissions of sessions created with this token to
+	// those listed, a token without a scope has all of its user's
+	// permissions
+	Scope []Permission `json:"scope,omitempty"`
+
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
 
 	// We never want to send Credentials over the wire!
 	Credentials *Credentials `json:"-" gqlgen:"-"`
@@ -27,9 +41,18 @@ func (tc *Token) Validate() error {
 		return fmt.Errorf("credentials must be non-nil")
 	}
 
+	if tc.ExpiresAt != nil && tc.ExpiresAt.Before(tc.CreatedAt) {
+		return fmt.Errorf("expires at must be after created at")
+	}
+
 	return nil
 }
 
+// Expired returns whether the token can no longer be used to login
+func (tc *Token) Expired() bool {
+	return tc.ExpiresAt != nil && time.Now().UTC().After(*tc.ExpiresAt)
+}
+
 func (tc *Token) GetUserID() string {
 	return tc.UserID
 }
@@ -43,6 +66,7 @@ func NewToken(userID string, creds *Credentials) Token {
 		ID:          NewID(),
 		UserID:      userID,
 		Credentials: creds,
+		CreatedAt:   time.Now().UTC(),
 	}
 }
 
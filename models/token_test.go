@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	gm "github.com/onsi/gomega"
 )
@@ -48,3 +49,48 @@ func TestToken(t *testing.T) {
 		}
 	})
 }
+
+func TestTokenExpiry(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	_, user := GenerateUser("hello", "bob@hello.com")
+	token := NewToken(user.ID, &user.Credentials)
+	gm.Expect(token.Expired()).To(gm.BeFalse())
+
+	past := time.Now().UTC().Add(-time.Minute)
+	token.ExpiresAt = &past
+	gm.Expect(token.Expired()).To(gm.BeTrue())
+	gm.Expect(token.Validate()).ToNot(gm.BeNil())
+
+	future := time.Now().UTC().Add(time.Hour)
+	token.ExpiresAt = &future
+	gm.Expect(token.Expired()).To(gm.BeFalse())
+	gm.Expect(token.Validate()).To(gm.BeNil())
+}
+
+func TestRoleScope(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	roles := UserRoles{
+		Global: NewRole(AdminRole, true),
+		Projects: ProjectRolesMap{
+			"my-project": NewRole(ProjectOwnerRole, true),
+		},
+	}
+
+	roles.Restrict([]Permission{ReadPolicy, ListOwnTokens, ListAnyTokens})
+
+	gm.Expect(roles.Global.Can(ListAnyTokens)).To(gm.BeTrue())
+	gm.Expect(roles.Global.Can(CreateAnyToken)).To(gm.BeFalse())
+
+	project, err := roles.Projects.Get("my-project")
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(project.Can(ReadPolicy)).To(gm.BeTrue())
+	gm.Expect(project.Can(AcceptPolicy)).To(gm.BeFalse())
+
+	// A scope can't grant permissions the role doesn't have
+	user := NewRole(UserRole, true)
+	scope := withRules(ListAnyTokens)
+	user.Scope = &scope
+	gm.Expect(user.Can(ListAnyTokens)).To(gm.BeFalse())
+}
@@ -9,6 +9,7 @@ func (u *User) GetCredentials() (*Credentials, error) {
 		Secret: u.Credentials.Secret,
 		Salt:   u.Credentials.Salt,
 		Alg:    u.Credentials.Alg,
+		Params: u.Credentials.Params,
 	}, nil
 }
 
@@ -31,6 +32,27 @@ type User struct {
 
 	// We never want to send Credentials over the wire!
 	Credentials Credentials `json:"credentials" gqlgen:"-"`
+
+	// PasswordHistory holds the credentials of the user's previous
+	// passwords, most recent first, so they can't be reused
+	PasswordHistory []Credentials `json:"password_history,omitempty" gqlgen:"-"`
+}
+
+// RememberPassword moves the user's current credentials into their password
+// history, keeping at most the given number of previous passwords, and
+// replaces them with the given credentials
+func (u *User) RememberPassword(creds Credentials, keep int) {
+	history := append([]Credentials{u.Credentials}, u.PasswordHistory...)
+	if len(history) > keep {
+		history = history[:keep]
+	}
+
+	if len(history) == 0 {
+		history = nil
+	}
+
+	u.Credentials = creds
+	u.PasswordHistory = history
 }
 
 // NewUser returns a new User struct
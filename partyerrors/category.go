@@ -24,6 +24,7 @@ var (
 	ConflictCategory            Category = "conflict"
 	ForbiddenCategory           Category = "forbidden"
 	RequestTimeoutCategory      Category = "request_timeout"
+	TooManyRequestsCategory     Category = "too_many_requests"
 )
 
 func init() {
@@ -46,6 +47,7 @@ var statusCodeCategoryMap = map[Category]int{
 	ConflictCategory:            http.StatusConflict,
 	ForbiddenCategory:           http.StatusForbidden,
 	RequestTimeoutCategory:      http.StatusRequestTimeout,
+	TooManyRequestsCategory:     http.StatusTooManyRequests,
 }
 
 var inverseMap = map[int]Category{}